@@ -92,6 +92,78 @@ func (o *Api) ResetUserPassword(c *gin.Context) {
 	a2r.Call(chat.ChatClient.ChangePassword, o.chatClient, c)
 }
 
+func (o *Api) GetUserSessions(c *gin.Context) {
+	a2r.Call(admin.AdminClient.GetUserSessions, o.adminClient, c)
+}
+
+func (o *Api) RevokeUserSession(c *gin.Context) {
+	a2r.Call(admin.AdminClient.RevokeUserSession, o.adminClient, c)
+}
+
+func (o *Api) StartUserRecovery(c *gin.Context) {
+	a2r.Call(admin.AdminClient.StartUserRecovery, o.adminClient, c)
+}
+
+func (o *Api) ExecuteUserRecovery(c *gin.Context) {
+	a2r.Call(admin.AdminClient.ExecuteUserRecovery, o.adminClient, c)
+}
+
+func (o *Api) CancelUserRecovery(c *gin.Context) {
+	a2r.Call(admin.AdminClient.CancelUserRecovery, o.adminClient, c)
+}
+
+func (o *Api) GetUserRecovery(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetRecovery, o.chatClient, c)
+}
+
+func (o *Api) GetRedPacket(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetRedPacket, o.chatClient, c)
+}
+
+func (o *Api) GetRedPacketBalance(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetRedPacketBalance, o.chatClient, c)
+}
+
+func (o *Api) AdjustRedPacketBalance(c *gin.Context) {
+	a2r.Call(chat.ChatClient.AdjustRedPacketBalance, o.chatClient, c)
+}
+
+func (o *Api) FindRedPacketCallbacks(c *gin.Context) {
+	a2r.Call(chat.ChatClient.FindRedPacketCallbacks, o.chatClient, c)
+}
+
+func (o *Api) GetDiscoverConfig(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetDiscoverConfig, o.chatClient, c)
+}
+
+func (o *Api) SetDiscoverConfig(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SetDiscoverConfig, o.chatClient, c)
+}
+
+func (o *Api) SetPostDiscover(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SetPostDiscover, o.chatClient, c)
+}
+
+func (o *Api) SearchPostReports(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SearchPostReports, o.chatClient, c)
+}
+
+func (o *Api) GetReportedPost(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetReportedPost, o.chatClient, c)
+}
+
+func (o *Api) ModeratePost(c *gin.Context) {
+	a2r.Call(chat.ChatClient.ModeratePost, o.chatClient, c)
+}
+
+func (o *Api) SearchPostModerationLogs(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SearchPostModerationLogs, o.chatClient, c)
+}
+
+func (o *Api) GetCallbackMetrics(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetCallbackMetrics, o.chatClient, c)
+}
+
 func (o *Api) AdminUpdateInfo(c *gin.Context) {
 	req, err := a2r.ParseRequest[admin.AdminUpdateInfoReq](c)
 	if err != nil {
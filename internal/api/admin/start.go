@@ -121,7 +121,31 @@ func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW) {
 	blockRouter.POST("/search", admin.SearchBlockUser) // Search blocked users
 
 	userRouter := router.Group("/user", mw.CheckAdmin)
-	userRouter.POST("/password/reset", admin.ResetUserPassword) // Reset user password
+	userRouter.POST("/password/reset", admin.ResetUserPassword)     // Reset user password
+	userRouter.POST("/session/list", admin.GetUserSessions)         // List signed-in devices of a user
+	userRouter.POST("/session/revoke", admin.RevokeUserSession)     // Sign a user out of one device
+	userRouter.POST("/recovery/start", admin.StartUserRecovery)     // Schedule a time-locked key rotation for a user
+	userRouter.POST("/recovery/execute", admin.ExecuteUserRecovery) // Execute a recovery after its time lock
+	userRouter.POST("/recovery/cancel", admin.CancelUserRecovery)   // Cancel a pending recovery
+	userRouter.POST("/recovery/get", admin.GetUserRecovery)         // Get recovery progress
+
+	redPacketRouter := router.Group("/red_packet", mw.CheckAdmin)
+	redPacketRouter.POST("/get", admin.GetRedPacket)                      // Get a red packet and its claims
+	redPacketRouter.POST("/balance/get", admin.GetRedPacketBalance)       // Get the red packet ledger balance of a user
+	redPacketRouter.POST("/balance/adjust", admin.AdjustRedPacketBalance) // Credit or debit the red packet ledger balance of a user
+	redPacketRouter.POST("/callback/find", admin.FindRedPacketCallbacks)  // Find the callbacks recorded for a message clientMsgID to reconcile
+
+	postRouter := router.Group("/post", mw.CheckAdmin)
+	postRouter.POST("/discover/config/get", admin.GetDiscoverConfig)          // Get the weights of the discover feed ranking
+	postRouter.POST("/discover/config/set", admin.SetDiscoverConfig)          // Set the weights of the discover feed ranking
+	postRouter.POST("/discover/set", admin.SetPostDiscover)                   // Pin a post in the discover feed or boost its score
+	postRouter.POST("/report/search", admin.SearchPostReports)                // Search post reports
+	postRouter.POST("/report/get", admin.GetReportedPost)                     // Get a reported post with its reports and moderation history
+	postRouter.POST("/moderate", admin.ModeratePost)                          // Dismiss the reports of a post, hide, remove it, or warn or ban its author
+	postRouter.POST("/moderation/log/search", admin.SearchPostModerationLogs) // Search the moderation audit trail
+
+	callbackRouter := router.Group("/callback", mw.CheckAdmin)
+	callbackRouter.POST("/metrics", admin.GetCallbackMetrics) // Get the metrics of the OpenIM callback handlers
 
 	initGroup := router.Group("/client_config", mw.CheckAdmin)
 	initGroup.POST("/get", admin.GetClientConfig) // Get client initialization configuration
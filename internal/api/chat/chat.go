@@ -78,6 +78,26 @@ func (o *Api) VerifyCode(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.VerifyCode, o.chatClient, c)
 }
 
+func (o *Api) ChallengeNonce(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.ChallengeNonceReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.ChallengeNonce(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
 func (o *Api) RegisterUser(c *gin.Context) {
 	req, err := a2r.ParseRequest[chatpb.RegisterUserReq](c)
 	if err != nil {
@@ -350,6 +370,58 @@ func (o *Api) SearchUserPublicInfo(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.SearchUserPublicInfo, o.chatClient, c)
 }
 
+func (o *Api) AddUserKey(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.AddUserKey, o.chatClient, c)
+}
+
+func (o *Api) ListUserKeys(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.ListUserKeys, o.chatClient, c)
+}
+
+func (o *Api) RevokeUserKey(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.RevokeUserKey, o.chatClient, c)
+}
+
+// ################## SESSION ##################
+
+func (o *Api) RefreshToken(c *gin.Context) {
+	a2r.Call(admin.AdminClient.RefreshToken, o.adminClient, c)
+}
+
+func (o *Api) GetUserSessions(c *gin.Context) {
+	a2r.Call(admin.AdminClient.GetUserSessions, o.adminClient, c)
+}
+
+func (o *Api) RevokeUserSession(c *gin.Context) {
+	a2r.Call(admin.AdminClient.RevokeUserSession, o.adminClient, c)
+}
+
+// ################## RECOVERY ##################
+
+func (o *Api) SetRecoveryGuardians(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.SetRecoveryGuardians, o.chatClient, c)
+}
+
+func (o *Api) GetRecoveryGuardians(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetRecoveryGuardians, o.chatClient, c)
+}
+
+func (o *Api) InitiateRecovery(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.InitiateRecovery, o.chatClient, c)
+}
+
+func (o *Api) ApproveRecovery(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.ApproveRecovery, o.chatClient, c)
+}
+
+func (o *Api) GetRecovery(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetRecovery, o.chatClient, c)
+}
+
+func (o *Api) CancelRecovery(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.CancelRecovery, o.chatClient, c)
+}
+
 func (o *Api) GetTokenForVideoMeeting(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.GetTokenForVideoMeeting, o.chatClient, c)
 }
@@ -466,6 +538,10 @@ func (o *Api) PinPost(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.PinPost, o.chatClient, c)
 }
 
+func (o *Api) PinComment(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.PinComment, o.chatClient, c)
+}
+
 func (o *Api) ReferencePost(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.ReferencePost, o.chatClient, c)
 }
@@ -486,6 +562,54 @@ func (o *Api) ChangeAllowForwardPost(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.ChangeAllowForwardPost, o.chatClient, c)
 }
 
+func (o *Api) EditPost(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.EditPost, o.chatClient, c)
+}
+
+func (o *Api) GetPostRevisions(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetPostRevisions, o.chatClient, c)
+}
+
+func (o *Api) ChangePostVisibility(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.ChangePostVisibility, o.chatClient, c)
+}
+
+func (o *Api) GetUserCounters(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetUserCounters, o.chatClient, c)
+}
+
+func (o *Api) GetNotifications(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetNotifications, o.chatClient, c)
+}
+
+func (o *Api) MarkNotificationsRead(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.MarkNotificationsRead, o.chatClient, c)
+}
+
+func (o *Api) GetUnreadNotificationCount(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetUnreadNotificationCount, o.chatClient, c)
+}
+
+func (o *Api) SearchPosts(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.SearchPosts, o.chatClient, c)
+}
+
+func (o *Api) GetTopicFeed(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetTopicFeed, o.chatClient, c)
+}
+
+func (o *Api) ReportPost(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.ReportPost, o.chatClient, c)
+}
+
+func (o *Api) RestorePost(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.RestorePost, o.chatClient, c)
+}
+
+func (o *Api) GetDeletedPostList(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetDeletedPostList, o.chatClient, c)
+}
+
 func (o *Api) DeletePost(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.DeletePost, o.chatClient, c)
 }
@@ -532,3 +656,11 @@ func (o *Api) CheckVersion(c *gin.Context) {
 func (o *Api) GetFakeUser(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.GetFakeUser, o.chatClient, c)
 }
+
+func (o *Api) GetRedPacket(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetRedPacket, o.chatClient, c)
+}
+
+func (o *Api) GetRedPacketBalance(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetRedPacketBalance, o.chatClient, c)
+}
@@ -70,8 +70,11 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	config.POST("/fakeUser", chat.GetFakeUser)
 
 	account := router.Group("/account")
+	account.POST("/challenge_nonce", chat.ChallengeNonce)            // Get a nonce to sign for register or login
 	account.POST("/register", mw.CheckAdminOrNil, chat.RegisterUser) // Register
 	account.POST("/login", chat.Login)                               // Login
+	account.POST("/refresh_token", chat.RefreshToken)                // Exchange a refresh token for a new token pair
+	account.POST("/recovery/initiate", chat.InitiateRecovery)        // Request a key rotation signed by the new key, approved by guardians
 
 	post := router.Group("/post", mw.CheckToken)
 	post.POST("/publish", chat.PublishPost)
@@ -80,8 +83,20 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	post.POST("/forward", chat.ForwardPost)
 	post.POST("/comment", chat.CommentPost)
 	post.POST("/pin", chat.PinPost)
+	post.POST("/pin_comment", chat.PinComment)
 	post.POST("/reference", chat.ReferencePost)
+	post.POST("/edit", chat.EditPost)
+	post.POST("/revision/list", chat.GetPostRevisions)
 	post.POST("/delete", chat.DeletePost)
+	post.POST("/restore", chat.RestorePost)
+	post.POST("/user_counters", chat.GetUserCounters)
+	post.POST("/trash_list", chat.GetDeletedPostList)
+	post.POST("/notification/list", chat.GetNotifications)
+	post.POST("/notification/read", chat.MarkNotificationsRead)
+	post.POST("/notification/unread_count", chat.GetUnreadNotificationCount)
+	post.POST("/search", chat.SearchPosts)
+	post.POST("/topic/feed", chat.GetTopicFeed)
+	post.POST("/report", chat.ReportPost)
 	post.POST("/:postID", chat.GetPostByID)
 	post.POST("/list_by_user", chat.GetPostListByUser)
 	post.POST("/list", chat.GetPostList)
@@ -89,6 +104,7 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	post.POST("/comment_list", chat.GetCommentPostListByPostID)
 	post.POST("/change_allow_comment", chat.ChangeAllowCommentPost)
 	post.POST("/change_allow_forward", chat.ChangeAllowForwardPost)
+	post.POST("/change_visibility", chat.ChangePostVisibility)
 
 	user := router.Group("/user", mw.CheckToken)
 	user.POST("/update", chat.UpdateUserInfo)              // Edit personal information
@@ -100,12 +116,26 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	user.POST("/rtc/get_token", chat.GetTokenForVideoMeeting) // Get token for video meeting for the user
 	user.POST("/statistic", chat.GetStatistic)
 	user.POST("/online_time", chat.GetUsersOnlineTime)
+	user.POST("/key/add", chat.AddUserKey)                         // Link another wallet key to the account
+	user.POST("/key/list", chat.ListUserKeys)                      // List wallet keys linked to the account
+	user.POST("/key/revoke", chat.RevokeUserKey)                   // Revoke a linked wallet key
+	user.POST("/session/list", chat.GetUserSessions)               // List signed-in devices
+	user.POST("/session/revoke", chat.RevokeUserSession)           // Sign out one device
+	user.POST("/recovery/guardian/set", chat.SetRecoveryGuardians) // Set guardians who can approve recovering the account
+	user.POST("/recovery/guardian/get", chat.GetRecoveryGuardians) // Get recovery guardians
+	user.POST("/recovery/approve", chat.ApproveRecovery)           // Approve a recovery as guardian
+	user.POST("/recovery/cancel", chat.CancelRecovery)             // Cancel a pending recovery of own account
+	user.POST("/recovery/get", chat.GetRecovery)                   // Get recovery progress as the account owner or a guardian
 
 	group := router.Group("/group", mw.CheckToken)
 	group.POST("/contact/get", chat.GetGroupFromContact)
 	group.POST("/contact/save", chat.SaveGroupToContact)
 	group.POST("/contact/delete", chat.DeleteGroupFromContact)
 
+	redPacket := router.Group("/red_packet", mw.CheckToken)
+	redPacket.POST("/get", chat.GetRedPacket)            // Get a red packet and its claims
+	redPacket.POST("/balance", chat.GetRedPacketBalance) // Get own red packet ledger balance
+
 	router.POST("/friend/search", mw.CheckToken, chat.SearchFriend)
 	router.POST("/callback/:command", chat.OpenIMCallback)
 
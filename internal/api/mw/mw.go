@@ -19,6 +19,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
@@ -68,7 +69,7 @@ func (o *MW) isValidToken(c *gin.Context, userID string, token string) error {
 		switch v {
 		case constantpb.NormalToken:
 		case constantpb.KickedToken:
-			return errs.ErrTokenExpired.Wrap()
+			return eerrs.ErrTokenKicked.Wrap()
 		default:
 			return errs.ErrTokenUnknown.Wrap()
 		}
@@ -16,8 +16,12 @@ package admin
 
 import (
 	"context"
+	"time"
+
+	"github.com/openimsdk/tools/log"
 
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
@@ -27,8 +31,9 @@ func (o *adminServer) CheckRegisterForbidden(ctx context.Context, req *admin.Che
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 	for _, forbidden := range forbiddens {
-		if forbidden.LimitRegister {
+		if forbidden.LimitRegister && !lockoutExpired(forbidden.ExpireTime, now) {
 			return nil, eerrs.ErrForbidden.Wrap()
 		}
 	}
@@ -40,15 +45,29 @@ func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckL
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 	for _, forbidden := range forbiddens {
-		if forbidden.LimitLogin {
+		if forbidden.LimitLogin && !lockoutExpired(forbidden.ExpireTime, now) {
 			return nil, eerrs.ErrForbidden.WrapMsg("ip forbidden")
 		}
+		if now.Before(forbidden.LoginExpireTime) {
+			return nil, eerrs.ErrForbidden.WrapMsg("ip locked", "expireTime", forbidden.LoginExpireTime)
+		}
 	}
-	if _, err := o.Database.GetLimitUserLoginIP(ctx, req.UserID, req.Ip); err != nil {
-		if !dbutil.IsDBNotFound(err) {
-			return nil, err
+	limit, err := o.Database.GetLimitUserLoginIP(ctx, req.UserID, req.Ip)
+	if err != nil && !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	if limit != nil && now.Before(limit.LockExpireTime) {
+		return nil, eerrs.ErrForbidden.WrapMsg("user ip locked", "expireTime", limit.LockExpireTime)
+	}
+	if limit != nil && limit.Locked {
+		if !lockoutExpired(limit.ExpireTime, now) {
+			return nil, eerrs.ErrForbidden.WrapMsg("user ip locked", "expireTime", limit.ExpireTime)
 		}
+		limit = nil
+	}
+	if limit == nil {
 		count, err := o.Database.CountLimitUserLoginIP(ctx, req.UserID)
 		if err != nil {
 			return nil, err
@@ -64,3 +83,73 @@ func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckL
 	}
 	return &admin.CheckLoginForbiddenResp{}, nil
 }
+
+// AddLoginLockout temporarily blocks logins from an ip, for one user or for everyone. Rules set by an admin are kept,
+// a permanent ip rule that does not limit login gets the lockout in LoginExpireTime and an allow list entry in LockExpireTime.
+func (o *adminServer) AddLoginLockout(ctx context.Context, req *admin.AddLoginLockoutReq) (*admin.AddLoginLockoutResp, error) {
+	now := time.Now()
+	if req.UserID == "" {
+		forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
+		if err != nil {
+			return nil, err
+		}
+		if len(forbiddens) > 0 && forbiddens[0].ExpireTime.IsZero() {
+			forbidden := forbiddens[0]
+			if forbidden.LimitLogin {
+				return &admin.AddLoginLockoutResp{Recorded: false}, nil
+			}
+			forbidden.LoginExpireTime = time.UnixMilli(req.ExpireTime)
+			if err := o.Database.LockIPForbidden(ctx, forbidden); err != nil {
+				return nil, err
+			}
+			log.ZInfo(ctx, "login lockout", "ip", req.Ip, "reason", req.Reason, "expireTime", req.ExpireTime)
+			return &admin.AddLoginLockoutResp{Recorded: true}, nil
+		}
+		forbidden := &admindb.IPForbidden{
+			IP:         req.Ip,
+			LimitLogin: true,
+			CreateTime: now,
+			Reason:     req.Reason,
+			ExpireTime: time.UnixMilli(req.ExpireTime),
+		}
+		if err := o.Database.LockIPForbidden(ctx, forbidden); err != nil {
+			return nil, err
+		}
+	} else {
+		limit, err := o.Database.GetLimitUserLoginIP(ctx, req.UserID, req.Ip)
+		if err == nil && !limit.Locked {
+			limit.LockExpireTime = time.UnixMilli(req.ExpireTime)
+			if err := o.Database.LockUserLoginIP(ctx, limit); err != nil {
+				return nil, err
+			}
+			log.ZInfo(ctx, "login lockout", "userID", req.UserID, "ip", req.Ip, "reason", req.Reason, "expireTime", req.ExpireTime)
+			return &admin.AddLoginLockoutResp{Recorded: true}, nil
+		} else if err != nil && !dbutil.IsDBNotFound(err) {
+			return nil, err
+		}
+		limit = &admindb.LimitUserLoginIP{
+			UserID:     req.UserID,
+			IP:         req.Ip,
+			CreateTime: now,
+			Locked:     true,
+			Reason:     req.Reason,
+			ExpireTime: time.UnixMilli(req.ExpireTime),
+		}
+		if err := o.Database.LockUserLoginIP(ctx, limit); err != nil {
+			return nil, err
+		}
+	}
+	log.ZInfo(ctx, "login lockout", "userID", req.UserID, "ip", req.Ip, "reason", req.Reason, "expireTime", req.ExpireTime)
+	return &admin.AddLoginLockoutResp{Recorded: true}, nil
+}
+
+func lockoutExpired(expireTime time.Time, now time.Time) bool {
+	return !expireTime.IsZero() && expireTime.Before(now)
+}
+
+func expireTimeMilli(expireTime time.Time) int64 {
+	if expireTime.IsZero() {
+		return 0
+	}
+	return expireTime.UnixMilli()
+}
@@ -41,6 +41,8 @@ func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIP
 			LimitLogin:    forbidden.LimitLogin,
 			LimitRegister: forbidden.LimitRegister,
 			CreateTime:    forbidden.CreateTime.UnixMilli(),
+			Reason:        forbidden.Reason,
+			ExpireTime:    expireTimeMilli(forbidden.ExpireTime),
 		})
 	}
 	return resp, nil
@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// StartUserRecovery schedules a key rotation for a user who lost every key, it can only be executed after the time lock.
+func (o *adminServer) StartUserRecovery(ctx context.Context, req *admin.StartUserRecoveryReq) (*admin.StartUserRecoveryResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	recovery, err := o.Chat.StartAdminRecovery(ctx, &chat.StartAdminRecoveryReq{
+		UserID:       req.UserID,
+		NewAddress:   req.NewAddress,
+		NewPublicKey: req.NewPublicKey,
+		Reason:       req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.StartUserRecoveryResp{RecoveryID: recovery.RecoveryID, ExecuteAfter: recovery.ExecuteAfter}, nil
+}
+
+func (o *adminServer) ExecuteUserRecovery(ctx context.Context, req *admin.ExecuteUserRecoveryReq) (*admin.ExecuteUserRecoveryResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := o.Chat.ExecuteRecovery(ctx, req.RecoveryID); err != nil {
+		return nil, err
+	}
+	return &admin.ExecuteUserRecoveryResp{}, nil
+}
+
+func (o *adminServer) CancelUserRecovery(ctx context.Context, req *admin.CancelUserRecoveryReq) (*admin.CancelUserRecoveryResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := o.Chat.CancelRecovery(ctx, req.RecoveryID); err != nil {
+		return nil, err
+	}
+	return &admin.CancelUserRecoveryResp{}, nil
+}
@@ -57,8 +57,13 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	}
 	srv.Chat = chatClient.NewChatClient(chat.NewChatClient(conn))
 	srv.Token = &tokenverify.Token{
-		Expires: time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
-		Secret:  config.RpcConfig.Secret,
+		Expires:       time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
+		AccessExpires: time.Duration(config.RpcConfig.TokenPolicy.AccessExpire) * time.Hour,
+		Secret:        config.RpcConfig.Secret,
+	}
+	srv.MultiLogin = multiLogin{
+		Policy:      config.RpcConfig.TokenPolicy.MultiLogin.Policy,
+		MaxSessions: config.RpcConfig.TokenPolicy.MultiLogin.MaxSessions,
 	}
 	if err := srv.initAdmin(ctx, config.Share.ChatAdmin, config.Share.OpenIM.AdminUserID); err != nil {
 		return err
@@ -68,9 +73,10 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 }
 
 type adminServer struct {
-	Database database.AdminDatabaseInterface
-	Chat     *chatClient.ChatClient
-	Token    *tokenverify.Token
+	Database   database.AdminDatabaseInterface
+	Chat       *chatClient.ChatClient
+	Token      *tokenverify.Token
+	MultiLogin multiLogin
 }
 
 func (o *adminServer) initAdmin(ctx context.Context, admins []string, imUserID string) error {
@@ -16,27 +16,217 @@ package admin
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/redis/go-redis/v9"
 )
 
 func (o *adminServer) CreateToken(ctx context.Context, req *adminpb.CreateTokenReq) (*adminpb.CreateTokenResp, error) {
-	token, err := o.Token.CreateToken(req.UserID, req.UserType)
+	if req.UserType != constant.NormalUser {
+		token, err := o.Token.CreateToken(req.UserID, req.UserType)
+		if err != nil {
+			return nil, err
+		}
+		err = o.Database.CacheToken(ctx, req.UserID, token)
+		if err != nil {
+			return nil, err
+		}
+		return &adminpb.CreateTokenResp{
+			Token: token,
+		}, nil
+	}
+	now := time.Now()
+	session := &cache.Session{
+		SessionID:   uuid.New().String(),
+		UserID:      req.UserID,
+		UserType:    req.UserType,
+		PlatformID:  req.PlatformID,
+		DeviceID:    req.DeviceID,
+		IP:          req.Ip,
+		CreateTime:  now.UnixMilli(),
+		RefreshTime: now.UnixMilli(),
+		ExpireTime:  now.Add(o.Token.Expires).UnixMilli(),
+	}
+	sessions, err := o.Database.GetSessions(ctx, req.UserID)
 	if err != nil {
 		return nil, err
 	}
-	err = o.Database.CacheToken(ctx, req.UserID, token)
+	if kicked := o.MultiLogin.kicked(sessions, session); len(kicked) > 0 {
+		if err := o.Database.KickSessions(ctx, req.UserID, kicked); err != nil {
+			return nil, err
+		}
+		log.ZInfo(ctx, "kick user sessions", "userID", req.UserID, "platformID", req.PlatformID, "count", len(kicked))
+	}
+	refreshToken, err := o.renewSession(ctx, session)
 	if err != nil {
 		return nil, err
 	}
 	return &adminpb.CreateTokenResp{
-		Token: token,
+		Token:             session.AccessToken,
+		RefreshToken:      refreshToken,
+		ExpireTime:        now.Add(o.Token.AccessExpires).UnixMilli(),
+		RefreshExpireTime: session.ExpireTime,
+		SessionID:         session.SessionID,
+	}, nil
+}
+
+// RefreshToken rotates the token pair of a session, the refresh token presented can not be used again.
+func (o *adminServer) RefreshToken(ctx context.Context, req *adminpb.RefreshTokenReq) (*adminpb.RefreshTokenResp, error) {
+	userID, sessionID, err := o.Database.TakeRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, eerrs.ErrTokenNotExist.WrapMsg("refresh token not exist")
+		}
+		return nil, err
+	}
+	session, err := o.Database.GetSession(ctx, userID, sessionID)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, eerrs.ErrTokenNotExist.WrapMsg("session not exist")
+		}
+		return nil, err
+	}
+	if _, err := o.CheckLoginForbidden(ctx, &adminpb.CheckLoginForbiddenReq{UserID: userID, Ip: session.IP}); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	oldToken := session.AccessToken
+	session.RefreshTime = now.UnixMilli()
+	refreshToken, err := o.renewSession(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.DeleteAccessToken(ctx, userID, oldToken); err != nil {
+		return nil, err
+	}
+	return &adminpb.RefreshTokenResp{
+		UserID:            userID,
+		Token:             session.AccessToken,
+		RefreshToken:      refreshToken,
+		ExpireTime:        now.Add(o.Token.AccessExpires).UnixMilli(),
+		RefreshExpireTime: session.ExpireTime,
 	}, nil
 }
 
+type multiLogin struct {
+	Policy      int
+	MaxSessions int
+}
+
+// kicked returns the existing sessions that a new session ends under the policy.
+func (m multiLogin) kicked(sessions []*cache.Session, session *cache.Session) []*cache.Session {
+	var kicked []*cache.Session
+	switch m.Policy {
+	case constant.MultiLoginPerPlatform:
+		for _, old := range sessions {
+			if old.PlatformID == session.PlatformID {
+				kicked = append(kicked, old)
+			}
+		}
+	case constant.MultiLoginPerClass:
+		class := constantpb.PlatformIDToClass(int(session.PlatformID))
+		for _, old := range sessions {
+			if old.PlatformID == session.PlatformID || (class != "" && constantpb.PlatformIDToClass(int(old.PlatformID)) == class) {
+				kicked = append(kicked, old)
+			}
+		}
+	case constant.MultiLoginMaxSessions:
+		if m.MaxSessions <= 0 || len(sessions) < m.MaxSessions {
+			return nil
+		}
+		sort.Slice(sessions, func(i, j int) bool {
+			return sessions[i].CreateTime < sessions[j].CreateTime
+		})
+		kicked = sessions[:len(sessions)-m.MaxSessions+1]
+	}
+	return kicked
+}
+
+// renewSession issues a new access token and refresh token for the session and caches them.
+func (o *adminServer) renewSession(ctx context.Context, session *cache.Session) (string, error) {
+	token, err := o.Token.CreateAccessToken(session.UserID, session.UserType)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	refreshToken := hex.EncodeToString(b)
+	session.AccessToken = token
+	if err := o.Database.CacheSession(ctx, session, refreshToken); err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+func (o *adminServer) GetUserSessions(ctx context.Context, req *adminpb.GetUserSessionsReq) (*adminpb.GetUserSessionsResp, error) {
+	opUserID, _, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == "" {
+		req.UserID = opUserID
+	}
+	if err := mctx.CheckAdminOr(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	sessions, err := o.Database.GetSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreateTime > sessions[j].CreateTime
+	})
+	resp := &adminpb.GetUserSessionsResp{Sessions: make([]*adminpb.SessionInfo, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &adminpb.SessionInfo{
+			SessionID:   session.SessionID,
+			PlatformID:  session.PlatformID,
+			Platform:    constantpb.PlatformIDToName(int(session.PlatformID)),
+			DeviceID:    session.DeviceID,
+			Ip:          session.IP,
+			CreateTime:  session.CreateTime,
+			RefreshTime: session.RefreshTime,
+			ExpireTime:  session.ExpireTime,
+		})
+	}
+	return resp, nil
+}
+
+func (o *adminServer) RevokeUserSession(ctx context.Context, req *adminpb.RevokeUserSessionReq) (*adminpb.RevokeUserSessionResp, error) {
+	opUserID, _, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == "" {
+		req.UserID = opUserID
+	}
+	if err := mctx.CheckAdminOr(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DeleteSession(ctx, req.UserID, req.SessionID); err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, errs.ErrRecordNotFound.WrapMsg("session not found")
+		}
+		return nil, err
+	}
+	log.ZInfo(ctx, "revoke user session", "userID", req.UserID, "sessionID", req.SessionID)
+	return &adminpb.RevokeUserSessionResp{}, nil
+}
+
 func (o *adminServer) ParseToken(ctx context.Context, req *adminpb.ParseTokenReq) (*adminpb.ParseTokenResp, error) {
 	userID, userType, err := o.Token.GetToken(req.Token)
 	if err != nil {
@@ -49,8 +239,10 @@ func (o *adminServer) ParseToken(ctx context.Context, req *adminpb.ParseTokenReq
 	if len(m) == 0 {
 		return nil, eerrs.ErrTokenNotExist.Wrap()
 	}
-	if _, ok := m[req.Token]; !ok {
+	if flag, ok := m[req.Token]; !ok {
 		return nil, eerrs.ErrTokenNotExist.Wrap()
+	} else if flag == constantpb.KickedToken {
+		return nil, eerrs.ErrTokenKicked.Wrap()
 	}
 
 	return &adminpb.ParseTokenResp{
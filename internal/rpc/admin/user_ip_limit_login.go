@@ -46,6 +46,9 @@ func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.Sea
 			Ip:         info.IP,
 			CreateTime: info.CreateTime.UnixMilli(),
 			User:       userMap[info.UserID],
+			Locked:     info.Locked,
+			Reason:     info.Reason,
+			ExpireTime: expireTimeMilli(info.ExpireTime),
 		})
 	}
 	return &admin.SearchUserIPLimitLoginResp{Total: uint32(total), Limits: limits}, nil
@@ -18,14 +18,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/callback"
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
-	"github.com/openimsdk/chat/pkg/redpacket"
-	"github.com/openimsdk/chat/pkg/redpacket/servererrs"
-	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/chat/pkg/redpacket/apistruct"
 )
 
 type CallbackBeforeAddFriendReq struct {
@@ -37,69 +41,76 @@ type CallbackBeforeAddFriendReq struct {
 	OperationID     string `json:"operationID"`
 }
 
+type CallbackBeforeCreateGroupReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	OperationID     string `json:"operationID"`
+	GroupID         string `json:"groupID"`
+	OwnerUserID     string `json:"ownerUserID"`
+	CreatorUserID   string `json:"creatorUserID"`
+	InitMemberList  []struct {
+		UserID string `json:"userID"`
+	} `json:"initMemberList"`
+}
+
+type CallbackBeforeMemberJoinGroupReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	OperationID     string `json:"operationID"`
+	GroupID         string `json:"groupID"`
+	UserID          string `json:"userID"`
+}
+
 type CallbackCommand string
 
 func (c CallbackCommand) GetCallbackCommand() string {
 	return string(c)
 }
 
-func (o *chatSvr) OpenIMCallback(ctx context.Context, req *chat.OpenIMCallbackReq) (*chat.OpenIMCallbackResp, error) {
-	var result *chat.OpenIMCallbackResp
-	var err error
-
-	switch req.Command {
-	case constantpb.CallbackBeforeAddFriendCommand:
-		result, err = o.handleCallbackBeforeAddFriend(ctx, req)
-	case constantpb.CallbackBeforeSendSingleMsgCommand:
-		result, err = o.handleCallbackBeforeMsg(ctx, req)
-	case constantpb.CallbackBeforeSendGroupMsgCommand:
-		result, err = o.handleCallbackBeforeMsg(ctx, req)
-	default:
-		return nil, errs.ErrArgs.WrapMsg(fmt.Sprintf("invalid command %s", req.Command))
+// newCallbackRouter registers the handlers of the OpenIM callbacks, a new custom message type only needs
+// a HandleCustom call here.
+func (o *chatSvr) newCallbackRouter(timeout time.Duration) *callback.Router {
+	router := callback.NewRouter(timeout)
+	router.Handle(constantpb.CallbackBeforeAddFriendCommand, o.handleCallbackBeforeAddFriend)
+	router.Handle(constantpb.CallbackBeforeCreateGroupCommand, o.handleCallbackBeforeCreateGroup)
+	router.Handle(constantpb.CallbackBeforeMemberJoinGroupCommand, o.handleCallbackBeforeMemberJoinGroup)
+	for _, customType := range []int32{constantpb.SendPrivateRedPacket, constantpb.SendLuckRedPacket, constantpb.SendExclusiveRedPacket} {
+		router.HandleCustom(callback.BeforeSend, customType, o.SendRedPacket)
 	}
-
-	if err != nil {
-		return nil, err
+	for _, customType := range []int32{constantpb.ReceivePrivateRedPacket, constantpb.ReceiveLuckRedPacket, constantpb.ReceiveExclusiveRedPacket} {
+		router.HandleCustom(callback.BeforeSend, customType, o.ReceiveRedPacket)
 	}
+	router.HandleCustom(callback.BeforeSend, constantpb.RefundRedPacket, func(ctx context.Context, msg *callback.MsgReq, data *apistruct.CustomData) (*chat.OpenIMCallbackResp, error) {
+		return callback.Reject(eerrs.ErrRedPacketPayload.WrapMsg("refund red packet can only be sent by the server")), nil
+	})
+	return router
+}
 
-	return result, nil
+func (o *chatSvr) OpenIMCallback(ctx context.Context, req *chat.OpenIMCallbackReq) (*chat.OpenIMCallbackResp, error) {
+	return o.Callback.Dispatch(ctx, req.Command, req.Body)
 }
 
-func (o *chatSvr) handleCallbackBeforeMsg(ctx context.Context, req *chat.OpenIMCallbackReq) (*chat.OpenIMCallbackResp, error) {
-	var data constantpb.CommonCallbackReq
-	if err := json.Unmarshal([]byte(req.Body), &data); err != nil {
-		return nil, errs.Wrap(err)
+func (o *chatSvr) GetCallbackMetrics(ctx context.Context, req *chat.GetCallbackMetricsReq) (*chat.GetCallbackMetricsResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
 	}
-	if data.MsgFrom == constantpb.UserMsgType && data.ContentType == constantpb.Custom {
-		var content map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Content), &content); err != nil {
-			return nil, errs.Wrap(err)
-		}
-		var data1 map[string]interface{}
-		if err := json.Unmarshal([]byte(content["data"].(string)), &data1); err != nil {
-			return nil, errs.Wrap(err)
-		}
-		if customType, ok := data1["customType"].(float64); ok {
-			intCustomType := int32(customType)
-			if intCustomType >= constantpb.SendPrivateRedPacket {
-				if intCustomType <= constantpb.SendExclusiveRedPacket {
-					return o.SendRedPacket(ctx, &data)
-				}
-				if intCustomType <= constantpb.ReceiveExclusiveRedPacket {
-					return o.ReceiveRedPacket(ctx, &data)
-				}
-			}
-		}
-	}
-	return &chat.OpenIMCallbackResp{
-		ActionCode: 0,
-		NextCode:   0,
-	}, nil
+	metrics := o.Callback.Metrics()
+	resp := &chat.GetCallbackMetricsResp{Metrics: make([]*chat.CallbackMetric, 0, len(metrics))}
+	for _, m := range metrics {
+		resp.Metrics = append(resp.Metrics, &chat.CallbackMetric{
+			Route:       m.Route,
+			Calls:       m.Calls,
+			Errors:      m.Errors,
+			Rejects:     m.Rejects,
+			Timeouts:    m.Timeouts,
+			AvgDuration: (m.TotalTime / time.Duration(m.Calls)).Milliseconds(),
+			MaxDuration: m.MaxDuration.Milliseconds(),
+		})
+	}
+	return resp, nil
 }
 
-func (o *chatSvr) handleCallbackBeforeAddFriend(ctx context.Context, req *chat.OpenIMCallbackReq) (*chat.OpenIMCallbackResp, error) {
+func (o *chatSvr) handleCallbackBeforeAddFriend(ctx context.Context, body []byte) (*chat.OpenIMCallbackResp, error) {
 	var data CallbackBeforeAddFriendReq
-	if err := json.Unmarshal([]byte(req.Body), &data); err != nil {
+	if err := json.Unmarshal(body, &data); err != nil {
 		return nil, errs.Wrap(err)
 	}
 	user, err := o.Database.GetAttribute(ctx, data.ToUserID)
@@ -109,103 +120,71 @@ func (o *chatSvr) handleCallbackBeforeAddFriend(ctx context.Context, req *chat.O
 	if user.AllowAddFriend != constant.OrdinaryUserAddFriendEnable {
 		return nil, eerrs.ErrRefuseFriend.WrapMsg(fmt.Sprintf("state %d", user.AllowAddFriend))
 	}
-	return &chat.OpenIMCallbackResp{
-		ActionCode: 0,
-		NextCode:   0,
-	}, nil
+	return callback.Continue(), nil
 }
 
-// 发送红包
-func (o *chatSvr) SendRedPacket(ctx context.Context, msgData *constantpb.CommonCallbackReq) (*chat.OpenIMCallbackResp, error) {
-	var content map[string]interface{}
-	if err := json.Unmarshal([]byte(msgData.Content), &content); err != nil {
+// handleCallbackBeforeCreateGroup keeps blocked users out of new groups.
+func (o *chatSvr) handleCallbackBeforeCreateGroup(ctx context.Context, body []byte) (*chat.OpenIMCallbackResp, error) {
+	var data CallbackBeforeCreateGroupReq
+	if err := json.Unmarshal(body, &data); err != nil {
 		return nil, errs.Wrap(err)
 	}
+	userIDs := []string{data.OwnerUserID, data.CreatorUserID}
+	for _, member := range data.InitMemberList {
+		userIDs = append(userIDs, member.UserID)
+	}
+	return o.rejectForbidden(ctx, userIDs)
+}
 
-	var contentData map[string]interface{}
-	if err := json.Unmarshal([]byte(content["data"].(string)), &contentData); err != nil {
+// handleCallbackBeforeMemberJoinGroup keeps blocked users from joining groups.
+func (o *chatSvr) handleCallbackBeforeMemberJoinGroup(ctx context.Context, body []byte) (*chat.OpenIMCallbackResp, error) {
+	var data CallbackBeforeMemberJoinGroupReq
+	if err := json.Unmarshal(body, &data); err != nil {
 		return nil, errs.Wrap(err)
 	}
-
-	if params, ok := contentData["data"].(map[string]interface{}); ok {
-		redpacketResp := &redpacket.Response{}
-		params["clientMsgID"] = msgData.ClientMsgID
-		if err := o.RedPacketClient.SyncPost(ctx, msgData.SendID, "/redPacket/send", params, redpacketResp, &o.Share.RedPacket); err != nil {
-			return &chat.OpenIMCallbackResp{
-				ActionCode: 0,
-				NextCode:   1,
-				ErrDlt:     err.Error(),
-			}, nil
-		}
-
-		if redpacketResp.Code == redpacket.SuccessCode {
-			return &chat.OpenIMCallbackResp{
-				ActionCode: 0,
-				NextCode:   0,
-			}, nil
-		} else {
-			return &chat.OpenIMCallbackResp{
-				ActionCode: 0,
-				NextCode:   1,
-				ErrDlt:     redpacketResp.Msg,
-				ErrMsg:     redpacketResp.Msg,
-				ErrCode:    servererrs.ServerInternalError,
-			}, nil
-		}
-	}
-
-	return &chat.OpenIMCallbackResp{
-		ActionCode: 0,
-		NextCode:   0,
-	}, nil
+	return o.rejectForbidden(ctx, []string{data.UserID})
 }
 
-// 领取红包
-func (o *chatSvr) ReceiveRedPacket(ctx context.Context, msgData *constantpb.CommonCallbackReq) (*chat.OpenIMCallbackResp, error) {
-	var content map[string]interface{}
-	if err := json.Unmarshal([]byte(msgData.Content), &content); err != nil {
-		return nil, errs.Wrap(err)
+func (o *chatSvr) rejectForbidden(ctx context.Context, userIDs []string) (*chat.OpenIMCallbackResp, error) {
+	userIDs = datautil.Distinct(datautil.Filter(userIDs, func(e string) (string, bool) { return e, e != "" }))
+	if len(userIDs) == 0 {
+		return callback.Continue(), nil
 	}
+	forbiddenIDs, err := o.Database.FindForbiddenUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(forbiddenIDs) > 0 {
+		return callback.Reject(eerrs.ErrForbidden.WrapMsg("user is blocked", "userIDs", forbiddenIDs)), nil
+	}
+	return callback.Continue(), nil
+}
 
-	resp := &chat.OpenIMCallbackResp{
-		ActionCode: 0,
-		NextCode:   0,
-	}
-
-	if contentData, ok := content["data"].(string); ok {
-		var innerData map[string]interface{}
-		if err := json.Unmarshal([]byte(contentData), &innerData); err != nil {
-			return nil, errs.Wrap(err)
-		}
-		if data, ok := innerData["data"].(map[string]interface{}); ok {
-			if redPacketId, ok := data["redPacketId"].(string); ok {
-				// 获取领取红包参数
-				receiveParams := map[string]interface{}{"redPacketId": redPacketId}
-				redpacketResp := &redpacket.Response{}
-				if err := o.RedPacketClient.SyncPost(ctx, msgData.SendID, "/redPacket/receive", receiveParams, redpacketResp, &o.Share.RedPacket); err != nil {
-					return &chat.OpenIMCallbackResp{
-						ActionCode: 1,
-						ErrDlt:     err.Error(),
-					}, nil
-				}
-
-				if redpacketResp.Code == redpacket.SuccessCode {
-					return &chat.OpenIMCallbackResp{
-						ActionCode: 0,
-						NextCode:   0,
-					}, nil
-				} else {
-					return &chat.OpenIMCallbackResp{
-						ActionCode: 0,
-						NextCode:   1,
-						ErrDlt:     redpacketResp.Msg,
-						ErrMsg:     redpacketResp.Msg,
-						ErrCode:    servererrs.ServerInternalError,
-					}, nil
-				}
-			}
-		}
+// 发送红包
+func (o *chatSvr) SendRedPacket(ctx context.Context, msgData *callback.MsgReq, customData *apistruct.CustomData) (*chat.OpenIMCallbackResp, error) {
+	packet, err := customData.RedPacket(o.RedPacketMaxCount)
+	if err != nil {
+		return callback.Reject(err), nil
+	}
+	// the settlement backend gets the payload as the client sent it
+	var params map[string]any
+	if err := json.Unmarshal(customData.Data, &params); err != nil {
+		return callback.Reject(eerrs.ErrRedPacketPayload.WrapMsg(err.Error())), nil
 	}
+	if err := o.createRedPacket(ctx, msgData, packet, params); err != nil {
+		return callback.Reject(err), nil
+	}
+	return callback.Continue(), nil
+}
 
-	return resp, nil
+// 领取红包
+func (o *chatSvr) ReceiveRedPacket(ctx context.Context, msgData *callback.MsgReq, customData *apistruct.CustomData) (*chat.OpenIMCallbackResp, error) {
+	receive, err := customData.ReceiveRedPacket()
+	if err != nil {
+		return callback.Reject(err), nil
+	}
+	if _, err := o.claimRedPacket(ctx, msgData, receive.RedPacketID); err != nil {
+		return callback.Reject(err), nil
+	}
+	return callback.Continue(), nil
 }
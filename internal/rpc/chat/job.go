@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+// jobLockExpire is how long a job lock outlives its holder, the lock is renewed every third of it while the job runs.
+const jobLockExpire = time.Minute
+
+// runJob calls fn every interval until ctx is done. The job lock keeps one run of name going at a time across
+// chat-rpc instances, a run that can not renew the lock has its ctx cancelled before another instance takes over.
+func (o *chatSvr) runJob(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context)) {
+	owner := uuid.New().String()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.runJobOnce(mcontext.SetOperationID(ctx, name+"_"+strconv.FormatInt(time.Now().UnixMilli(), 10)), name, owner, fn)
+		}
+	}
+}
+
+func (o *chatSvr) runJobOnce(ctx context.Context, name string, owner string, fn func(ctx context.Context)) {
+	ok, err := o.Database.LockJob(ctx, name, owner, jobLockExpire)
+	if err != nil {
+		log.ZError(ctx, "lock job failed", err, "job", name)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		if err := o.Database.UnlockJob(ctx, name, owner); err != nil {
+			log.ZError(ctx, "unlock job failed", err, "job", name)
+		}
+	}()
+	jobCtx, cancel := context.WithCancel(ctx)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		ticker := time.NewTicker(jobLockExpire / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+				ok, err := o.Database.RenewJob(jobCtx, name, owner, jobLockExpire)
+				if err == nil && ok {
+					continue
+				}
+				log.ZWarn(ctx, "renew job lock failed, job cancelled", err, "job", name, "held", ok)
+				cancel()
+				return
+			}
+		}
+	}()
+	fn(jobCtx)
+	cancel()
+	<-renewed
+}
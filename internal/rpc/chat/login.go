@@ -29,6 +29,8 @@ import (
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
@@ -240,34 +242,60 @@ func (o *chatSvr) genVerifyCode() string {
 }
 
 func (o *chatSvr) ChallengeNonce(ctx context.Context, req *chat.ChallengeNonceReq) (*chat.ChallengeNonceResp, error) {
-	resp := &chat.ChallengeNonceResp{}
+	var address common.Address
+	switch {
+	case req.Address != "":
+		if !common.IsHexAddress(req.Address) {
+			return nil, errs.ErrArgs.WrapMsg("address is invalid")
+		}
+		address = common.HexToAddress(req.Address)
+	case req.PublicKey != "":
+		var err error
+		if address, err = pubkeyToAddress(req.PublicKey); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errs.ErrArgs.WrapMsg("address or public key is empty")
+	}
+	if err := o.checkNonceRate(ctx, req.Ip, address.Hex()); err != nil {
+		return nil, err
+	}
 	nonce, err := generateNonce(16)
 	if err != nil {
 		return nil, errs.ErrInternalServer.WrapMsg("nonce generate failed!")
 	}
-	resp.Nonce = nonce
-
-	return resp, nil
+	now := time.Now()
+	message := o.SignIn.siweMessage(address, nonce, now)
+	if err := o.Database.AddNonce(ctx, nonce, address.Hex(), message, o.SignIn.ValidTime); err != nil {
+		return nil, err
+	}
+	return &chat.ChallengeNonceResp{
+		Nonce:      nonce,
+		ExpireTime: now.Add(o.SignIn.ValidTime).UnixMilli(),
+		Message:    message,
+	}, nil
 }
 
 func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (*chat.RegisterUserResp, error) {
 	resp := &chat.RegisterUserResp{}
-	var validSig bool
-	var err error
-	if validSig, err = validateSignature(req.User.PublicKey, req.Nonce, req.Signature); err != nil {
+	if req.User == nil {
+		return nil, errs.ErrArgs.WrapMsg("user is nil")
+	}
+	signer, err := o.validateSignature(ctx, req.Nonce, req.Signature)
+	if err != nil {
 		return nil, err
 	}
-	if !validSig {
-		return nil, errs.ErrArgs.WrapMsg("signature is wrong!")
+	if err := checkSigner(signer, req.User.Address, req.User.PublicKey); err != nil {
+		return nil, err
+	}
+	if req.User.PublicKey == "" {
+		req.User.PublicKey = signer
 	}
 	isAdmin, err := o.Admin.CheckNilOrAdmin(ctx)
 	ctx = o.WithAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if req.User == nil {
-		return nil, errs.ErrArgs.WrapMsg("user is nil")
-	}
 	// if req.User.Email == "" {
 	// 	if (req.User.AreaCode == "" && req.User.PhoneNumber != "") || (req.User.AreaCode != "" && req.User.PhoneNumber == "") {
 	// 		return nil, errs.ErrArgs.WrapMsg("area code or phone number error, no email provide")
@@ -401,7 +429,16 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		AllowAddFriend: constant.DefaultAllowAddFriend,
 		RegisterType:   registerType,
 	}
-	if err := o.Database.RegisterUser(ctx, register, account, attribute); err != nil {
+	key := &chatdb.UserKey{
+		UserID:     req.User.UserID,
+		Address:    common.HexToAddress(req.User.Address).Hex(),
+		PublicKey:  req.User.PublicKey,
+		DeviceID:   req.DeviceID,
+		Platform:   register.Platform,
+		Status:     constant.UserKeyActive,
+		CreateTime: register.CreateTime,
+	}
+	if err := o.Database.RegisterUser(ctx, register, account, attribute, key); err != nil {
 		return nil, err
 	}
 	// if usedInvitationCode {
@@ -410,9 +447,12 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 	// 	}
 	// }
 	if req.AutoLogin {
-		chatToken, err := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser)
+		chatToken, err := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser, req.Platform, req.DeviceID, req.Ip)
 		if err == nil {
 			resp.ChatToken = chatToken.Token
+			resp.RefreshToken = chatToken.RefreshToken
+			resp.ExpireTime = chatToken.ExpireTime
+			resp.RefreshExpireTime = chatToken.RefreshExpireTime
 		} else {
 			log.ZError(ctx, "Admin CreateToken Failed", err, "userID", req.User.UserID, "platform", req.Platform)
 		}
@@ -423,43 +463,48 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 
 func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginResp, error) {
 	resp := &chat.LoginResp{}
-	var err error
-	var attribute *chatdb.Attribute
-	if req.Address != "" {
-		attribute, err = o.Database.GetAttributeByAddress(ctx, req.Address)
-	}
+	key, err := o.resolveUserKey(ctx, req.Address)
 	if err != nil {
 		if dbutil.IsDBNotFound(err) {
 			return nil, eerrs.ErrAccountNotFound.WrapMsg("user unregistered")
 		}
 		return nil, err
 	}
-	if err := o.Admin.CheckLogin(ctx, attribute.UserID, req.Ip); err != nil {
+	if key.Status != constant.UserKeyActive {
+		return nil, eerrs.ErrUserKeyRevoked.Wrap()
+	}
+	if err := o.Admin.CheckLogin(ctx, key.UserID, req.Ip); err != nil {
 		return nil, err
 	}
-
-	var validSig bool
-	if validSig, err = validateSignature(req.PublicKey, req.Nonce, req.Signature); err != nil {
+	signer, err := o.validateSignature(ctx, req.Nonce, req.Signature)
+	if err != nil {
+		o.loginFailed(ctx, key.UserID, req.Ip)
 		return nil, err
 	}
-	if !validSig {
-		return nil, errs.ErrArgs.WrapMsg("signature is wrong!")
+	if err := checkSigner(signer, key.Address, key.PublicKey); err != nil {
+		o.loginFailed(ctx, key.UserID, req.Ip)
+		return nil, err
 	}
-	chatToken, err := o.Admin.CreateToken(ctx, attribute.UserID, constant.NormalUser)
+	o.loginSucceeded(ctx, key.UserID, req.Ip)
+	chatToken, err := o.Admin.CreateToken(ctx, key.UserID, constant.NormalUser, req.Platform, req.DeviceID, req.Ip)
 	if err != nil {
 		return nil, err
 	}
 	record := &chatdb.UserLoginRecord{
-		UserID:    attribute.UserID,
+		UserID:    key.UserID,
 		LoginTime: time.Now(),
 		IP:        req.Ip,
 		DeviceID:  req.DeviceID,
 		Platform:  constantpb.PlatformIDToName(int(req.Platform)),
+		Address:   key.Address,
 	}
 	if err := o.Database.LoginRecord(ctx, record); err != nil {
 		return nil, err
 	}
-	resp.UserID = attribute.UserID
+	resp.UserID = key.UserID
 	resp.ChatToken = chatToken.Token
+	resp.RefreshToken = chatToken.RefreshToken
+	resp.ExpireTime = chatToken.ExpireTime
+	resp.RefreshExpireTime = chatToken.RefreshExpireTime
 	return resp, nil
 }
@@ -0,0 +1,196 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/protocol/common"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+)
+
+// postNotificationKey is the key of the business notification pushed when a post notification changes.
+const postNotificationKey = "post_notification"
+
+func (o *chatSvr) GetNotifications(ctx context.Context, req *chatpb.GetNotificationsReq) (*chatpb.GetNotificationsResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	total, notifications, err := o.Database.FindNotifications(ctx, userID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	notificationsPB, err := o.notificationsDB2Pb(ctx, notifications)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetNotificationsResp{
+		Total:         uint32(total),
+		Notifications: notificationsPB,
+	}, nil
+}
+
+func (o *chatSvr) MarkNotificationsRead(ctx context.Context, req *chatpb.MarkNotificationsReadReq) (*chatpb.MarkNotificationsReadResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.MarkNotificationsRead(ctx, userID, req.NotificationIDs); err != nil {
+		return nil, err
+	}
+	return &chatpb.MarkNotificationsReadResp{}, nil
+}
+
+func (o *chatSvr) GetUnreadNotificationCount(ctx context.Context, req *chatpb.GetUnreadNotificationCountReq) (*chatpb.GetUnreadNotificationCountResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count, err := o.Database.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetUnreadNotificationCountResp{Count: count}, nil
+}
+
+// notificationsDB2Pb fills the actors of the notifications with their public info.
+func (o *chatSvr) notificationsDB2Pb(ctx context.Context, notifications []*chat.Notification) ([]*chatpb.PostNotification, error) {
+	var userIDs []string
+	for _, notification := range notifications {
+		userIDs = append(userIDs, notification.ActorUserIDs...)
+	}
+	attributes, err := o.Database.FindAttribute(ctx, datautil.Distinct(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	attributeMap := datautil.SliceToMap(attributes, func(e *chat.Attribute) string { return e.UserID })
+	return datautil.Slice(notifications, func(notification *chat.Notification) *chatpb.PostNotification {
+		return &chatpb.PostNotification{
+			NotificationID: notification.NotificationID,
+			Type:           notification.Type,
+			PostID:         notification.PostID,
+			SourcePostID:   notification.SourcePostID,
+			Actors: datautil.Batch(func(userID string) *common.UserPublicInfo {
+				return convert.DbToPbAttribute(attributeMap[userID])
+			}, notification.ActorUserIDs),
+			ActorCount: notification.ActorCount,
+			IsRead:     notification.IsRead,
+			CreateTime: notification.CreateTime.UnixMilli(),
+			UpdateTime: notification.UpdateTime.UnixMilli(),
+		}
+	}), nil
+}
+
+// notifyPost tells userID that actorUserID acted on postID, self interactions are skipped.
+// Notifications are best effort, a failure is logged and does not fail the interaction.
+func (o *chatSvr) notifyPost(ctx context.Context, userID string, typ int32, postID string, sourcePostID string, actorUserID string) {
+	if userID == "" || userID == actorUserID {
+		return
+	}
+	now := time.Now()
+	notification, err := o.Database.AddNotification(ctx, &chat.Notification{
+		NotificationID: uuid.New().String(),
+		UserID:         userID,
+		Type:           typ,
+		PostID:         postID,
+		SourcePostID:   sourcePostID,
+		ActorUserIDs:   []string{actorUserID},
+		CreateTime:     now,
+		UpdateTime:     now,
+	})
+	if err != nil {
+		log.ZWarn(ctx, "add post notification failed", err, "userID", userID, "type", typ, "postID", postID)
+		return
+	}
+	if err := o.pushNotification(ctx, notification); err != nil {
+		log.ZWarn(ctx, "push post notification failed", err, "userID", userID, "notificationID", notification.NotificationID)
+	}
+}
+
+// notifyMentions notifies the users in atUserIDs that can see post that they were mentioned in it.
+func (o *chatSvr) notifyMentions(ctx context.Context, post *chat.PostDB, atUserIDs []string) {
+	userIDs, err := o.mentionedUserIDs(ctx, post, atUserIDs)
+	if err != nil {
+		log.ZWarn(ctx, "find mentioned users failed", err, "postID", post.PostID)
+		return
+	}
+	for _, userID := range userIDs {
+		o.notifyPost(ctx, userID, constant.NotificationMention, post.PostID, "", post.UserID)
+	}
+}
+
+// mentionedUserIDs 返回 atUserIDs 中可以看到帖子的用户, 与帖子查询的可见范围一致
+func (o *chatSvr) mentionedUserIDs(ctx context.Context, post *chat.PostDB, atUserIDs []string) ([]string, error) {
+	atUserIDs = datautil.Distinct(atUserIDs)
+	switch post.Visibility {
+	case constant.PostVisibilityPrivate:
+		return nil, nil
+	case constant.PostVisibilityCustom:
+		return datautil.Filter(atUserIDs, func(userID string) (string, bool) {
+			return userID, datautil.Contain(userID, post.VisibleUserIDs...)
+		}), nil
+	case constant.PostVisibilityFollowers:
+		return o.Database.FilterFollowerUserIDs(ctx, post.UserID, atUserIDs)
+	case constant.PostVisibilityFriends:
+		// 互相关注即为好友
+		followerUserIDs, err := o.Database.FilterFollowerUserIDs(ctx, post.UserID, atUserIDs)
+		if err != nil {
+			return nil, err
+		}
+		return o.Database.FilterFollowedUserIDs(ctx, post.UserID, followerUserIDs)
+	default:
+		return atUserIDs, nil
+	}
+}
+
+// pushNotification sends the notification to the online clients of its user as an OpenIM business notification.
+func (o *chatSvr) pushNotification(ctx context.Context, notification *chat.Notification) error {
+	notificationsPB, err := o.notificationsDB2Pb(ctx, []*chat.Notification{notification})
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(notificationsPB[0])
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	token, err := o.IMApi.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = o.IMApi.SendMsg(mctx.WithApiToken(ctx, token), &imapi.SendMsgReq{
+		SendID:           o.Share.OpenIM.AdminUserID,
+		RecvID:           notification.UserID,
+		SenderPlatformID: constantpb.AdminPlatformID,
+		Content:          map[string]any{"key": postNotificationKey, "data": string(data)},
+		ContentType:      constantpb.BusinessNotification,
+		SessionType:      constantpb.SingleChatType,
+		IsOnlineOnly:     true,
+	})
+	return err
+}
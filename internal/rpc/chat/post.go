@@ -10,8 +10,9 @@ import (
 	"time"
 
 	"github.com/openimsdk/chat/pkg/redpacket/servererrs"
-	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/encrypt"
 	"go.mongodb.org/mongo-driver/mongo"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/openimsdk/chat/pkg/common/convert"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
 	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/errs"
 )
@@ -29,12 +31,15 @@ func (o *chatSvr) PublishPost(ctx context.Context, req *chatpb.PublishPostReq) (
 		return nil, err
 	}
 	postDB := &chat.PostDB{
-		UserID:       userID,
-		AllowComment: req.AllowComment,
-		AllowForward: req.AllowForward,
-		Content:      req.Content.Value,
-		AtUserIds:    req.AtUserIds,
-		MediaMsgs:    convert.PostMediasPb2DB(req.MediaMsgs),
+		UserID:         userID,
+		AllowComment:   req.AllowComment,
+		AllowForward:   req.AllowForward,
+		Content:        req.Content.Value,
+		AtUserIds:      req.AtUserIds,
+		Hashtags:       parseHashtags(req.Content.Value),
+		MediaMsgs:      convert.PostMediasPb2DB(req.MediaMsgs),
+		Visibility:     req.Visibility,
+		VisibleUserIDs: req.VisibleUserIDs,
 	}
 	if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
 		return nil, err
@@ -43,6 +48,8 @@ func (o *chatSvr) PublishPost(ctx context.Context, req *chatpb.PublishPostReq) (
 	if err != nil {
 		return nil, err
 	}
+	o.pushTimeline(ctx, postDB)
+	o.notifyMentions(ctx, postDB, postDB.AtUserIds)
 	post, err := o.Database.GetPostByID(ctx, postDB.PostID)
 	if err != nil {
 		return nil, err
@@ -58,59 +65,30 @@ func (o *chatSvr) ForwardPost(ctx context.Context, req *chatpb.ForwardPostReq) (
 		return nil, err
 	}
 
-	err = o.tx.Transaction(ctx, func(ctx context.Context) error {
-
-		if req.IsForwarded == constant.Forwarded {
-			postDB := &chat.PostDB{
-				UserID:        userID,
-				ForwardPostID: req.ForwardPostID,
-			}
-			if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
-				return err
-			}
-			if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
-				return err
-			}
-
-			relation, err := o.Database.GetUserPostRelation(ctx, userID, req.ForwardPostID)
-			switch {
-			case err == nil:
-				relation.IsForwarded = constant.Forwarded
-				err = o.Database.UpdateUserPostRelation(ctx, userID, req.ForwardPostID, map[string]any{"is_forwarded": relation.IsForwarded})
-				if err != nil {
-					return err
-				}
-			case errors.Is(err, mongo.ErrNoDocuments):
-				relation = &chat.UserPostRelation{
-					UserID:      userID,
-					PostID:      req.ForwardPostID,
-					IsForwarded: constant.Forwarded,
-				}
-				err = o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation})
-				if err != nil {
-					return err
-				}
-			default:
-				return err
-			}
-
-		} else {
-			if err := o.Database.UpdateUserPostRelation(ctx, userID, req.ForwardPostID, map[string]any{"is_forwarded": constant.NotForwarded}); err != nil {
-				return err
-			}
-			post, err := o.Database.GetPostByForwardPostID(ctx, userID, req.ForwardPostID)
-			if err != nil {
-				return err
-			}
-			if err := o.Database.DeletePost(ctx, []string{post.PostID}); err != nil {
-				return err
-			}
+	if req.IsForwarded == constant.Forwarded {
+		forwardPost, err := o.Database.GetPostByID(ctx, req.ForwardPostID)
+		if err != nil {
+			return nil, err
+		}
+		if err := o.checkPostInteraction(ctx, userID, forwardPost, postActionForward); err != nil {
+			return nil, err
+		}
+		postDB := &chat.PostDB{
+			UserID:        userID,
+			ForwardPostID: req.ForwardPostID,
+		}
+		if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
+			return nil, err
+		}
+		if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
+			return nil, err
+		}
+		o.pushTimeline(ctx, postDB)
+		o.notifyPost(ctx, forwardPost.UserID, constant.NotificationForward, forwardPost.PostID, postDB.PostID, userID)
+	} else {
+		if err := o.Database.UnforwardPost(ctx, userID, req.ForwardPostID); err != nil {
+			return nil, err
 		}
-
-		return nil
-	})
-	if err != nil {
-		return nil, err
 	}
 
 	return &chatpb.ForwardPostResp{
@@ -123,50 +101,39 @@ func (o *chatSvr) CommentPost(ctx context.Context, req *chatpb.CommentPostReq) (
 	if err != nil {
 		return nil, err
 	}
+	targetPost, err := o.Database.GetPostByID(ctx, req.CommentPostID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkPostInteraction(ctx, userID, targetPost, postActionComment); err != nil {
+		return nil, err
+	}
+	// 查询结果只对作者返回自定义可见用户, 这里需要原始数据
+	commentPost, err := o.Database.TakePostDB(ctx, req.CommentPostID)
+	if err != nil {
+		return nil, err
+	}
+	// 评论与被评论的帖子可见范围一致
 	postDB := &chat.PostDB{
-		UserID:        userID,
-		CommentPostID: req.CommentPostID,
-		AllowComment:  req.AllowComment,
-		AllowForward:  req.AllowForward,
-		Content:       req.Content.Value,
-		AtUserIds:     req.AtUserIds,
-		MediaMsgs:     convert.PostMediasPb2DB(req.MediaMsgs),
+		UserID:         userID,
+		CommentPostID:  req.CommentPostID,
+		RootPostID:     commentRootPostID(commentPost),
+		AllowComment:   req.AllowComment,
+		AllowForward:   req.AllowForward,
+		Content:        req.Content.Value,
+		AtUserIds:      req.AtUserIds,
+		MediaMsgs:      convert.PostMediasPb2DB(req.MediaMsgs),
+		Visibility:     commentPost.Visibility,
+		VisibleUserIDs: commentVisibleUserIDs(commentPost.UserID, commentPost.Visibility, commentPost.VisibleUserIDs),
 	}
 	if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
 		return nil, err
 	}
-	relation, err := o.Database.GetUserPostRelation(ctx, userID, req.CommentPostID)
-	switch {
-	case err == nil:
-		relation.IsCommented = constant.Commented
-		err = o.Database.UpdateUserPostRelation(ctx, userID, req.CommentPostID, map[string]any{"is_commented": relation.IsCommented})
-		if err != nil {
-			return nil, err
-		}
-	case errors.Is(err, mongo.ErrNoDocuments):
-		err = tx.Tx.Transaction(o.tx, ctx, func(ctx context.Context) error {
-			relation = &chat.UserPostRelation{
-				UserID:      userID,
-				PostID:      req.CommentPostID,
-				IsCommented: constant.Commented,
-			}
-			if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
-				return err
-			}
-			if err := o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation}); err != nil {
-				return err
-			}
-
-			return nil
-		})
-
-		if err != nil {
-			return nil, err
-		}
-
-	default:
+	if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
 		return nil, err
 	}
+	o.notifyPost(ctx, commentPost.UserID, constant.NotificationComment, commentPost.PostID, postDB.PostID, userID)
+	o.notifyMentions(ctx, postDB, postDB.AtUserIds)
 	post, err := o.Database.GetPostByID(ctx, postDB.PostID)
 	if err != nil {
 		return nil, err
@@ -224,14 +191,24 @@ func (o *chatSvr) ReferencePost(ctx context.Context, req *chatpb.ReferencePostRe
 	if err != nil {
 		return nil, err
 	}
+	refPost, err := o.Database.GetPostByID(ctx, req.RefPostID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkPostInteraction(ctx, userID, refPost, postActionReference); err != nil {
+		return nil, err
+	}
 	postDB := &chat.PostDB{
-		UserID:       userID,
-		RefPostID:    req.RefPostID,
-		Content:      req.Content.Value,
-		AllowComment: req.AllowComment,
-		AllowForward: req.AllowForward,
-		AtUserIds:    req.AtUserIds,
-		MediaMsgs:    convert.PostMediasPb2DB(req.MediaMsgs),
+		UserID:         userID,
+		RefPostID:      req.RefPostID,
+		Content:        req.Content.Value,
+		AllowComment:   req.AllowComment,
+		AllowForward:   req.AllowForward,
+		AtUserIds:      req.AtUserIds,
+		Hashtags:       parseHashtags(req.Content.Value),
+		MediaMsgs:      convert.PostMediasPb2DB(req.MediaMsgs),
+		Visibility:     req.Visibility,
+		VisibleUserIDs: req.VisibleUserIDs,
 	}
 	if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
 		return nil, err
@@ -240,9 +217,22 @@ func (o *chatSvr) ReferencePost(ctx context.Context, req *chatpb.ReferencePostRe
 	if err != nil {
 		return nil, err
 	}
+	o.pushTimeline(ctx, postDB)
+	o.notifyPost(ctx, refPost.UserID, constant.NotificationReference, refPost.PostID, postDB.PostID, userID)
+	o.notifyMentions(ctx, postDB, postDB.AtUserIds)
 	return &chatpb.ReferencePostResp{}, nil
 }
 
+// pushTimeline 推送帖子到关注者的时间线, 失败的时间线在过期重建前缺少该帖子
+func (o *chatSvr) pushTimeline(ctx context.Context, post *chat.PostDB) {
+	if o.PostTimeline.Length <= 0 {
+		return
+	}
+	if err := o.Database.FanOutPost(ctx, post, o.PostTimeline); err != nil {
+		log.ZWarn(ctx, "fan out post failed", err, "postID", post.PostID)
+	}
+}
+
 func (o *chatSvr) ChangeLikePost(ctx context.Context, req *chatpb.LikePostReq) (*chatpb.LikePostResp, error) {
 	opUserID, err := mctx.CheckUser(ctx)
 	if err != nil {
@@ -253,37 +243,23 @@ func (o *chatSvr) ChangeLikePost(ctx context.Context, req *chatpb.LikePostReq) (
 		return nil, err
 	}
 
-	isLiked := constant.NotLiked
+	isLiked := int32(constant.NotLiked)
 	if req.IsLiked == constant.Liked {
 		isLiked = constant.Liked
-	}
-
-	relation, err := o.Database.GetUserPostRelation(ctx, opUserID, post.PostID)
-	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			relation = &chat.UserPostRelation{
-				UserID:  opUserID,
-				PostID:  post.PostID,
-				IsLiked: int32(isLiked),
-			}
-			err = o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation})
-			if err != nil {
-				return nil, err
-			}
-		} else {
+		if err := o.checkPostInteraction(ctx, opUserID, post, postActionLike); err != nil {
 			return nil, err
 		}
+	}
 
-	} else {
-		relation.IsLiked = int32(isLiked)
-		err = o.Database.UpdateUserPostRelation(ctx, opUserID, post.PostID, map[string]any{"is_liked": relation.IsLiked})
-		if err != nil {
-			return nil, err
-		}
+	if err := o.Database.SetUserPostRelation(ctx, opUserID, post.PostID, "is_liked", isLiked); err != nil {
+		return nil, err
+	}
+	if isLiked == constant.Liked {
+		o.notifyPost(ctx, post.UserID, constant.NotificationLike, post.PostID, "", opUserID)
 	}
 
 	return &chatpb.LikePostResp{
-		IsLiked: relation.IsLiked,
+		IsLiked: isLiked,
 	}, nil
 }
 
@@ -297,38 +273,20 @@ func (o *chatSvr) ChangeCollectPost(ctx context.Context, req *chatpb.CollectPost
 		return nil, err
 	}
 
-	isCollected := constant.NotCollected
+	isCollected := int32(constant.NotCollected)
 	if req.IsCollected == constant.Collected {
 		isCollected = constant.Collected
-	}
-
-	relation, err := o.Database.GetUserPostRelation(ctx, opUserID, post.PostID)
-	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			relation = &chat.UserPostRelation{
-				UserID:      opUserID,
-				PostID:      post.PostID,
-				IsCollected: int32(isCollected),
-			}
-			err = o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation})
-			if err != nil {
-				return nil, err
-			}
-
-		} else {
+		if err := o.checkPostInteraction(ctx, opUserID, post, postActionCollect); err != nil {
 			return nil, err
 		}
+	}
 
-	} else {
-		relation.IsCollected = int32(isCollected)
-		err = o.Database.UpdateUserPostRelation(ctx, opUserID, post.PostID, map[string]any{"is_collected": relation.IsCollected})
-		if err != nil {
-			return nil, err
-		}
+	if err := o.Database.SetUserPostRelation(ctx, opUserID, post.PostID, "is_collected", isCollected); err != nil {
+		return nil, err
 	}
 
 	return &chatpb.CollectPostResp{
-		IsCollected: relation.IsCollected,
+		IsCollected: isCollected,
 	}, nil
 }
 
@@ -390,6 +348,128 @@ func (o *chatSvr) ChangeAllowForwardPost(ctx context.Context, req *chatpb.Change
 	}, nil
 }
 
+func (o *chatSvr) EditPost(ctx context.Context, req *chatpb.EditPostReq) (*chatpb.EditPostResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != opUserID {
+		return nil, errs.ErrNoPermission.WrapMsg("permission denied")
+	}
+	if post.ForwardPostID != "" {
+		return nil, errs.ErrArgs.WrapMsg("forwarded post can not be edited")
+	}
+	if o.PostEditWindow > 0 && time.Since(post.CreateTime) > o.PostEditWindow {
+		return nil, eerrs.ErrPostEditExpired.WrapMsg("post can only be edited within " + o.PostEditWindow.String())
+	}
+	// 保存被替换的内容, 首次编辑前的内容发布于帖子创建时
+	createTime := post.CreateTime
+	if post.EditCount > 0 {
+		createTime = post.EditTime
+	}
+	now := time.Now()
+	revision := &chat.PostRevision{
+		PostID:     post.PostID,
+		Version:    post.EditCount + 1,
+		Content:    post.Content,
+		AtUserIds:  post.AtUserIds,
+		MediaMsgs:  post.MediaMsgs,
+		CreateTime: createTime,
+		EditTime:   now,
+	}
+	data := map[string]any{
+		"content":     req.Content.GetValue(),
+		"at_user_ids": req.AtUserIds,
+		"media_msgs":  convert.PostMediasPb2DB(req.MediaMsgs),
+		"edit_count":  revision.Version,
+		"edit_time":   now,
+	}
+	if err := o.Database.EditPost(ctx, revision, data, parseHashtags(req.Content.GetValue())); err != nil {
+		return nil, err
+	}
+	// 只通知编辑后新提及的用户
+	if atUserIDs := datautil.Filter(req.AtUserIds, func(userID string) (string, bool) {
+		return userID, !datautil.Contain(userID, post.AtUserIds...)
+	}); len(atUserIDs) > 0 {
+		if postDB, err := o.Database.TakePostDB(ctx, req.PostID); err != nil {
+			log.ZWarn(ctx, "take edited post failed", err, "postID", req.PostID)
+		} else {
+			o.notifyMentions(ctx, postDB, atUserIDs)
+		}
+	}
+	post, err = o.Database.GetPostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.EditPostResp{
+		Post: convert.PostDB2Pb(post),
+	}, nil
+}
+
+func (o *chatSvr) GetPostRevisions(ctx context.Context, req *chatpb.GetPostRevisionsReq) (*chatpb.GetPostRevisionsResp, error) {
+	// 帖子不可见时不返回编辑历史
+	if _, err := o.Database.GetPostByID(ctx, req.PostID); err != nil {
+		return nil, err
+	}
+	revisions, err := o.Database.FindPostRevisions(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetPostRevisionsResp{
+		Revisions: convert.PostRevisionsDB2Pb(revisions),
+	}, nil
+}
+
+func (o *chatSvr) ChangePostVisibility(ctx context.Context, req *chatpb.ChangePostVisibilityReq) (*chatpb.ChangePostVisibilityResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != opUserID {
+		return nil, errs.ErrNoPermission.WrapMsg("permission denied")
+	}
+	if post.CommentPostID != "" {
+		return nil, errs.ErrArgs.WrapMsg("comment visibility follows the commented post")
+	}
+	err = o.Database.UpdatePostVisibility(ctx, req.PostID, req.Visibility, req.VisibleUserIDs, commentVisibleUserIDs(post.UserID, req.Visibility, req.VisibleUserIDs))
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.ChangePostVisibilityResp{
+		PostID:     req.PostID,
+		Visibility: req.Visibility,
+	}, nil
+}
+
+// commentRootPostID 返回回复 post 的评论所属的帖子
+func commentRootPostID(post *chat.PostDB) string {
+	switch {
+	case post.CommentPostID == "":
+		return post.PostID
+	case post.RootPostID != "":
+		return post.RootPostID
+	default:
+		// 早于评论串的评论没有记录所属帖子
+		return post.CommentPostID
+	}
+}
+
+// commentVisibleUserIDs 返回评论的自定义可见用户, 被评论帖子的作者始终可见
+func commentVisibleUserIDs(authorID string, visibility int32, visibleUserIDs []string) []string {
+	if visibility != constant.PostVisibilityCustom {
+		return nil
+	}
+	return datautil.Distinct(append([]string{authorID}, visibleUserIDs...))
+}
+
 func (o *chatSvr) DeletePost(ctx context.Context, req *chatpb.DeletePostReq) (*chatpb.DeletePostResp, error) {
 	opUserID, err := mctx.CheckUser(ctx)
 	if err != nil {
@@ -403,7 +483,7 @@ func (o *chatSvr) DeletePost(ctx context.Context, req *chatpb.DeletePostReq) (*c
 		return nil, errs.ErrNoPermission.WrapMsg("permission denied")
 	}
 
-	err = o.Database.DeletePost(ctx, []string{req.PostID})
+	err = o.Database.SoftDeletePost(ctx, req.PostID)
 	if err != nil {
 		return nil, err
 	}
@@ -411,11 +491,86 @@ func (o *chatSvr) DeletePost(ctx context.Context, req *chatpb.DeletePostReq) (*c
 	return &chatpb.DeletePostResp{}, nil
 }
 
+func (o *chatSvr) GetUserCounters(ctx context.Context, req *chatpb.GetUserCountersReq) (*chatpb.GetUserCountersResp, error) {
+	userIDs := datautil.Distinct(req.UserIDs)
+	counters, err := o.Database.FindUserCounters(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetUserCountersResp{
+		Counters: convert.UserCountersDB2Pb(userIDs, counters),
+	}, nil
+}
+
+func (o *chatSvr) RestorePost(ctx context.Context, req *chatpb.RestorePostReq) (*chatpb.RestorePostResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := o.Database.TakePostDB(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != opUserID {
+		return nil, errs.ErrNoPermission.WrapMsg("permission denied")
+	}
+	if post.Removed {
+		return nil, errs.ErrNoPermission.WrapMsg("post was removed by an admin")
+	}
+	// 随帖子删除的评论只能随帖子一起恢复
+	if post.Deleted != constant.PostDeleted || post.DeleteRootID != post.PostID {
+		return nil, errs.ErrArgs.WrapMsg("post is not in the trash")
+	}
+	if time.Since(post.DeleteTime) > o.PostTrashWindow {
+		return nil, eerrs.ErrPostRestoreExpired.WrapMsg("post can only be restored within " + o.PostTrashWindow.String())
+	}
+	if post.CommentPostID != "" {
+		commentPost, err := o.Database.TakePostDB(ctx, post.CommentPostID)
+		if err != nil {
+			return nil, err
+		}
+		if commentPost.Deleted == constant.PostDeleted {
+			return nil, errs.ErrArgs.WrapMsg("commented post is deleted")
+		}
+	}
+	if err := o.Database.RestorePost(ctx, req.PostID); err != nil {
+		return nil, err
+	}
+	restored, err := o.Database.GetPostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.RestorePostResp{
+		Post: convert.PostDB2Pb(restored),
+	}, nil
+}
+
+func (o *chatSvr) GetDeletedPostList(ctx context.Context, req *chatpb.GetDeletedPostListReq) (*chatpb.GetDeletedPostListResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chatpb.GetDeletedPostListResp{}
+	postsDB, nextCursor, err := o.Database.GetDeletedPostsByCursorAndUser(ctx, req.NextCursor, opUserID, int64(req.Count))
+	if err != nil {
+		return nil, err
+	}
+	resp.Posts = convert.PostsDB2Pb(postsDB)
+	resp.NextCursor = nextCursor
+	return resp, nil
+}
+
 func (o *chatSvr) GetPostByID(ctx context.Context, req *chatpb.GetPostByIDReq) (*chatpb.GetPostByIDResp, error) {
 	post, err := o.Database.GetPostByID(ctx, req.PostID)
 	if err != nil {
 		return nil, err
 	}
+	// views are counted per user, a request without one is not counted
+	if opUserID := mcontext.GetOpUserID(ctx); o.PostViewFlushInterval > 0 && opUserID != "" {
+		if err := o.Database.AddPostView(ctx, req.PostID, opUserID, o.PostViewDedupe); err != nil {
+			log.ZWarn(ctx, "add post view failed", err, "postID", req.PostID)
+		}
+	}
 	postPB := convert.PostDB2Pb(post)
 	return &chatpb.GetPostByIDResp{
 		Post: postPB,
@@ -431,20 +586,14 @@ func (o *chatSvr) GetPostListByUser(ctx context.Context, req *chatpb.GetPostList
 	postsPB := convert.PostsDB2Pb(postsDB)
 
 	resp.Posts = postsPB
-	if nextCursor != "" {
-		nextCursorInt, err := strconv.ParseInt(nextCursor, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		resp.NextCursor = nextCursorInt
-	}
+	resp.NextCursor = nextCursor
 	return resp, nil
 }
 
 func (o *chatSvr) GetAllTypePost(ctx context.Context, req *chatpb.GetAllTypePostReq) (*chatpb.GetAllTypePostResp, error) {
 	resp := &chatpb.GetAllTypePostResp{}
 	var allPosts []*chatpb.TypePosts
-	postTypes := []int32{constant.Follow, constant.Subscribe, constant.Reply, constant.Like, constant.Collect}
+	postTypes := []int32{constant.Follow, constant.Subscribe, constant.Reply, constant.Like, constant.Collect, constant.Discover}
 	for _, postType := range postTypes {
 		paginationReq := &chatpb.GetPostListReq{
 			Type:  postType,
@@ -483,9 +632,17 @@ func (o *chatSvr) GetPostList(ctx context.Context, req *chatpb.GetPostListReq) (
 	/// 回复 获取帖子被回复的帖子 （除了这个类型，其他不返回回复的帖子）
 	/// 点赞 获取帖子被点赞的帖子
 	/// 收藏 获取帖子被收藏的帖子
+	/// 发现 按热度获取公开的帖子
 
 	switch req.Type {
 	case constant.Follow:
+		if o.PostTimeline.Length > 0 {
+			postsDB, nextCursor, err = o.Database.GetTimelinePosts(ctx, userID, cursor, int64(req.Count), o.PostTimeline)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
 		userIDs, err := o.Database.GetFollowedUserIDs(ctx, userID)
 		if err != nil {
 			return nil, err
@@ -532,6 +689,11 @@ func (o *chatSvr) GetPostList(ctx context.Context, req *chatpb.GetPostListReq) (
 		if err != nil {
 			return nil, err
 		}
+	case constant.Discover:
+		postsDB, nextCursor, err = o.Database.GetDiscoverPostsByCursor(ctx, cursor, int64(req.Count))
+		if err != nil {
+			return nil, err
+		}
 	default:
 		postsDB, nextCursor, err = o.Database.GetPostsByCursorAndUser(ctx, cursor, userID, int64(req.Count))
 		if err != nil {
@@ -541,38 +703,85 @@ func (o *chatSvr) GetPostList(ctx context.Context, req *chatpb.GetPostListReq) (
 	postsPB := convert.PostsDB2Pb(postsDB)
 
 	resp.Posts = postsPB
-	if nextCursor != "" {
-		nextCursorInt, err := strconv.ParseInt(nextCursor, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		resp.NextCursor = nextCursorInt
-	}
+	resp.NextCursor = nextCursor
 	return resp, nil
 }
 
 func (o *chatSvr) GetCommentPostListByPostID(ctx context.Context, req *chatpb.GetCommentPostListByPostIDReq) (*chatpb.GetCommentPostListByPostIDResp, error) {
+	// 被评论的帖子不可见时不返回评论
+	if _, err := o.Database.GetPostByID(ctx, req.PostID); err != nil {
+		return nil, err
+	}
 	resp := &chatpb.GetCommentPostListByPostIDResp{}
-	postsDB, nextCursor, err := o.Database.GetCommentPostsByPostID(ctx, req.NextCursor, req.PostID, int64(req.Count))
+	postsDB, nextCursor, err := o.Database.GetCommentPostsByPostID(ctx, req.NextCursor, req.PostID, req.Sort, int64(req.Count))
 	if err != nil {
 		return nil, err
 	}
 	postsPB := convert.PostsDB2Pb(postsDB)
+	if err := o.fillCommentReplies(ctx, postsPB, req.Sort, int(req.Depth), int64(req.ReplyCount)); err != nil {
+		return nil, err
+	}
 
 	resp.Posts = postsPB
-	if nextCursor != "" {
-		nextCursorInt, err := strconv.ParseInt(nextCursor, 10, 64)
+	resp.NextCursor = nextCursor
+	return resp, nil
+}
+
+// fillCommentReplies 逐层填充评论的回复, 每层一次查询
+func (o *chatSvr) fillCommentReplies(ctx context.Context, comments []*chatpb.Post, sort int32, depth int, count int64) error {
+	if count <= 0 {
+		return nil
+	}
+	for level := 0; level < depth && len(comments) > 0; level++ {
+		commentPostIDs := datautil.Slice(comments, func(e *chatpb.Post) string { return e.PostID })
+		replies, err := o.Database.FindCommentReplies(ctx, commentPostIDs, sort, count)
+		if err != nil {
+			return err
+		}
+		var next []*chatpb.Post
+		for _, comment := range comments {
+			comment.Replies = convert.PostsDB2Pb(replies[comment.PostID])
+			next = append(next, comment.Replies...)
+		}
+		comments = next
+	}
+	return nil
+}
+
+func (o *chatSvr) PinComment(ctx context.Context, req *chatpb.PinCommentReq) (*chatpb.PinCommentResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != userID {
+		return nil, errs.ErrNoPermission.WrapMsg("only the author can pin a comment")
+	}
+	var pinnedCommentID string
+	if req.IsPinned == constant.Pinned {
+		comment, err := o.Database.GetPostByID(ctx, req.CommentPostID)
 		if err != nil {
 			return nil, err
 		}
-		resp.NextCursor = nextCursorInt
+		if comment.CommentPostID != req.PostID {
+			return nil, errs.ErrArgs.WrapMsg("not a comment of the post")
+		}
+		pinnedCommentID = req.CommentPostID
+	} else if post.PinnedCommentID != req.CommentPostID {
+		return &chatpb.PinCommentResp{}, nil
 	}
-	return resp, nil
+	if err := o.Database.UpdatePost(ctx, req.PostID, map[string]any{"pinned_comment_id": pinnedCommentID}); err != nil {
+		return nil, err
+	}
+	return &chatpb.PinCommentResp{}, nil
 }
 
 func (o *chatSvr) GenPostID(ctx context.Context, postID *string) error {
 	if *postID != "" {
-		_, err := o.Database.GetPostByID(ctx, *postID)
+		_, err := o.Database.TakePostDB(ctx, *postID)
 		if err == nil {
 			return servererrs.ErrGroupIDExisted.WrapMsg("post id existed " + *postID)
 		} else if IsNotFound(err) {
@@ -586,7 +795,7 @@ func (o *chatSvr) GenPostID(ctx context.Context, postID *string) error {
 		bi := big.NewInt(0)
 		bi.SetString(id[0:8], 16)
 		id = bi.String()
-		_, err := o.Database.GetPostByID(ctx, id)
+		_, err := o.Database.TakePostDB(ctx, id)
 		if err == nil {
 			continue
 		} else if IsNotFound(err) {
@@ -0,0 +1,67 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	counterReconcileJob   = "counter_reconcile"
+	counterReconcileBatch = 100
+)
+
+// reconcileCounters recounts the post and user counters and repairs the ones that drifted,
+// follower counts are only refreshed here since follows are written outside chat.
+func (o *chatSvr) reconcileCounters(ctx context.Context) {
+	var postRepaired int
+	for afterPostID := ""; ; {
+		lastPostID, n, err := o.Database.ReconcilePostCounters(ctx, afterPostID, counterReconcileBatch)
+		if err != nil {
+			log.ZError(ctx, "reconcile post counters failed", err, "afterPostID", afterPostID)
+			return
+		}
+		postRepaired += n
+		if lastPostID == "" {
+			break
+		}
+		afterPostID = lastPostID
+	}
+	var userRepaired int
+	for pageNumber := 1; ; pageNumber++ {
+		_, userIDs, err := o.Database.GetAllUserID(ctx, &sdkws.RequestPagination{PageNumber: int32(pageNumber), ShowNumber: counterReconcileBatch})
+		if err != nil {
+			log.ZError(ctx, "get user ids failed", err, "pageNumber", pageNumber)
+			return
+		}
+		if len(userIDs) > 0 {
+			n, err := o.Database.ReconcileUserCounters(ctx, userIDs)
+			if err != nil {
+				log.ZError(ctx, "reconcile user counters failed", err, "pageNumber", pageNumber)
+				return
+			}
+			userRepaired += n
+		}
+		if len(userIDs) < counterReconcileBatch {
+			break
+		}
+	}
+	if postRepaired > 0 || userRepaired > 0 {
+		log.ZInfo(ctx, "reconciled counters", "posts", postRepaired, "users", userRepaired)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+const (
+	discoverScoreJob   = "discover_score"
+	discoverScoreBatch = 100
+)
+
+// discoverScore 发现页热度分数, 互动计数按权重求和后取对数, 加上随发布时间增长的时间分
+// 每过 DecayHours 时间分加 1, 相当于旧帖子的热度按十倍衰减, 分数只随计数变化, 不需要定时全部重算
+func discoverScore(post *chat.PostDB, config *chat.DiscoverConfig) float64 {
+	engagement := config.LikeWeight*float64(post.LikeCount) +
+		config.CommentWeight*float64(post.CommentCount) +
+		config.ForwardWeight*float64(post.ForwardCount) +
+		config.ViewWeight*float64(post.ViewCount)
+	decay := config.DecayHours * float64(time.Hour/time.Second)
+	return math.Log10(math.Max(engagement, 1)) + post.DiscoverBoost + float64(post.CreateTime.Unix())/decay
+}
+
+// discoverable 只有公开且未删除、未隐藏的原创帖子进入发现页, 评论与转发不计算分数
+func discoverable(post *chat.PostDB) bool {
+	return post.Deleted != constant.PostDeleted && post.Hidden != constant.PostHidden &&
+		post.CommentPostID == "" && post.ForwardPostID == "" &&
+		post.Visibility == constant.PostVisibilityPublic
+}
+
+// scoreDiscoverPosts scores the posts whose engagement changed.
+func (o *chatSvr) scoreDiscoverPosts(ctx context.Context) {
+	config, err := o.Database.GetDiscoverConfig(ctx)
+	if err != nil {
+		log.ZError(ctx, "get discover config failed", err)
+		return
+	}
+	var total int
+	for {
+		posts, err := o.Database.TakeDiscoverDirty(ctx, discoverScoreBatch)
+		if err != nil {
+			log.ZError(ctx, "take discover dirty posts failed", err)
+			return
+		}
+		scores := make(map[string]float64, len(posts))
+		for _, post := range posts {
+			if discoverable(post) {
+				scores[post.PostID] = discoverScore(post, config)
+			}
+		}
+		if err := o.Database.SetDiscoverScores(ctx, scores); err != nil {
+			log.ZError(ctx, "set discover scores failed", err)
+			return
+		}
+		total += len(scores)
+		if len(posts) < discoverScoreBatch {
+			break
+		}
+	}
+	if total > 0 {
+		log.ZDebug(ctx, "scored discover posts", "count", total)
+	}
+}
+
+func (o *chatSvr) GetDiscoverConfig(ctx context.Context, req *chatpb.GetDiscoverConfigReq) (*chatpb.GetDiscoverConfigResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	config, err := o.Database.GetDiscoverConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetDiscoverConfigResp{
+		Config: &chatpb.DiscoverConfig{
+			LikeWeight:    config.LikeWeight,
+			CommentWeight: config.CommentWeight,
+			ForwardWeight: config.ForwardWeight,
+			ViewWeight:    config.ViewWeight,
+			DecayHours:    config.DecayHours,
+		},
+	}, nil
+}
+
+// SetDiscoverConfig changes the weights, the posts of the last PostDiscoverWindow are scored again with them.
+func (o *chatSvr) SetDiscoverConfig(ctx context.Context, req *chatpb.SetDiscoverConfigReq) (*chatpb.SetDiscoverConfigResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	config := &chat.DiscoverConfig{
+		LikeWeight:    req.Config.LikeWeight,
+		CommentWeight: req.Config.CommentWeight,
+		ForwardWeight: req.Config.ForwardWeight,
+		ViewWeight:    req.Config.ViewWeight,
+		DecayHours:    req.Config.DecayHours,
+	}
+	if err := o.Database.SetDiscoverConfig(ctx, config, time.Now().Add(-o.PostDiscoverWindow)); err != nil {
+		return nil, err
+	}
+	return &chatpb.SetDiscoverConfigResp{}, nil
+}
+
+func (o *chatSvr) SetPostDiscover(ctx context.Context, req *chatpb.SetPostDiscoverReq) (*chatpb.SetPostDiscoverResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.TakePostDB(ctx, req.PostID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.SetPostDiscover(ctx, req.PostID, req.Pinned, req.Boost); err != nil {
+		return nil, err
+	}
+	return &chatpb.SetPostDiscoverResp{}, nil
+}
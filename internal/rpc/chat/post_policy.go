@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+// 帖子互动类型
+const (
+	postActionLike = iota + 1
+	postActionCollect
+	postActionComment
+	postActionForward
+	postActionReference
+)
+
+// checkPostInteraction 帖子的互动都需要通过此检查, 作者本人不受限制
+// 依次检查帖子的评论转发开关, 作者的 OpenIM 黑名单与作者的互动设置
+func (o *chatSvr) checkPostInteraction(ctx context.Context, opUserID string, post *chat.Post, action int) error {
+	if post.UserID == opUserID {
+		return nil
+	}
+	switch action {
+	case postActionComment:
+		if post.AllowComment != constant.Commented {
+			return eerrs.ErrPostCommentNotAllowed.WrapMsg("the post does not allow comments")
+		}
+	case postActionForward, postActionReference:
+		// 引用同样会把帖子带到作者的关注者之外, 与转发共用开关
+		if post.AllowForward != constant.Forwarded {
+			return eerrs.ErrPostForwardNotAllowed.WrapMsg("the post does not allow forwarding")
+		}
+		// 只有公开的帖子可以被转发或引用, 避免帖子被转发到作者限定的范围之外
+		if post.Visibility != constant.PostVisibilityPublic {
+			return errs.ErrNoPermission.WrapMsg("only public posts can be shared")
+		}
+	}
+	token, err := o.IMApi.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	blocked, err := o.IMApi.IsBlack(mctx.WithApiToken(ctx, token), post.UserID, opUserID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return eerrs.ErrBlockedByPostAuthor.WrapMsg("blocked by the post author")
+	}
+	// 收藏只对自己可见, 不受作者互动设置限制
+	if action == postActionCollect {
+		return nil
+	}
+	return o.checkAuthorInteraction(ctx, opUserID, post.UserID)
+}
+
+// checkAuthorInteraction 检查作者的互动设置, 好友为互相关注
+func (o *chatSvr) checkAuthorInteraction(ctx context.Context, opUserID string, authorID string) error {
+	attributes, err := o.Database.FindAttribute(ctx, []string{authorID})
+	if err != nil {
+		return err
+	}
+	if len(attributes) == 0 || attributes[0].PostInteraction == constant.PostInteractionEveryone {
+		return nil
+	}
+	following, err := o.Database.IsFollowing(ctx, opUserID, authorID)
+	if err != nil {
+		return err
+	}
+	if !following {
+		return eerrs.ErrPostInteractionRestricted.WrapMsg("only followers of the author can interact")
+	}
+	if attributes[0].PostInteraction == constant.PostInteractionFriends {
+		followed, err := o.Database.IsFollowing(ctx, authorID, opUserID)
+		if err != nil {
+			return err
+		}
+		if !followed {
+			return eerrs.ErrPostInteractionRestricted.WrapMsg("only friends of the author can interact")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	postPurgeJob   = "post_purge"
+	postPurgeBatch = 100
+)
+
+// purgePosts clears the content of the posts whose trash window has passed.
+func (o *chatSvr) purgePosts(ctx context.Context) {
+	before := time.Now().Add(-o.PostTrashWindow)
+	for {
+		n, err := o.Database.PurgeDeletedPosts(ctx, before, postPurgeBatch)
+		if err != nil {
+			log.ZError(ctx, "purge deleted posts failed", err)
+			return
+		}
+		if n > 0 {
+			log.ZInfo(ctx, "purged deleted posts", "count", n)
+		}
+		if n < postPurgeBatch {
+			return
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+)
+
+const (
+	// postModerationKey is the key of the business notification sent to the author of a moderated post.
+	postModerationKey = "post_moderation"
+	// reportedPostReportLimit is the number of latest reports returned with a reported post.
+	reportedPostReportLimit = 50
+)
+
+func (o *chatSvr) ReportPost(ctx context.Context, req *chatpb.ReportPostReq) (*chatpb.ReportPostResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID == opUserID {
+		return nil, errs.ErrArgs.WrapMsg("cannot report own post")
+	}
+	report := &chat.PostReport{
+		ReportID:       uuid.New().String(),
+		PostID:         post.PostID,
+		AuthorUserID:   post.UserID,
+		ReporterUserID: opUserID,
+		Reason:         req.Reason,
+		Description:    req.Description,
+		Status:         constant.PostReportPending,
+		CreateTime:     time.Now(),
+	}
+	if err := o.Database.CreatePostReport(ctx, report); err != nil {
+		if dbutil.IsDBDuplicateKey(err) {
+			return nil, eerrs.ErrPostAlreadyReported.Wrap()
+		}
+		return nil, err
+	}
+	return &chatpb.ReportPostResp{ReportID: report.ReportID}, nil
+}
+
+func (o *chatSvr) SearchPostReports(ctx context.Context, req *chatpb.SearchPostReportsReq) (*chatpb.SearchPostReportsResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	search := &chat.PostReportSearch{
+		PostID:         req.PostID,
+		ReporterUserID: req.ReporterUserID,
+		AuthorUserID:   req.AuthorUserID,
+	}
+	if req.Status != nil {
+		search.Status = &req.Status.Value
+	}
+	total, reports, err := o.Database.SearchPostReports(ctx, search, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	reportsPB, err := o.postReportsDB2Pb(ctx, reports)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.SearchPostReportsResp{
+		Total:   uint32(total),
+		Reports: reportsPB,
+	}, nil
+}
+
+// GetReportedPost returns the post as its viewers would see it with its parents, even when it is hidden or deleted.
+func (o *chatSvr) GetReportedPost(ctx context.Context, req *chatpb.GetReportedPostReq) (*chatpb.GetReportedPostResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	post, err := o.Database.TakePostForModeration(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := o.Database.FindPostReports(ctx, req.PostID, reportedPostReportLimit)
+	if err != nil {
+		return nil, err
+	}
+	reportsPB, err := o.postReportsDB2Pb(ctx, reports)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := o.Database.FindPostModerationLogs(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetReportedPostResp{
+		Post:    convert.PostDB2Pb(post),
+		Reports: reportsPB,
+		Logs:    datautil.Slice(logs, postModerationLogDB2Pb),
+	}, nil
+}
+
+// ModeratePost applies the action to the post and its author, then closes the pending reports of the post
+// and records the decision. A warning is sent before anything is recorded, so a failed warning can be retried.
+func (o *chatSvr) ModeratePost(ctx context.Context, req *chatpb.ModeratePostReq) (*chatpb.ModeratePostResp, error) {
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := o.Database.TakePostDB(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	moderationLog := &chat.PostModerationLog{
+		LogID:          uuid.New().String(),
+		PostID:         post.PostID,
+		AuthorUserID:   post.UserID,
+		OperatorUserID: opUserID,
+		Action:         req.Action,
+		Reason:         req.Reason,
+		CreateTime:     time.Now(),
+	}
+	var (
+		data   map[string]any
+		remove bool
+	)
+	switch req.Action {
+	case constant.PostModerationHide:
+		data = map[string]any{"hidden": constant.PostHidden}
+	case constant.PostModerationUnhide:
+		data = map[string]any{"hidden": constant.PostNotHidden}
+	case constant.PostModerationRemove:
+		data = map[string]any{"hidden": constant.PostHidden}
+		remove = true
+	case constant.PostModerationWarn:
+		if err := o.sendModerationNotice(ctx, moderationLog); err != nil {
+			return nil, err
+		}
+	case constant.PostModerationBan:
+		if err := o.banPostAuthor(ctx, post.UserID, req.Reason); err != nil {
+			return nil, err
+		}
+		data = map[string]any{"hidden": constant.PostHidden}
+	}
+	if err := o.Database.ModeratePost(ctx, moderationLog, data, remove); err != nil {
+		return nil, err
+	}
+	switch req.Action {
+	case constant.PostModerationHide, constant.PostModerationRemove, constant.PostModerationBan:
+		if err := o.sendModerationNotice(ctx, moderationLog); err != nil {
+			log.ZWarn(ctx, "send post moderation notice failed", err, "postID", post.PostID, "action", req.Action)
+		}
+	}
+	return &chatpb.ModeratePostResp{}, nil
+}
+
+func (o *chatSvr) SearchPostModerationLogs(ctx context.Context, req *chatpb.SearchPostModerationLogsReq) (*chatpb.SearchPostModerationLogsResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, logs, err := o.Database.SearchPostModerationLogs(ctx, req.PostID, req.AuthorUserID, req.OperatorUserID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.SearchPostModerationLogsResp{
+		Total: uint32(total),
+		Logs:  datautil.Slice(logs, postModerationLogDB2Pb),
+	}, nil
+}
+
+// banPostAuthor blocks the user through the admin rpc unless already blocked and kicks the user offline.
+func (o *chatSvr) banPostAuthor(ctx context.Context, userID string, reason string) error {
+	forbiddenUserIDs, err := o.Database.FindForbiddenUserIDs(ctx, []string{userID})
+	if err != nil {
+		return err
+	}
+	if len(forbiddenUserIDs) == 0 {
+		if err := o.Admin.BlockUser(ctx, userID, reason); err != nil {
+			return err
+		}
+	}
+	token, err := o.IMApi.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	return o.IMApi.ForceOffLine(mctx.WithApiToken(ctx, token), userID)
+}
+
+// sendModerationNotice tells the author of the post about the decision as an OpenIM business notification.
+func (o *chatSvr) sendModerationNotice(ctx context.Context, moderationLog *chat.PostModerationLog) error {
+	data, err := json.Marshal(map[string]any{
+		"postID": moderationLog.PostID,
+		"action": moderationLog.Action,
+		"reason": moderationLog.Reason,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	token, err := o.IMApi.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = o.IMApi.SendMsg(mctx.WithApiToken(ctx, token), &imapi.SendMsgReq{
+		SendID:           o.Share.OpenIM.AdminUserID,
+		RecvID:           moderationLog.AuthorUserID,
+		SenderPlatformID: constantpb.AdminPlatformID,
+		Content:          map[string]any{"key": postModerationKey, "data": string(data)},
+		ContentType:      constantpb.BusinessNotification,
+		SessionType:      constantpb.SingleChatType,
+	})
+	return err
+}
+
+// postReportsDB2Pb fills the reporters and authors of the reports with their public info.
+func (o *chatSvr) postReportsDB2Pb(ctx context.Context, reports []*chat.PostReport) ([]*chatpb.PostReport, error) {
+	var userIDs []string
+	for _, report := range reports {
+		userIDs = append(userIDs, report.ReporterUserID, report.AuthorUserID)
+	}
+	attributes, err := o.Database.FindAttribute(ctx, datautil.Distinct(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	attributeMap := datautil.SliceToMap(attributes, func(e *chat.Attribute) string { return e.UserID })
+	return datautil.Slice(reports, func(report *chat.PostReport) *chatpb.PostReport {
+		reportPB := &chatpb.PostReport{
+			ReportID:       report.ReportID,
+			PostID:         report.PostID,
+			AuthorUserID:   report.AuthorUserID,
+			ReporterUserID: report.ReporterUserID,
+			Reason:         report.Reason,
+			Description:    report.Description,
+			Status:         report.Status,
+			Action:         report.Action,
+			OperatorUserID: report.OperatorUserID,
+			CreateTime:     report.CreateTime.UnixMilli(),
+			Reporter:       convert.DbToPbAttribute(attributeMap[report.ReporterUserID]),
+			Author:         convert.DbToPbAttribute(attributeMap[report.AuthorUserID]),
+		}
+		if report.Status != constant.PostReportPending {
+			reportPB.HandleTime = report.HandleTime.UnixMilli()
+		}
+		return reportPB
+	}), nil
+}
+
+func postModerationLogDB2Pb(moderationLog *chat.PostModerationLog) *chatpb.PostModerationLog {
+	return &chatpb.PostModerationLog{
+		LogID:          moderationLog.LogID,
+		PostID:         moderationLog.PostID,
+		AuthorUserID:   moderationLog.AuthorUserID,
+		OperatorUserID: moderationLog.OperatorUserID,
+		Action:         moderationLog.Action,
+		Reason:         moderationLog.Reason,
+		ReportCount:    moderationLog.ReportCount,
+		CreateTime:     moderationLog.CreateTime.UnixMilli(),
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// hashtagRegexp 匹配话题标签, # 前的字符由 hashtagCanFollow 检查
+var hashtagRegexp = regexp.MustCompile(`#([\p{L}\p{N}_]+)`)
+
+// parseHashtags 提取内容中的话题标签, 转为小写并去重, 忽略纯数字与过长的标签
+func parseHashtags(content string) []string {
+	var hashtags []string
+	for _, loc := range hashtagRegexp.FindAllStringSubmatchIndex(content, -1) {
+		if r, _ := utf8.DecodeLastRuneInString(content[:loc[0]]); loc[0] > 0 && !hashtagCanFollow(r) {
+			continue
+		}
+		hashtag := normalizeHashtag(content[loc[2]:loc[3]])
+		if hashtag == "" || strings.Trim(hashtag, "0123456789") == "" || utf8.RuneCountInString(hashtag) > constant.MaxHashtagLength {
+			continue
+		}
+		if !datautil.Contain(hashtag, hashtags...) {
+			hashtags = append(hashtags, hashtag)
+		}
+		if len(hashtags) == constant.MaxPostHashtags {
+			break
+		}
+	}
+	return hashtags
+}
+
+// hashtagCanFollow 报告 # 能否跟在 r 之后, 中日韩文字之间没有空格可以紧跟,
+// 其他文字后不能紧跟, 避免匹配单词与链接中的锚点
+func hashtagCanFollow(r rune) bool {
+	if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+		return true
+	}
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !strings.ContainsRune("_&#/", r)
+}
+
+// normalizeHashtag 话题标签统一为不带 # 的小写形式
+func normalizeHashtag(hashtag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(hashtag), "#"))
+}
+
+func (o *chatSvr) SearchPosts(ctx context.Context, req *chatpb.SearchPostsReq) (*chatpb.SearchPostsResp, error) {
+	search := &chat.PostSearch{
+		Keyword: strings.TrimSpace(req.Keyword),
+		Hashtag: normalizeHashtag(req.Hashtag),
+		UserID:  req.UserID,
+	}
+	if req.StartTime > 0 {
+		search.StartTime = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		search.EndTime = time.UnixMilli(req.EndTime)
+	}
+	total, posts, err := o.Database.SearchPosts(ctx, search, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.SearchPostsResp{
+		Total: uint32(total),
+		Posts: convert.PostsDB2Pb(posts),
+	}, nil
+}
+
+func (o *chatSvr) GetTopicFeed(ctx context.Context, req *chatpb.GetTopicFeedReq) (*chatpb.GetTopicFeedResp, error) {
+	hashtag := normalizeHashtag(req.Hashtag)
+	topic, err := o.Database.TakeTopic(ctx, hashtag)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+		// 还没有帖子使用的话题
+		topic = &chat.Topic{Name: hashtag}
+	}
+	posts, nextCursor, err := o.Database.GetPostsByCursorAndHashtag(ctx, req.NextCursor, hashtag, int64(req.Count))
+	if err != nil {
+		return nil, err
+	}
+	topicPB := &chatpb.Topic{
+		Name:      topic.Name,
+		PostCount: topic.PostCount,
+	}
+	if !topic.CreateTime.IsZero() {
+		topicPB.CreateTime = topic.CreateTime.UnixMilli()
+	}
+	return &chatpb.GetTopicFeedResp{
+		Topic:      topicPB,
+		NextCursor: nextCursor,
+		Posts:      convert.PostsDB2Pb(posts),
+	}, nil
+}
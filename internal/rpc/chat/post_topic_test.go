@@ -0,0 +1,69 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+)
+
+func TestParseHashtags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{name: "none", content: "hello world", want: nil},
+		{name: "start of content", content: "#Go is fun", want: []string{"go"}},
+		{name: "after space", content: "learning #golang today", want: []string{"golang"}},
+		{name: "lowercase and dedupe", content: "#Go #GO #go", want: []string{"go"}},
+		{name: "after punctuation", content: "(#tag1),#tag2", want: []string{"tag1", "tag2"}},
+		{name: "chinese", content: "#天气 真好", want: []string{"天气"}},
+		{name: "after chinese", content: "今天#天气", want: []string{"天气"}},
+		{name: "consecutive chinese", content: "#天气#晴天", want: []string{"天气", "晴天"}},
+		{name: "after japanese", content: "きょうは#てんき", want: []string{"てんき"}},
+		{name: "after korean", content: "오늘#날씨", want: []string{"날씨"}},
+		{name: "after latin letter", content: "abc#def", want: nil},
+		{name: "after digit", content: "1#tag", want: nil},
+		{name: "consecutive latin", content: "#a#b", want: []string{"a"}},
+		{name: "url anchor", content: "see https://example.com/#anchor", want: nil},
+		{name: "html entity", content: "&#1234;", want: nil},
+		{name: "double hash", content: "##tag", want: nil},
+		{name: "digits only", content: "#2024 #v2", want: []string{"v2"}},
+		{name: "underscore", content: "#open_im", want: []string{"open_im"}},
+		{name: "too long", content: "#" + strings.Repeat("a", constant.MaxHashtagLength+1), want: nil},
+		{name: "max length", content: "#" + strings.Repeat("a", constant.MaxHashtagLength), want: []string{strings.Repeat("a", constant.MaxHashtagLength)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseHashtags(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseHashtags(%q) = %v, want %v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHashtagsLimit(t *testing.T) {
+	var content []string
+	for i := 0; i < constant.MaxPostHashtags+5; i++ {
+		content = append(content, "#t"+strings.Repeat("x", i))
+	}
+	if got := parseHashtags(strings.Join(content, " ")); len(got) != constant.MaxPostHashtags {
+		t.Fatalf("parseHashtags() returned %d hashtags, want %d", len(got), constant.MaxPostHashtags)
+	}
+}
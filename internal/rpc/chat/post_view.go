@@ -0,0 +1,35 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/log"
+)
+
+const postViewFlushJob = "post_view_flush"
+
+// flushPostViews adds the views counted in redis to the posts, so reading a post does not write the post document.
+func (o *chatSvr) flushPostViews(ctx context.Context) {
+	n, err := o.Database.FlushPostViews(ctx)
+	if err != nil {
+		log.ZError(ctx, "flush post views failed", err)
+		return
+	}
+	if n > 0 {
+		log.ZDebug(ctx, "flushed post views", "posts", n)
+	}
+}
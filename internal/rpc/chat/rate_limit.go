@@ -0,0 +1,106 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+// checkNonceRate throttles challenge nonces per ip and per address within a sliding window.
+func (o *chatSvr) checkNonceRate(ctx context.Context, ip string, address string) error {
+	limit := o.Share.RateLimit.Nonce
+	window := time.Duration(limit.Window) * time.Second
+	if window <= 0 {
+		return nil
+	}
+	if ip != "" && limit.IPLimit > 0 {
+		ok, err := o.Database.AllowRate(ctx, "nonce:ip:"+ip, limit.IPLimit, window)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return eerrs.ErrTooManyRequests.WrapMsg("too many nonce requests from ip")
+		}
+	}
+	if limit.AddressLimit > 0 {
+		ok, err := o.Database.AllowRate(ctx, "nonce:address:"+address, limit.AddressLimit, window)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return eerrs.ErrTooManyRequests.WrapMsg("too many nonce requests for address")
+		}
+	}
+	return nil
+}
+
+// loginFailed counts a failed login signature and locks the ip, for the user or for everyone, once
+// the failures reach the configured limit. Errors are only logged so the caller reports the failed login.
+func (o *chatSvr) loginFailed(ctx context.Context, userID string, ip string) {
+	limit := o.Share.RateLimit.Login
+	window := time.Duration(limit.Window) * time.Second
+	if ip == "" || window <= 0 || limit.Lockout <= 0 {
+		return
+	}
+	expireTime := time.Now().Add(time.Duration(limit.Lockout) * time.Second)
+	lock := func(key string, max int, userID string, reason string) {
+		if max <= 0 {
+			return
+		}
+		count, err := o.Database.HitRate(ctx, key, window)
+		if err != nil {
+			log.ZWarn(ctx, "count login failure", err, "key", key)
+			return
+		}
+		if count < int64(max) {
+			return
+		}
+		recorded, err := o.Admin.AddLoginLockout(ctx, userID, ip, reason, expireTime)
+		if err != nil {
+			log.ZWarn(ctx, "add login lockout", err, "userID", userID, "ip", ip)
+			return
+		}
+		// the failures keep counting while a permanent rule blocks the ip instead
+		if !recorded {
+			return
+		}
+		if err := o.Database.ResetRate(ctx, key); err != nil {
+			log.ZWarn(ctx, "reset login failure", err, "key", key)
+		}
+	}
+	lock(loginFailureKey(userID, ip), limit.MaxFailures, userID, "too many failed logins")
+	lock(loginFailureKey("", ip), limit.IPMaxFailures, "", "too many failed logins from ip")
+}
+
+func (o *chatSvr) loginSucceeded(ctx context.Context, userID string, ip string) {
+	if ip == "" {
+		return
+	}
+	if err := o.Database.ResetRate(ctx, loginFailureKey(userID, ip)); err != nil {
+		log.ZWarn(ctx, "reset login failure", err, "userID", userID, "ip", ip)
+	}
+}
+
+func loginFailureKey(userID string, ip string) string {
+	if userID == "" {
+		return "login_fail:ip:" + ip
+	}
+	return "login_fail:user:" + userID + ":" + ip
+}
@@ -0,0 +1,363 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func (o *chatSvr) SetRecoveryGuardians(ctx context.Context, req *chat.SetRecoveryGuardiansReq) (*chat.SetRecoveryGuardiansResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GuardianUserIDs) > constant.MaxRecoveryGuardians {
+		return nil, errs.ErrArgs.WrapMsg("too many guardians")
+	}
+	if datautil.Duplicate(req.GuardianUserIDs) {
+		return nil, errs.ErrArgs.WrapMsg("duplicate guardian")
+	}
+	if datautil.Contain(opUserID, req.GuardianUserIDs...) {
+		return nil, errs.ErrArgs.WrapMsg("user can not be own guardian")
+	}
+	if len(req.GuardianUserIDs) == 0 {
+		req.Threshold = 0
+	} else if req.Threshold < 1 || int(req.Threshold) > len(req.GuardianUserIDs) {
+		return nil, errs.ErrArgs.WrapMsg("threshold is invalid")
+	}
+	// changing guardians hands over control of the account, a token alone is not enough
+	if _, err := o.checkUserKeySignature(ctx, opUserID, req.Nonce, req.Signature); err != nil {
+		return nil, err
+	}
+	if len(req.GuardianUserIDs) > 0 {
+		attributes, err := o.Database.FindAttribute(ctx, req.GuardianUserIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(attributes) != len(req.GuardianUserIDs) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("guardian not found")
+		}
+	}
+	guardian := &chatdb.RecoveryGuardian{
+		UserID:          opUserID,
+		GuardianUserIDs: req.GuardianUserIDs,
+		Threshold:       req.Threshold,
+		UpdateTime:      time.Now(),
+	}
+	if err := o.Database.SetRecoveryGuardian(ctx, guardian); err != nil {
+		return nil, err
+	}
+	return &chat.SetRecoveryGuardiansResp{}, nil
+}
+
+func (o *chatSvr) GetRecoveryGuardians(ctx context.Context, req *chat.GetRecoveryGuardiansReq) (*chat.GetRecoveryGuardiansResp, error) {
+	opUserID, _, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == "" {
+		req.UserID = opUserID
+	}
+	if err := mctx.CheckAdminOr(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	guardian, err := o.Database.TakeRecoveryGuardian(ctx, req.UserID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return &chat.GetRecoveryGuardiansResp{GuardianUserIDs: []string{}}, nil
+		}
+		return nil, err
+	}
+	return &chat.GetRecoveryGuardiansResp{GuardianUserIDs: guardian.GuardianUserIDs, Threshold: guardian.Threshold}, nil
+}
+
+// InitiateRecovery is called without a token by a user who lost their key. The new key signs the request
+// and guardians approve it, the key is rotated once the threshold is reached.
+func (o *chatSvr) InitiateRecovery(ctx context.Context, req *chat.InitiateRecoveryReq) (*chat.InitiateRecoveryResp, error) {
+	if !common.IsHexAddress(req.NewAddress) {
+		return nil, errs.ErrArgs.WrapMsg("new address is invalid")
+	}
+	lost, err := o.resolveUserKey(ctx, req.Address)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.Wrap()
+		}
+		return nil, err
+	}
+	guardian, err := o.Database.TakeRecoveryGuardian(ctx, lost.UserID)
+	if err != nil && !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	if guardian == nil || len(guardian.GuardianUserIDs) == 0 {
+		return nil, eerrs.ErrGuardianNotSet.Wrap()
+	}
+	newAddress := common.HexToAddress(req.NewAddress).Hex()
+	if err := o.checkRecoveryAddress(ctx, lost.UserID, newAddress); err != nil {
+		return nil, err
+	}
+	signer, err := o.validateSignature(ctx, req.Nonce, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSigner(signer, newAddress, ""); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	request := &chatdb.RecoveryRequest{
+		RecoveryID:      uuid.New().String(),
+		UserID:          lost.UserID,
+		NewAddress:      newAddress,
+		NewPublicKey:    signer,
+		Mode:            constant.RecoveryModeGuardian,
+		Status:          constant.RecoveryPending,
+		GuardianUserIDs: guardian.GuardianUserIDs,
+		Threshold:       guardian.Threshold,
+		Approvals:       []*chatdb.RecoveryApproval{},
+		CreateTime:      now,
+		ExpireTime:      now.Add(o.RecoveryExpire),
+	}
+	if err := o.Database.CreateRecovery(ctx, request); err != nil {
+		return nil, err
+	}
+	return &chat.InitiateRecoveryResp{Recovery: convert.RecoveryDB2Pb(request)}, nil
+}
+
+func (o *chatSvr) ApproveRecovery(ctx context.Context, req *chat.ApproveRecoveryReq) (*chat.ApproveRecoveryResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := o.takePendingRecovery(ctx, req.RecoveryID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Mode != constant.RecoveryModeGuardian || !datautil.Contain(opUserID, request.GuardianUserIDs...) {
+		return nil, eerrs.ErrGuardianNotAllowed.Wrap()
+	}
+	key, err := o.checkUserKeySignature(ctx, opUserID, req.Nonce, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	approval := &chatdb.RecoveryApproval{
+		GuardianUserID: opUserID,
+		Address:        key.Address,
+		ApproveTime:    time.Now(),
+	}
+	request, err = o.Database.ApproveRecovery(ctx, req.RecoveryID, approval)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrRecoveryNotPending.WrapMsg("recovery already approved by guardian or no longer pending")
+		}
+		return nil, err
+	}
+	if len(request.Approvals) >= int(request.Threshold) {
+		request, err = o.executeRecovery(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &chat.ApproveRecoveryResp{Recovery: convert.RecoveryDB2Pb(request)}, nil
+}
+
+// GetRecovery returns a recovery to the account owner, its guardians or an admin.
+func (o *chatSvr) GetRecovery(ctx context.Context, req *chat.GetRecoveryReq) (*chat.GetRecoveryResp, error) {
+	if _, _, err := mctx.Check(ctx); err != nil {
+		return nil, err
+	}
+	request, err := o.Database.TakeRecovery(ctx, req.RecoveryID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrRecoveryNotFound.Wrap()
+		}
+		return nil, err
+	}
+	if err := mctx.CheckAdminOr(ctx, append([]string{request.UserID}, request.GuardianUserIDs...)...); err != nil {
+		return nil, err
+	}
+	return &chat.GetRecoveryResp{Recovery: convert.RecoveryDB2Pb(request)}, nil
+}
+
+// StartAdminRecovery schedules a key rotation that an admin can execute once RecoveryTimeLock has passed,
+// leaving the user time to cancel it.
+func (o *chatSvr) StartAdminRecovery(ctx context.Context, req *chat.StartAdminRecoveryReq) (*chat.StartAdminRecoveryResp, error) {
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.NewAddress) {
+		return nil, errs.ErrArgs.WrapMsg("new address is invalid")
+	}
+	newAddress := common.HexToAddress(req.NewAddress).Hex()
+	if req.NewPublicKey != "" {
+		if err := checkSigner(req.NewPublicKey, newAddress, ""); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := o.Database.GetAttribute(ctx, req.UserID); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.Wrap()
+		}
+		return nil, err
+	}
+	if err := o.checkRecoveryAddress(ctx, req.UserID, newAddress); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	executeAfter := now.Add(o.RecoveryTimeLock)
+	request := &chatdb.RecoveryRequest{
+		RecoveryID:     uuid.New().String(),
+		UserID:         req.UserID,
+		NewAddress:     newAddress,
+		NewPublicKey:   req.NewPublicKey,
+		Mode:           constant.RecoveryModeAdmin,
+		Status:         constant.RecoveryPending,
+		Approvals:      []*chatdb.RecoveryApproval{},
+		OperatorUserID: opUserID,
+		Reason:         req.Reason,
+		CreateTime:     now,
+		ExecuteAfter:   executeAfter,
+		ExpireTime:     executeAfter.Add(o.RecoveryExpire),
+	}
+	if err := o.Database.CreateRecovery(ctx, request); err != nil {
+		return nil, err
+	}
+	return &chat.StartAdminRecoveryResp{Recovery: convert.RecoveryDB2Pb(request)}, nil
+}
+
+func (o *chatSvr) ExecuteRecovery(ctx context.Context, req *chat.ExecuteRecoveryReq) (*chat.ExecuteRecoveryResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	request, err := o.takePendingRecovery(ctx, req.RecoveryID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Mode != constant.RecoveryModeAdmin {
+		return nil, errs.ErrArgs.WrapMsg("recovery is waiting for guardian approvals")
+	}
+	if time.Now().Before(request.ExecuteAfter) {
+		return nil, eerrs.ErrRecoveryTimeLocked.WrapMsg("recovery can be executed after " + request.ExecuteAfter.Format(time.DateTime))
+	}
+	request, err = o.executeRecovery(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.ExecuteRecoveryResp{Recovery: convert.RecoveryDB2Pb(request)}, nil
+}
+
+// CancelRecovery lets the account owner, while still holding a key, or an admin stop a pending recovery.
+func (o *chatSvr) CancelRecovery(ctx context.Context, req *chat.CancelRecoveryReq) (*chat.CancelRecoveryResp, error) {
+	if _, _, err := mctx.Check(ctx); err != nil {
+		return nil, err
+	}
+	request, err := o.Database.TakeRecovery(ctx, req.RecoveryID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrRecoveryNotFound.Wrap()
+		}
+		return nil, err
+	}
+	if err := mctx.CheckAdminOr(ctx, request.UserID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.CancelRecovery(ctx, req.RecoveryID); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrRecoveryNotPending.Wrap()
+		}
+		return nil, err
+	}
+	return &chat.CancelRecoveryResp{}, nil
+}
+
+func (o *chatSvr) takePendingRecovery(ctx context.Context, recoveryID string) (*chatdb.RecoveryRequest, error) {
+	request, err := o.Database.TakeRecovery(ctx, recoveryID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrRecoveryNotFound.Wrap()
+		}
+		return nil, err
+	}
+	if request.Status != constant.RecoveryPending {
+		return nil, eerrs.ErrRecoveryNotPending.Wrap()
+	}
+	if time.Now().After(request.ExpireTime) {
+		return nil, eerrs.ErrRecoveryExpired.Wrap()
+	}
+	return request, nil
+}
+
+// checkRecoveryAddress makes sure the new address is not an active key of another account.
+func (o *chatSvr) checkRecoveryAddress(ctx context.Context, userID string, address string) error {
+	key, err := o.resolveUserKey(ctx, address)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if key.UserID != userID && key.Status == constant.UserKeyActive {
+		return eerrs.ErrUserKeyAlreadyBound.Wrap()
+	}
+	return nil
+}
+
+// executeRecovery makes the new key the only key of the account and signs out every session of the user.
+func (o *chatSvr) executeRecovery(ctx context.Context, request *chatdb.RecoveryRequest) (*chatdb.RecoveryRequest, error) {
+	if err := o.checkRecoveryAddress(ctx, request.UserID, request.NewAddress); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	key := &chatdb.UserKey{
+		UserID:     request.UserID,
+		Address:    request.NewAddress,
+		PublicKey:  request.NewPublicKey,
+		Name:       "recovery",
+		Status:     constant.UserKeyActive,
+		CreateTime: now,
+	}
+	// 先退出登录, 失败时恢复仍待执行, 可以重试
+	if err := o.Admin.InvalidateToken(ctx, request.UserID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.RecoverUser(ctx, request.RecoveryID, key); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrRecoveryNotPending.Wrap()
+		}
+		return nil, err
+	}
+	// 旧密钥在轮换前可能又登录了一次
+	if err := o.Admin.InvalidateToken(ctx, request.UserID); err != nil {
+		log.ZError(ctx, "invalidate token after recovery failed", err, "recoveryID", request.RecoveryID, "userID", request.UserID)
+	}
+	log.ZInfo(ctx, "account recovered", "recoveryID", request.RecoveryID, "userID", request.UserID, "mode", request.Mode, "address", request.NewAddress)
+	request.Status = constant.RecoveryExecuted
+	request.ExecuteTime = now
+	return request, nil
+}
@@ -0,0 +1,430 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/callback"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/redpacket"
+	"github.com/openimsdk/chat/pkg/redpacket/apistruct"
+)
+
+// createRedPacket records the packet carried by a send red packet message, the message is only delivered if this succeeds.
+// req has been checked against the custom message schema, what is left to check depends on the chat it is sent in.
+func (o *chatSvr) createRedPacket(ctx context.Context, msgData *callback.MsgReq, req *apistruct.RedPacket, params map[string]any) error {
+	if msgData.ClientMsgID == "" {
+		return errs.ErrArgs.WrapMsg("clientMsgID is empty")
+	}
+	if callback, err := o.takeRedPacketCallback(ctx, msgData.ClientMsgID, constant.RedPacketCallbackSend); err != nil {
+		return err
+	} else if callback != nil {
+		return o.retryRedPacketSend(ctx, msgData.ClientMsgID)
+	}
+	total, err := redpacket.ParseAmount(req.Amount)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	packet := &chatdb.RedPacket{
+		RedPacketID:    msgData.ClientMsgID,
+		SendUserID:     msgData.SendID,
+		Type:           string(req.Type),
+		TotalAmount:    total,
+		TotalCount:     1,
+		RemainAmounts:  []int64{total},
+		ClaimedUserIDs: []string{},
+		Settlement:     constant.RedPacketSettlementLedger,
+		Status:         constant.RedPacketPending,
+		Remark:         req.Remark,
+		Emoji:          req.Emoji,
+		CreateTime:     now,
+		ExpireTime:     now.Add(o.RedPacketExpire),
+	}
+	switch req.Type {
+	case apistruct.Private:
+		if msgData.RecvID == "" {
+			return eerrs.ErrRedPacketPayload.WrapMsg("private red packet must be sent in a single chat")
+		}
+		packet.ReceiveUserID = msgData.RecvID
+	case apistruct.Luck:
+		if msgData.GroupID == "" {
+			return eerrs.ErrRedPacketPayload.WrapMsg("luck red packet must be sent in a group")
+		}
+		shares, err := redpacket.Split(total, req.TotalCount)
+		if err != nil {
+			return err
+		}
+		packet.GroupID = msgData.GroupID
+		packet.TotalCount = int32(req.TotalCount)
+		packet.RemainAmounts = shares
+	case apistruct.Exclusive:
+		if msgData.GroupID == "" {
+			return eerrs.ErrRedPacketPayload.WrapMsg("exclusive red packet must be sent in a group")
+		}
+		if req.ReceiveUserID == msgData.SendID {
+			return eerrs.ErrRedPacketPayload.WrapMsg("exclusive red packet can not be sent to self")
+		}
+		if err := o.checkGroupMember(ctx, msgData.GroupID, req.ReceiveUserID); err != nil {
+			return err
+		}
+		packet.GroupID = msgData.GroupID
+		packet.ReceiveUserID = req.ReceiveUserID
+	default:
+		return eerrs.ErrRedPacketPayload.WrapMsg("unknown red packet type")
+	}
+	var settlement *chatdb.RedPacketSettlement
+	if o.RedPacketSettlement != nil {
+		// 结算完成前红包不能领取
+		packet.Settlement = constant.RedPacketSettlementHTTP
+		packet.Unsettled = true
+		params["clientMsgID"] = msgData.ClientMsgID
+		settlement = &chatdb.RedPacketSettlement{
+			SettlementID: redpacket.SettlementID(constant.RedPacketCallbackSend, msgData.ClientMsgID),
+			Operation:    constant.RedPacketCallbackSend,
+			UserID:       msgData.SendID,
+			RedPacketID:  packet.RedPacketID,
+			ClientMsgID:  msgData.ClientMsgID,
+			Amount:       total,
+			Params:       params,
+			Status:       constant.RedPacketSettlementPending,
+			CreateTime:   now,
+			UpdateTime:   now,
+		}
+	}
+	callback := &chatdb.RedPacketCallback{
+		ClientMsgID: msgData.ClientMsgID,
+		Operation:   constant.RedPacketCallbackSend,
+		UserID:      msgData.SendID,
+		RedPacketID: packet.RedPacketID,
+		Amount:      total,
+		CreateTime:  now,
+	}
+	if err := o.Database.CreateRedPacket(ctx, packet, callback, settlement); err != nil {
+		// a concurrent retry of the same callback may have created the packet first
+		if callback, _ := o.takeRedPacketCallback(ctx, msgData.ClientMsgID, constant.RedPacketCallbackSend); callback != nil {
+			return o.retryRedPacketSend(ctx, msgData.ClientMsgID)
+		}
+		if dbutil.IsDBNotFound(err) {
+			return eerrs.ErrInsufficientBalance.Wrap()
+		}
+		return err
+	}
+	if settlement != nil {
+		// a failed send rejects the message, the retried callback settles it again
+		return o.settleRedPacket(ctx, settlement)
+	}
+	return nil
+}
+
+// claimRedPacket gives the sender of a receive red packet message one share of the packet.
+func (o *chatSvr) claimRedPacket(ctx context.Context, msgData *callback.MsgReq, redPacketID string) (*chatdb.RedPacketClaim, error) {
+	if msgData.ClientMsgID == "" {
+		return nil, errs.ErrArgs.WrapMsg("clientMsgID is empty")
+	}
+	if callback, err := o.takeRedPacketCallback(ctx, msgData.ClientMsgID, constant.RedPacketCallbackReceive); err != nil {
+		return nil, err
+	} else if callback != nil {
+		return callbackClaim(callback), nil
+	}
+	userID := msgData.SendID
+	packet, err := o.takeRedPacket(ctx, redPacketID)
+	if err != nil {
+		return nil, err
+	}
+	switch apistruct.RedPacketType(packet.Type) {
+	case apistruct.Private, apistruct.Exclusive:
+		if userID != packet.ReceiveUserID {
+			return nil, eerrs.ErrRedPacketNotAllowed.WrapMsg("red packet is for another user")
+		}
+	case apistruct.Luck:
+		if msgData.GroupID != packet.GroupID {
+			return nil, eerrs.ErrRedPacketNotAllowed.WrapMsg("red packet is for another group")
+		}
+	}
+	if err := o.checkRedPacketClaim(ctx, packet, userID); err != nil {
+		return nil, err
+	}
+	if packet.Settlement == constant.RedPacketSettlementHTTP && o.RedPacketSettlement == nil {
+		return nil, errs.ErrInternalServer.WrapMsg("red packet settlement is not configured")
+	}
+	callback := &chatdb.RedPacketCallback{
+		ClientMsgID: msgData.ClientMsgID,
+		Operation:   constant.RedPacketCallbackReceive,
+		UserID:      userID,
+		RedPacketID: redPacketID,
+		CreateTime:  time.Now(),
+	}
+	claim, settlement, err := o.Database.ClaimRedPacket(ctx, redPacketID, callback)
+	if err == nil {
+		if settlement != nil {
+			// the claim is recorded, the refund sweep retries a failed settlement
+			if err := o.settleRedPacket(ctx, settlement); err != nil {
+				log.ZWarn(ctx, "settle red packet receive failed", err, "settlementID", settlement.SettlementID)
+			}
+		}
+		return claim, nil
+	}
+	if callback, _ := o.takeRedPacketCallback(ctx, msgData.ClientMsgID, constant.RedPacketCallbackReceive); callback != nil {
+		return callbackClaim(callback), nil
+	}
+	if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	// another claim won the race, report why the packet can no longer be claimed
+	if packet, err = o.takeRedPacket(ctx, redPacketID); err != nil {
+		return nil, err
+	}
+	if err := o.checkRedPacketClaim(ctx, packet, userID); err != nil {
+		return nil, err
+	}
+	return nil, eerrs.ErrRedPacketEmpty.Wrap()
+}
+
+// takeRedPacketCallback returns the recorded outcome of a callback that already took effect, or nil.
+// OpenIM retries before send callbacks, a retry must replay that outcome instead of applying it again.
+func (o *chatSvr) takeRedPacketCallback(ctx context.Context, clientMsgID string, operation string) (*chatdb.RedPacketCallback, error) {
+	callback, err := o.Database.TakeRedPacketCallback(ctx, clientMsgID, operation)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	log.ZInfo(ctx, "red packet callback replayed", "clientMsgID", clientMsgID, "operation", operation, "redPacketID", callback.RedPacketID)
+	return callback, nil
+}
+
+// retryRedPacketSend settles the send of a replayed callback again if the payment backend has not accepted it yet.
+func (o *chatSvr) retryRedPacketSend(ctx context.Context, clientMsgID string) error {
+	settlement, err := o.Database.TakeRedPacketSettlement(ctx, redpacket.SettlementID(constant.RedPacketCallbackSend, clientMsgID))
+	if err != nil {
+		// ledger packets have no settlement
+		if dbutil.IsDBNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if settlement.Status == constant.RedPacketSettlementDone {
+		return nil
+	}
+	return o.settleRedPacket(ctx, settlement)
+}
+
+// settleRedPacket sends a recorded settlement to the payment backend and marks it done.
+// It runs after the ledger change commits, the backend applies a settlementID once so a settlement is safe to retry.
+func (o *chatSvr) settleRedPacket(ctx context.Context, settlement *chatdb.RedPacketSettlement) error {
+	if o.RedPacketSettlement == nil {
+		return errs.ErrInternalServer.WrapMsg("red packet settlement is not configured")
+	}
+	var err error
+	switch settlement.Operation {
+	case constant.RedPacketCallbackSend:
+		err = o.RedPacketSettlement.Send(ctx, settlement.UserID, settlement.SettlementID, settlement.Params)
+	case constant.RedPacketCallbackReceive:
+		err = o.RedPacketSettlement.Receive(ctx, settlement.UserID, settlement.SettlementID, settlement.RedPacketID, settlement.ClientMsgID)
+	case constant.RedPacketSettlementRefund:
+		err = o.RedPacketSettlement.Refund(ctx, settlement.UserID, settlement.SettlementID, settlement.RedPacketID, redpacket.FormatAmount(settlement.Amount))
+	default:
+		err = errs.ErrInternalServer.WrapMsg("unknown red packet settlement operation", "operation", settlement.Operation)
+	}
+	if err != nil {
+		if err := o.Database.FailRedPacketSettlement(ctx, settlement.SettlementID, err.Error()); err != nil {
+			log.ZError(ctx, "record red packet settlement failure failed", err, "settlementID", settlement.SettlementID)
+		}
+		return err
+	}
+	// not found means a concurrent retry completed it first
+	if err := o.Database.CompleteRedPacketSettlement(ctx, settlement); err != nil && !dbutil.IsDBNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func callbackClaim(callback *chatdb.RedPacketCallback) *chatdb.RedPacketClaim {
+	return &chatdb.RedPacketClaim{
+		RedPacketID: callback.RedPacketID,
+		UserID:      callback.UserID,
+		Amount:      callback.Amount,
+		ClaimTime:   callback.CreateTime,
+	}
+}
+
+// checkGroupMember makes sure userID is in the group, OpenIM only checks the sender of a group message.
+func (o *chatSvr) checkGroupMember(ctx context.Context, groupID string, userID string) error {
+	token, err := o.IMApi.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	memberUserIDs, err := o.IMApi.FindGroupMemberUserIDs(mctx.WithApiToken(ctx, token), groupID, []string{userID})
+	if err != nil {
+		return err
+	}
+	if !datautil.Contain(userID, memberUserIDs...) {
+		return eerrs.ErrNotInGroup.WrapMsg("receiver is not in the group")
+	}
+	return nil
+}
+
+func (o *chatSvr) takeRedPacket(ctx context.Context, redPacketID string) (*chatdb.RedPacket, error) {
+	packet, err := o.Database.TakeRedPacket(ctx, redPacketID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("red packet not found")
+		}
+		return nil, err
+	}
+	return packet, nil
+}
+
+func (o *chatSvr) checkRedPacketClaim(ctx context.Context, packet *chatdb.RedPacket, userID string) error {
+	if datautil.Contain(userID, packet.ClaimedUserIDs...) {
+		return eerrs.ErrRedPacketClaimed.Wrap()
+	}
+	if packet.Status == constant.RedPacketRefunded {
+		return eerrs.ErrRedPacketExpired.Wrap()
+	}
+	if packet.Unsettled {
+		return eerrs.ErrRedPacketNotAllowed.WrapMsg("red packet is not paid yet")
+	}
+	if len(packet.RemainAmounts) == 0 {
+		return eerrs.ErrRedPacketEmpty.Wrap()
+	}
+	if !packet.ExpireTime.After(time.Now()) {
+		o.refundExpiredRedPacket(ctx, packet)
+		return eerrs.ErrRedPacketExpired.Wrap()
+	}
+	return nil
+}
+
+// refundExpiredRedPacket returns what is left of an expired packet to its sender without waiting for the refund sweep,
+// errors are only logged because the packet stays pending and the sweep retries it.
+func (o *chatSvr) refundExpiredRedPacket(ctx context.Context, packet *chatdb.RedPacket) {
+	if packet.Status != constant.RedPacketPending || len(packet.RemainAmounts) == 0 || packet.ExpireTime.After(time.Now()) {
+		return
+	}
+	if _, err := o.refundRedPacket(ctx, packet.RedPacketID); err != nil && !dbutil.IsDBNotFound(err) {
+		log.ZError(ctx, "refund red packet failed", err, "redPacketID", packet.RedPacketID)
+	}
+}
+
+func (o *chatSvr) refundRedPacket(ctx context.Context, redPacketID string) (*chatdb.RedPacket, error) {
+	packet, settlement, err := o.Database.RefundRedPacket(ctx, redPacketID)
+	if err != nil {
+		return nil, err
+	}
+	if settlement != nil {
+		// the refund is recorded, the refund sweep retries a failed settlement
+		if err := o.settleRedPacket(ctx, settlement); err != nil {
+			log.ZWarn(ctx, "settle red packet refund failed", err, "settlementID", settlement.SettlementID)
+		}
+	}
+	return packet, nil
+}
+
+func (o *chatSvr) GetRedPacket(ctx context.Context, req *chat.GetRedPacketReq) (*chat.GetRedPacketResp, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	packet, err := o.takeRedPacket(ctx, req.RedPacketID)
+	if err != nil {
+		return nil, err
+	}
+	// group packets are shown to whoever opens the message, private ones only to the two sides
+	if userType != constant.AdminUser && packet.GroupID == "" && opUserID != packet.SendUserID && opUserID != packet.ReceiveUserID {
+		return nil, errs.ErrNoPermission.WrapMsg("not a party of the red packet")
+	}
+	if packet.Status == constant.RedPacketPending && len(packet.RemainAmounts) > 0 && !packet.ExpireTime.After(time.Now()) {
+		o.refundExpiredRedPacket(ctx, packet)
+		if packet, err = o.takeRedPacket(ctx, req.RedPacketID); err != nil {
+			return nil, err
+		}
+	}
+	claims, err := o.Database.FindRedPacketClaims(ctx, req.RedPacketID)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.GetRedPacketResp{RedPacket: convert.RedPacketDB2Pb(packet, claims)}, nil
+}
+
+func (o *chatSvr) GetRedPacketBalance(ctx context.Context, req *chat.GetRedPacketBalanceReq) (*chat.GetRedPacketBalanceResp, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == "" || userType != constant.AdminUser {
+		req.UserID = opUserID
+	}
+	balance, err := o.Database.TakeRedPacketBalance(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.GetRedPacketBalanceResp{Balance: redpacket.FormatAmount(balance)}, nil
+}
+
+// AdjustRedPacketBalance credits or debits the ledger balance of a user, it is how balances are funded.
+func (o *chatSvr) AdjustRedPacketBalance(ctx context.Context, req *chat.AdjustRedPacketBalanceReq) (*chat.AdjustRedPacketBalanceResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	amountStr, negative := strings.CutPrefix(req.Amount, "-")
+	amount, err := redpacket.ParseAmount(amountStr)
+	if err != nil {
+		return nil, err
+	}
+	if negative {
+		amount = -amount
+	}
+	if _, err := o.Database.GetAttribute(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.AdjustRedPacketBalance(ctx, req.UserID, amount); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrInsufficientBalance.Wrap()
+		}
+		return nil, err
+	}
+	balance, err := o.Database.TakeRedPacketBalance(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.AdjustRedPacketBalanceResp{Balance: redpacket.FormatAmount(balance)}, nil
+}
+
+// FindRedPacketCallbacks lets the settlement backend reconcile the callbacks of a message with the ledger.
+func (o *chatSvr) FindRedPacketCallbacks(ctx context.Context, req *chat.FindRedPacketCallbacksReq) (*chat.FindRedPacketCallbacksResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	callbacks, err := o.Database.FindRedPacketCallbacks(ctx, req.ClientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.FindRedPacketCallbacksResp{Callbacks: convert.RedPacketCallbacksDB2Pb(callbacks)}, nil
+}
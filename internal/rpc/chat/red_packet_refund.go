@@ -0,0 +1,136 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+	"github.com/openimsdk/chat/pkg/redpacket"
+	"github.com/openimsdk/chat/pkg/redpacket/apistruct"
+)
+
+const (
+	redPacketRefundJob   = "red_packet_refund"
+	redPacketRefundBatch = 100
+
+	// a settlement is retried once the request that recorded it is over, and given up after redPacketSettleAttempts
+	redPacketSettleDelay    = time.Minute
+	redPacketSettleAttempts = 20
+)
+
+// sweepRedPackets refunds expired red packets, retries failed settlements and notifies refunded senders.
+// The atomic pending to refunded transition and the claimed notification make every step safe to retry after a restart.
+func (o *chatSvr) sweepRedPackets(ctx context.Context) {
+	for {
+		packets, err := o.Database.FindExpiredRedPackets(ctx, time.Now(), redPacketRefundBatch)
+		if err != nil {
+			log.ZError(ctx, "find expired red packets failed", err)
+			return
+		}
+		for _, packet := range packets {
+			if _, err := o.refundRedPacket(ctx, packet.RedPacketID); err != nil && !dbutil.IsDBNotFound(err) {
+				// left pending, the next sweep retries it
+				log.ZError(ctx, "refund red packet failed", err, "redPacketID", packet.RedPacketID)
+				return
+			}
+		}
+		if len(packets) < redPacketRefundBatch {
+			break
+		}
+	}
+	// one page per sweep, a settlement that fails again stays pending
+	settlements, err := o.Database.FindPendingRedPacketSettlements(ctx, time.Now().Add(-redPacketSettleDelay), redPacketSettleAttempts, redPacketRefundBatch)
+	if err != nil {
+		log.ZError(ctx, "find pending red packet settlements failed", err)
+		return
+	}
+	for _, settlement := range settlements {
+		if err := o.settleRedPacket(ctx, settlement); err != nil {
+			log.ZWarn(ctx, "retry red packet settlement failed", err, "settlementID", settlement.SettlementID, "attempts", settlement.Attempts+1)
+		}
+	}
+	for {
+		packets, err := o.Database.FindUnnotifiedRedPackets(ctx, redPacketRefundBatch)
+		if err != nil {
+			log.ZError(ctx, "find unnotified red packets failed", err)
+			return
+		}
+		for _, packet := range packets {
+			if err := o.notifyRedPacketRefund(ctx, packet); err != nil && !dbutil.IsDBNotFound(err) {
+				log.ZError(ctx, "notify red packet refund failed", err, "redPacketID", packet.RedPacketID)
+				return
+			}
+		}
+		if len(packets) < redPacketRefundBatch {
+			break
+		}
+	}
+}
+
+// notifyRedPacketRefund tells the sender about a refund with a RefundRedPacket custom message from the IM admin.
+// The notification is claimed before the message is sent so that two sweeps never both send it, a failed send gives
+// the claim back for the next sweep.
+func (o *chatSvr) notifyRedPacketRefund(ctx context.Context, packet *chatdb.RedPacket) (err error) {
+	refund, err := json.Marshal(&apistruct.RefundRedPacket{
+		RedPacketID: packet.RedPacketID,
+		Amount:      redpacket.FormatAmount(packet.RefundAmount),
+		RefundTime:  packet.RefundTime.UnixMilli(),
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	data, err := json.Marshal(&apistruct.CustomData{
+		CustomType: constantpb.RefundRedPacket,
+		Version:    apistruct.CustomMsgVersion,
+		Data:       refund,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := o.Database.SetRedPacketNotified(ctx, packet.RedPacketID); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if err := o.Database.ResetRedPacketNotified(ctx, packet.RedPacketID); err != nil {
+			log.ZError(ctx, "reset red packet notified failed", err, "redPacketID", packet.RedPacketID)
+		}
+	}()
+	token, err := o.IMApi.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = o.IMApi.SendMsg(mctx.WithApiToken(ctx, token), &imapi.SendMsgReq{
+		SendID:           o.Share.OpenIM.AdminUserID,
+		RecvID:           packet.SendUserID,
+		SenderPlatformID: constantpb.AdminPlatformID,
+		Content:          map[string]any{"data": string(data), "description": "", "extension": ""},
+		ContentType:      constantpb.Custom,
+		SessionType:      constantpb.SingleChatType,
+	})
+	return err
+}
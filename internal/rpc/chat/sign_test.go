@@ -4,7 +4,9 @@ import (
 	"bytes"
 	_ "encoding/hex"
 	"github.com/ethereum/go-ethereum/crypto"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -87,3 +89,95 @@ func TestVerifySignatureMalleable(t *testing.T) {
 		t.Error("VerifySignature returned true for malleable signature")
 	}
 }
+
+func TestCheckSigner(t *testing.T) {
+	pub, err := crypto.UnmarshalPubkey(testpubkey)
+	if err != nil {
+		t.Fatalf("unmarshal pubkey: %s", err)
+	}
+	address := crypto.PubkeyToAddress(*pub).Hex()
+	if err := checkSigner(hexutil.Encode(testpubkey), address, ""); err != nil {
+		t.Errorf("uncompressed key rejected for its own address: %s", err)
+	}
+	if err := checkSigner(hexutil.Encode(testpubkeyc), strings.ToLower(address), hexutil.Encode(testpubkey)); err != nil {
+		t.Errorf("compressed key rejected for its own address: %s", err)
+	}
+	if err := checkSigner(hexutil.Encode(testpubkey), "", ""); err == nil {
+		t.Errorf("empty address accepted")
+	}
+}
+
+// An attacker signs the sign-in message with their own key but claims the victim's address.
+func TestCheckSignerAttack(t *testing.T) {
+	victim, _ := crypto.GenerateKey()
+	attacker, _ := crypto.GenerateKey()
+	victimAddress := crypto.PubkeyToAddress(victim.PublicKey)
+	victimPubkey := hexutil.Encode(crypto.FromECDSAPub(&victim.PublicKey))
+
+	message := testSignIn.siweMessage(victimAddress, "0123456789abcdef", time.Now())
+	sig, err := crypto.Sign(textHash(message), attacker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := recoverSigner(message, hexutil.Encode(sig))
+	if err != nil {
+		t.Fatalf("recover signer: %s", err)
+	}
+	if err := checkSigner(signer, victimAddress.Hex(), ""); err == nil {
+		t.Errorf("attacker key accepted for victim address")
+	}
+	if err := checkSigner(signer, victimAddress.Hex(), victimPubkey); err == nil {
+		t.Errorf("attacker key accepted for victim address and stored key")
+	}
+	if err := checkSigner(victimPubkey, victimAddress.Hex(), signer); err == nil {
+		t.Errorf("key accepted although it differs from the stored key")
+	}
+}
+
+var testSignIn = signIn{
+	ValidTime: 5 * time.Minute,
+	Domain:    "example.com",
+	URI:       "https://example.com/login",
+	ChainID:   1,
+	Statement: "Sign in to OpenIM Chat",
+}
+
+func TestSiweMessage(t *testing.T) {
+	address := common.HexToAddress("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+	issuedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	want := "example.com wants you to sign in with your Ethereum account:\n" +
+		"0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n" +
+		"Sign in to OpenIM Chat\n\n" +
+		"URI: https://example.com/login\n" +
+		"Version: 1\n" +
+		"Chain ID: 1\n" +
+		"Nonce: 0123456789abcdef\n" +
+		"Issued At: 2024-01-02T03:04:05Z\n" +
+		"Expiration Time: 2024-01-02T03:09:05Z"
+	if got := testSignIn.siweMessage(address, "0123456789abcdef", issuedAt); got != want {
+		t.Errorf("siwe message mismatch:\nwant: %q\nhave: %q", want, got)
+	}
+}
+
+func TestRecoverSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	message := testSignIn.siweMessage(crypto.PubkeyToAddress(key.PublicKey), "0123456789abcdef", time.Now())
+	sig, err := crypto.Sign(textHash(message), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27 // wallets return v as 27/28
+	signer, err := recoverSigner(message, hexutil.Encode(sig))
+	if err != nil {
+		t.Fatalf("recover signer: %s", err)
+	}
+	if signer != hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)) {
+		t.Errorf("signer mismatch: have %s", signer)
+	}
+	if other, err := recoverSigner(message+" ", hexutil.Encode(sig)); err == nil && other == signer {
+		t.Errorf("signature valid for a different message")
+	}
+	if _, err := recoverSigner(message, hexutil.Encode(sig[:64])); err == nil {
+		t.Errorf("signature without recovery id accepted")
+	}
+}
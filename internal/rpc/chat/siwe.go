@@ -0,0 +1,76 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/openimsdk/tools/errs"
+)
+
+type signIn struct {
+	ValidTime time.Duration
+	Domain    string
+	URI       string
+	ChainID   int64
+	Statement string
+}
+
+// siweMessage builds an EIP-4361 (Sign-In with Ethereum) message for address and nonce.
+func (s signIn) siweMessage(address common.Address, nonce string, issuedAt time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s wants you to sign in with your Ethereum account:\n%s\n\n", s.Domain, address.Hex())
+	if s.Statement != "" {
+		fmt.Fprintf(&b, "%s\n\n", s.Statement)
+	}
+	fmt.Fprintf(&b, "URI: %s\n", s.URI)
+	b.WriteString("Version: 1\n")
+	fmt.Fprintf(&b, "Chain ID: %d\n", s.ChainID)
+	fmt.Fprintf(&b, "Nonce: %s\n", nonce)
+	fmt.Fprintf(&b, "Issued At: %s\n", issuedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Expiration Time: %s", issuedAt.Add(s.ValidTime).UTC().Format(time.RFC3339))
+	return b.String()
+}
+
+// textHash is the EIP-191 hash that personal_sign signs.
+func textHash(message string) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+}
+
+// recoverSigner returns the uncompressed public key that produced the personal_sign signature over message.
+func recoverSigner(message, signature string) (string, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return "", errs.ErrArgs.WrapMsg("signature is not hex")
+	}
+	if len(sig) != crypto.SignatureLength {
+		return "", errs.ErrArgs.WrapMsg("signature length is invalid")
+	}
+	sig = common.CopyBytes(sig)
+	// wallets return v as 27/28, go-ethereum expects 0/1
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.Ecrecover(textHash(message), sig)
+	if err != nil {
+		return "", errs.ErrArgs.WrapMsg("signature is wrong!")
+	}
+	return hexutil.Encode(pub), nil
+}
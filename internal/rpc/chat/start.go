@@ -6,11 +6,13 @@ import (
 
 	"github.com/openimsdk/chat/pkg/redpacket"
 
+	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/common/rtc"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/db/tx"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
@@ -18,6 +20,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/openimsdk/chat/pkg/callback"
 	"github.com/openimsdk/chat/pkg/common/config"
 	"github.com/openimsdk/chat/pkg/common/db/database"
 	"github.com/openimsdk/chat/pkg/email"
@@ -37,10 +40,10 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if len(config.Share.ChatAdmin) == 0 {
 		return errs.New("share chat admin not configured")
 	}
-	//rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
-	//if err != nil {
-	//	return err
-	//}
+	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	if err != nil {
+		return err
+	}
 	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
 	if err != nil {
 		return err
@@ -57,7 +60,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if mail := config.RpcConfig.VerifyCode.Mail; mail.Enable {
 		srv.Mail = email.NewMail(mail.SMTPAddr, mail.SMTPPort, mail.SenderMail, mail.SenderAuthorizationCode, mail.Title)
 	}
-	srv.Database, err = database.NewChatDatabase(mgocli)
+	srv.Database, err = database.NewChatDatabase(mgocli, rdb)
 	if err != nil {
 		return err
 	}
@@ -74,25 +77,84 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		ValidTime:  time.Duration(config.RpcConfig.VerifyCode.ValidTime) * time.Second,
 		Len:        config.RpcConfig.VerifyCode.Len,
 	}
+	srv.SignIn = signIn{
+		ValidTime: time.Duration(config.RpcConfig.Nonce.ValidTime) * time.Second,
+		Domain:    config.RpcConfig.Nonce.Domain,
+		URI:       config.RpcConfig.Nonce.URI,
+		ChainID:   config.RpcConfig.Nonce.ChainID,
+		Statement: config.RpcConfig.Nonce.Statement,
+	}
+	srv.RecoveryExpire = time.Duration(config.RpcConfig.Recovery.Expire) * time.Second
+	srv.RecoveryTimeLock = time.Duration(config.RpcConfig.Recovery.TimeLock) * time.Second
 	srv.Livekit = rtc.NewLiveKit(config.RpcConfig.LiveKit.Key, config.RpcConfig.LiveKit.Secret, config.RpcConfig.LiveKit.URL)
-	srv.RedPacketClient = redpacket.NewRedPacketClient(config.Share.RedPacket.ApiURL)
+	srv.PostEditWindow = time.Duration(config.RpcConfig.Post.EditWindow) * time.Second
+	srv.PostTrashWindow = time.Duration(config.RpcConfig.Post.TrashWindow) * time.Second
+	srv.PostTimeline = database.TimelineOption{
+		Length:      config.RpcConfig.Post.Timeline.Length,
+		Expire:      time.Duration(config.RpcConfig.Post.Timeline.Expire) * time.Second,
+		FanOutLimit: config.RpcConfig.Post.Timeline.FanOutLimit,
+	}
+	srv.PostDiscoverWindow = time.Duration(config.RpcConfig.Post.Discover.Window) * time.Second
+	srv.PostViewFlushInterval = time.Duration(config.RpcConfig.Post.View.FlushInterval) * time.Second
+	srv.PostViewDedupe = time.Duration(config.RpcConfig.Post.View.Dedupe) * time.Second
+	srv.RedPacketSettlement = redpacket.NewHTTPSettlement(&config.Share.RedPacket)
+	srv.RedPacketExpire = time.Duration(config.Share.RedPacket.Expire) * time.Second
+	srv.RedPacketMaxCount = config.Share.RedPacket.MaxCount
+	srv.IMApi = imapi.New(config.Share.OpenIM.ApiURL, config.Share.OpenIM.Secret, config.Share.OpenIM.AdminUserID)
 	srv.Share = config.Share
+	callbackTimeout := time.Duration(config.RpcConfig.Callback.Timeout) * time.Second
+	if callbackTimeout <= 0 {
+		callbackTimeout = 5 * time.Second
+	}
+	srv.Callback = srv.newCallbackRouter(callbackTimeout)
 	srv.tx = mgocli.GetTx()
 	chat.RegisterChatServer(server, &srv)
+	if interval := config.Share.RedPacket.RefundInterval; interval > 0 {
+		go srv.runJob(ctx, redPacketRefundJob, time.Duration(interval)*time.Second, srv.sweepRedPackets)
+	}
+	if interval := config.RpcConfig.Post.PurgeInterval; interval > 0 {
+		go srv.runJob(ctx, postPurgeJob, time.Duration(interval)*time.Second, srv.purgePosts)
+	}
+	if interval := config.RpcConfig.Post.ReconcileInterval; interval > 0 {
+		go srv.runJob(ctx, counterReconcileJob, time.Duration(interval)*time.Second, srv.reconcileCounters)
+	}
+	if interval := config.RpcConfig.Post.Discover.Interval; interval > 0 {
+		go srv.runJob(ctx, discoverScoreJob, time.Duration(interval)*time.Second, srv.scoreDiscoverPosts)
+	}
+	if srv.PostViewFlushInterval > 0 {
+		go srv.runJob(ctx, postViewFlushJob, srv.PostViewFlushInterval, srv.flushPostViews)
+	}
 	return nil
 }
 
 type chatSvr struct {
-	tx 							tx.Tx
-	Database        database.ChatDatabaseInterface
-	Admin           *chatClient.AdminClient
-	SMS             sms.SMS
-	Mail            email.Mail
-	Code            verifyCode
-	Livekit         *rtc.LiveKit
-	ChatAdminUserID string
-	RedPacketClient *redpacket.Client
-	Share           config.Share
+	tx               tx.Tx
+	Database         database.ChatDatabaseInterface
+	Admin            *chatClient.AdminClient
+	SMS              sms.SMS
+	Mail             email.Mail
+	Code             verifyCode
+	SignIn           signIn
+	RecoveryExpire   time.Duration
+	RecoveryTimeLock time.Duration
+	Livekit          *rtc.LiveKit
+	ChatAdminUserID  string
+	PostEditWindow   time.Duration // no limit when 0
+	PostTrashWindow  time.Duration
+	PostTimeline     database.TimelineOption
+	// PostDiscoverWindow is how far back posts are scored again when the discover weights change
+	PostDiscoverWindow time.Duration
+	// PostViewFlushInterval is how often the views counted in redis are added to the posts, views are not counted when 0
+	PostViewFlushInterval time.Duration
+	// PostViewDedupe is the window in which a user's views of a post are counted once, every view is counted when 0
+	PostViewDedupe time.Duration
+	// RedPacketSettlement is nil unless an HTTP red packet backend is configured
+	RedPacketSettlement redpacket.Settlement
+	RedPacketExpire     time.Duration
+	RedPacketMaxCount   int
+	IMApi               imapi.CallerInterface
+	Callback            *callback.Router
+	Share               config.Share
 }
 
 func (o *chatSvr) WithAdminUser(ctx context.Context) context.Context {
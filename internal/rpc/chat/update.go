@@ -17,6 +17,7 @@ package chat
 import (
 	"github.com/openimsdk/tools/errs"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 )
 
@@ -52,6 +53,14 @@ func ToDBAttributeUpdate(req *chat.UpdateUserInfoReq) (map[string]any, error) {
 	if req.GlobalRecvMsgOpt != nil {
 		update["global_recv_msg_opt"] = req.GlobalRecvMsgOpt.Value
 	}
+	if req.PostInteraction != nil {
+		switch req.PostInteraction.Value {
+		case constant.PostInteractionEveryone, constant.PostInteractionFollowers, constant.PostInteractionFriends:
+		default:
+			return nil, errs.ErrArgs.WrapMsg("invalid post interaction")
+		}
+		update["post_interaction"] = req.PostInteraction.Value
+	}
 	if len(update) == 0 {
 		return nil, errs.ErrArgs.WrapMsg("no update info")
 	}
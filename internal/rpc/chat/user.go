@@ -153,7 +153,7 @@ func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountRe
 		AllowAddFriend:    constant.DefaultAllowAddFriend,
 	}
 
-	if err := o.Database.RegisterUser(ctx, register, account, attribute); err != nil {
+	if err := o.Database.RegisterUser(ctx, register, account, attribute, nil); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,193 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+)
+
+// resolveUserKey finds the key an address signs in with. Accounts registered before
+// user_keys existed only have the address on their attribute, which is used as a fallback.
+func (o *chatSvr) resolveUserKey(ctx context.Context, address string) (*chatdb.UserKey, error) {
+	if !common.IsHexAddress(address) {
+		return nil, errs.ErrArgs.WrapMsg("address is invalid")
+	}
+	address = common.HexToAddress(address).Hex()
+	key, err := o.Database.TakeUserKeyByAddress(ctx, address)
+	if err == nil {
+		return key, nil
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttributeByAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &chatdb.UserKey{
+		UserID:     attribute.UserID,
+		Address:    attribute.Address,
+		PublicKey:  attribute.PublicKey,
+		Status:     constant.UserKeyActive,
+		CreateTime: attribute.CreateTime,
+	}, nil
+}
+
+// checkUserKeySignature verifies a challenge signature and returns the signing key, which must be an active key of userID.
+func (o *chatSvr) checkUserKeySignature(ctx context.Context, userID string, nonce, signature string) (*chatdb.UserKey, error) {
+	signer, err := o.validateSignature(ctx, nonce, signature)
+	if err != nil {
+		return nil, err
+	}
+	address, err := pubkeyToAddress(signer)
+	if err != nil {
+		return nil, err
+	}
+	key, err := o.resolveUserKey(ctx, address.Hex())
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrUserKeyNotFound.WrapMsg("signing key is not linked to any account")
+		}
+		return nil, err
+	}
+	if key.UserID != userID || key.Status != constant.UserKeyActive {
+		return nil, errs.ErrNoPermission.WrapMsg("signing key is not an active key of the user")
+	}
+	if err := checkSigner(signer, key.Address, key.PublicKey); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (o *chatSvr) AddUserKey(ctx context.Context, req *chat.AddUserKeyReq) (*chat.AddUserKeyResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.Address) {
+		return nil, errs.ErrArgs.WrapMsg("address is invalid")
+	}
+	address := common.HexToAddress(req.Address).Hex()
+	if exist, err := o.resolveUserKey(ctx, address); err == nil {
+		if exist.Status == constant.UserKeyActive {
+			return nil, eerrs.ErrUserKeyAlreadyBound.Wrap()
+		}
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	// an existing key of the account approves the new one
+	if _, err := o.checkUserKeySignature(ctx, opUserID, req.ApproveNonce, req.ApproveSignature); err != nil {
+		return nil, err
+	}
+	// the new key proves it is held by the caller
+	signer, err := o.validateSignature(ctx, req.Nonce, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSigner(signer, address, ""); err != nil {
+		return nil, err
+	}
+	key := &chatdb.UserKey{
+		UserID:     opUserID,
+		Address:    address,
+		PublicKey:  signer,
+		Name:       req.Name,
+		DeviceID:   req.DeviceID,
+		Platform:   constantpb.PlatformIDToName(int(req.Platform)),
+		Status:     constant.UserKeyActive,
+		CreateTime: time.Now(),
+	}
+	if err := o.Database.AddUserKey(ctx, key); err != nil {
+		return nil, err
+	}
+	return &chat.AddUserKeyResp{}, nil
+}
+
+func (o *chatSvr) ListUserKeys(ctx context.Context, req *chat.ListUserKeysReq) (*chat.ListUserKeysResp, error) {
+	opUserID, _, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == "" {
+		req.UserID = opUserID
+	}
+	if err := mctx.CheckAdminOr(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := o.Database.FindUserKeys(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	primary := common.HexToAddress(attribute.Address).Hex()
+	resp := &chat.ListUserKeysResp{Keys: make([]*chat.UserKeyInfo, 0, len(keys)+1)}
+	var hasPrimary bool
+	for _, key := range keys {
+		isPrimary := key.Address == primary
+		hasPrimary = hasPrimary || isPrimary
+		resp.Keys = append(resp.Keys, convert.UserKeyDB2Pb(key, isPrimary))
+	}
+	if !hasPrimary && attribute.Address != "" {
+		key := &chatdb.UserKey{
+			UserID:     attribute.UserID,
+			Address:    primary,
+			PublicKey:  attribute.PublicKey,
+			Status:     constant.UserKeyActive,
+			CreateTime: attribute.CreateTime,
+		}
+		resp.Keys = append([]*chat.UserKeyInfo{convert.UserKeyDB2Pb(key, true)}, resp.Keys...)
+	}
+	return resp, nil
+}
+
+func (o *chatSvr) RevokeUserKey(ctx context.Context, req *chat.RevokeUserKeyReq) (*chat.RevokeUserKeyResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(req.Address) {
+		return nil, errs.ErrArgs.WrapMsg("address is invalid")
+	}
+	address := common.HexToAddress(req.Address).Hex()
+	attribute, err := o.Database.GetAttribute(ctx, opUserID)
+	if err != nil {
+		return nil, err
+	}
+	if common.HexToAddress(attribute.Address).Hex() == address {
+		return nil, errs.ErrArgs.WrapMsg("primary key can not be revoked")
+	}
+	if err := o.Database.RevokeUserKey(ctx, opUserID, address); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrUserKeyNotFound.Wrap()
+		}
+		return nil, err
+	}
+	return &chat.RevokeUserKeyResp{}, nil
+}
@@ -15,12 +15,16 @@
 package chat
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mw/specialerror"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -33,21 +37,79 @@ func generateNonce(size int) (string, error) {
 	return hex.EncodeToString(nonce), nil
 }
 
-func validateSignature(publicKey, nonce, signature string) (b bool, err error) {
-	pubKey, err := hexutil.Decode(publicKey)
+// pubkeyToAddress derives the wallet address from a compressed or uncompressed secp256k1 public key.
+func pubkeyToAddress(publicKey string) (common.Address, error) {
+	key, err := hexutil.Decode(publicKey)
 	if err != nil {
-		return false, err
+		return common.Address{}, errs.ErrArgs.WrapMsg("public key is not hex")
 	}
-	message, err := hexutil.Decode(nonce)
+	if len(key) == 33 {
+		pub, err := crypto.DecompressPubkey(key)
+		if err != nil {
+			return common.Address{}, errs.ErrArgs.WrapMsg("public key is invalid")
+		}
+		return crypto.PubkeyToAddress(*pub), nil
+	}
+	pub, err := crypto.UnmarshalPubkey(key)
 	if err != nil {
-		return false, err
+		return common.Address{}, errs.ErrArgs.WrapMsg("public key is invalid")
 	}
-	sig, err := hexutil.Decode(signature)
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// checkSigner makes sure publicKey belongs to address, and to the stored public key when there is one.
+func checkSigner(publicKey, address, storedPublicKey string) error {
+	signer, err := pubkeyToAddress(publicKey)
 	if err != nil {
-		return false, err
+		return err
+	}
+	if !common.IsHexAddress(address) || signer != common.HexToAddress(address) {
+		return eerrs.ErrSignerMismatch.WrapMsg("public key does not match address")
+	}
+	if storedPublicKey != "" {
+		stored, err := pubkeyToAddress(storedPublicKey)
+		if err != nil {
+			return err
+		}
+		if signer != stored {
+			return eerrs.ErrSignerMismatch.WrapMsg("public key does not match registered key")
+		}
+	}
+	return nil
+}
+
+// validateSignature verifies the personal_sign signature over the sign-in message issued with nonce,
+// consumes the nonce and returns the public key of the signer.
+func (o *chatSvr) validateSignature(ctx context.Context, nonce, signature string) (string, error) {
+	message, err := o.Database.GetNonceMessage(ctx, nonce)
+	if err != nil {
+		return "", err
+	}
+	if message == "" {
+		return "", eerrs.ErrNonceNotFound.Wrap()
+	}
+	publicKey, err := recoverSigner(message, signature)
+	if err != nil {
+		return "", err
+	}
+	signer, err := pubkeyToAddress(publicKey)
+	if err != nil {
+		return "", err
+	}
+	state, err := o.Database.UseNonce(ctx, nonce, []string{signer.Hex()})
+	if err != nil {
+		return "", err
+	}
+	switch state {
+	case cache.NonceValid:
+		return publicKey, nil
+	case cache.NonceExpired:
+		return "", eerrs.ErrNonceExpired.Wrap()
+	case cache.NonceUsed:
+		return "", eerrs.ErrNonceUsed.Wrap()
+	default:
+		return "", eerrs.ErrNonceNotFound.Wrap()
 	}
-	b = crypto.VerifySignature(pubKey, message, sig)
-	return b, nil
 }
 
 func IsNotFound(err error) bool {
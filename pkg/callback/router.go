@@ -0,0 +1,281 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package callback routes OpenIM webhook callbacks to the handlers registered for their command,
+// and custom messages of the send message callbacks to the handlers registered for their customType.
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+	"github.com/openimsdk/chat/pkg/redpacket/apistruct"
+	"github.com/openimsdk/chat/pkg/redpacket/servererrs"
+)
+
+// Phase tells whether a custom message handler runs before or after OpenIM stores the message.
+type Phase int
+
+const (
+	BeforeSend Phase = iota
+	AfterSend
+)
+
+// MsgReq is the body of the before and after send single and group message callbacks.
+type MsgReq struct {
+	constantpb.CommonCallbackReq
+	RecvID  string `json:"recvID"`
+	GroupID string `json:"groupID"`
+}
+
+// Handler handles the raw body of one command.
+type Handler func(ctx context.Context, body []byte) (*chat.OpenIMCallbackResp, error)
+
+// CustomHandler handles a custom message of one customType.
+type CustomHandler func(ctx context.Context, msg *MsgReq, data *apistruct.CustomData) (*chat.OpenIMCallbackResp, error)
+
+// Continue lets OpenIM go on with the request.
+func Continue() *chat.OpenIMCallbackResp {
+	return &chat.OpenIMCallbackResp{
+		ActionCode: 0,
+		NextCode:   0,
+	}
+}
+
+// Reject stops OpenIM from going on with the request, reporting the code of err to the client.
+func Reject(err error) *chat.OpenIMCallbackResp {
+	resp := &chat.OpenIMCallbackResp{
+		ActionCode: 0,
+		NextCode:   1,
+		ErrDlt:     err.Error(),
+		ErrMsg:     err.Error(),
+		ErrCode:    servererrs.ServerInternalError,
+	}
+	if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		resp.ErrCode = int32(codeErr.Code())
+		resp.ErrMsg = codeErr.Msg()
+	}
+	return resp
+}
+
+type route struct {
+	name    string
+	timeout time.Duration
+	handle  func(ctx context.Context) (*chat.OpenIMCallbackResp, error)
+}
+
+// Router dispatches callbacks, it must be fully registered before the first Dispatch.
+type Router struct {
+	timeout  time.Duration
+	commands map[string]Handler
+	customs  map[Phase]map[int32]customRoute
+	metrics  *metrics
+}
+
+type customRoute struct {
+	handler CustomHandler
+	timeout time.Duration
+}
+
+// Option changes how a single handler runs.
+type Option func(timeout *time.Duration)
+
+// WithTimeout overrides the default timeout of the router for one handler.
+func WithTimeout(timeout time.Duration) Option {
+	return func(t *time.Duration) {
+		*t = timeout
+	}
+}
+
+// NewRouter returns a router whose handlers time out after timeout, the send message commands are
+// registered up front to dispatch custom messages.
+func NewRouter(timeout time.Duration) *Router {
+	r := &Router{
+		timeout:  timeout,
+		commands: make(map[string]Handler),
+		customs:  map[Phase]map[int32]customRoute{BeforeSend: {}, AfterSend: {}},
+		metrics:  &metrics{routes: make(map[string]*RouteMetrics)},
+	}
+	r.commands[constantpb.CallbackBeforeSendSingleMsgCommand] = r.customHandler(BeforeSend)
+	r.commands[constantpb.CallbackBeforeSendGroupMsgCommand] = r.customHandler(BeforeSend)
+	r.commands[constantpb.CallbackAfterSendSingleMsgCommand] = r.customHandler(AfterSend)
+	r.commands[constantpb.CallbackAfterSendGroupMsgCommand] = r.customHandler(AfterSend)
+	return r
+}
+
+// Handle registers handler for command, replacing the custom message dispatch of the send message commands.
+func (r *Router) Handle(command string, handler Handler, opts ...Option) {
+	timeout := r.option(opts)
+	r.commands[command] = func(ctx context.Context, body []byte) (*chat.OpenIMCallbackResp, error) {
+		return r.run(ctx, route{name: command, timeout: timeout, handle: func(ctx context.Context) (*chat.OpenIMCallbackResp, error) {
+			return handler(ctx, body)
+		}})
+	}
+}
+
+// HandleCustom registers handler for the custom messages of customType sent by users.
+func (r *Router) HandleCustom(phase Phase, customType int32, handler CustomHandler, opts ...Option) {
+	r.customs[phase][customType] = customRoute{handler: handler, timeout: r.option(opts)}
+}
+
+func (r *Router) option(opts []Option) time.Duration {
+	timeout := r.timeout
+	for _, opt := range opts {
+		opt(&timeout)
+	}
+	return timeout
+}
+
+// Dispatch runs the handler of command, commands without a handler are rejected.
+func (r *Router) Dispatch(ctx context.Context, command string, body string) (*chat.OpenIMCallbackResp, error) {
+	handler, ok := r.commands[command]
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("invalid command " + command)
+	}
+	return handler(ctx, []byte(body))
+}
+
+func (r *Router) customHandler(phase Phase) Handler {
+	return func(ctx context.Context, body []byte) (*chat.OpenIMCallbackResp, error) {
+		var msg MsgReq
+		if err := json.Unmarshal(body, &msg); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if msg.MsgFrom != constantpb.UserMsgType || msg.ContentType != constantpb.Custom {
+			return Continue(), nil
+		}
+		// custom messages keep their own schema, the ones that do not parse have no handler to run
+		data, err := apistruct.ParseCustomData(msg.Content)
+		if err != nil {
+			return Continue(), nil
+		}
+		custom, ok := r.customs[phase][data.CustomType]
+		if !ok {
+			return Continue(), nil
+		}
+		return r.run(ctx, route{
+			name:    msg.CallbackCommand + "/" + customTypeName(data.CustomType),
+			timeout: custom.timeout,
+			handle: func(ctx context.Context) (*chat.OpenIMCallbackResp, error) {
+				return custom.handler(ctx, &msg, data)
+			},
+		})
+	}
+}
+
+// run calls a handler with its timeout and records its metrics. A handler that runs past its timeout
+// is rejected with ErrCallbackTimeout so that OpenIM retries it, handlers must stop once ctx is done
+// and be idempotent, a handler that already committed its side effects replays them on the retry.
+func (r *Router) run(ctx context.Context, rt route) (*chat.OpenIMCallbackResp, error) {
+	start := time.Now()
+	type result struct {
+		resp *chat.OpenIMCallbackResp
+		err  error
+	}
+	ctx, cancel := context.WithTimeout(ctx, rt.timeout)
+	defer cancel()
+	done := make(chan result, 1)
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				done <- result{err: errs.ErrInternalServer.WrapMsg("callback handler panic", "panic", e)}
+			}
+		}()
+		resp, err := rt.handle(ctx)
+		done <- result{resp: resp, err: err}
+	}()
+	var res result
+	var timeout bool
+	select {
+	case res = <-done:
+	case <-ctx.Done():
+		timeout = true
+		res.resp = Reject(eerrs.ErrCallbackTimeout.WrapMsg(rt.name))
+		log.ZWarn(ctx, "callback handler timeout", nil, "route", rt.name, "timeout", rt.timeout)
+	}
+	r.metrics.record(rt.name, time.Since(start), res.resp, res.err, timeout)
+	return res.resp, res.err
+}
+
+// Metrics returns the metrics of every route that has been called, sorted by name.
+func (r *Router) Metrics() []*RouteMetrics {
+	return r.metrics.snapshot()
+}
+
+func customTypeName(customType int32) string {
+	return "customType:" + strconv.Itoa(int(customType))
+}
+
+// RouteMetrics counts the calls of a route since the process started.
+type RouteMetrics struct {
+	Route       string
+	Calls       int64
+	Errors      int64
+	Rejects     int64
+	Timeouts    int64
+	TotalTime   time.Duration
+	MaxDuration time.Duration
+}
+
+type metrics struct {
+	lock   sync.Mutex
+	routes map[string]*RouteMetrics
+}
+
+func (m *metrics) record(name string, duration time.Duration, resp *chat.OpenIMCallbackResp, err error, timeout bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	rm, ok := m.routes[name]
+	if !ok {
+		rm = &RouteMetrics{Route: name}
+		m.routes[name] = rm
+	}
+	rm.Calls++
+	rm.TotalTime += duration
+	if duration > rm.MaxDuration {
+		rm.MaxDuration = duration
+	}
+	switch {
+	case timeout:
+		rm.Timeouts++
+	case err != nil:
+		rm.Errors++
+	case resp != nil && resp.NextCode != 0:
+		rm.Rejects++
+	}
+}
+
+func (m *metrics) snapshot() []*RouteMetrics {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := make([]*RouteMetrics, 0, len(m.routes))
+	for _, rm := range m.routes {
+		v := *rm
+		res = append(res, &v)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Route < res[j].Route
+	})
+	return res
+}
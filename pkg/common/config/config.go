@@ -24,11 +24,30 @@ type Share struct {
 	ChatAdmin   []string     `mapstructure:"chatAdmin"`
 	ProxyHeader string       `mapstructure:"proxyHeader"`
 	RedPacket   RpcRedPacket `mapstructure:"redPacket"`
+	RateLimit   RateLimit    `mapstructure:"rateLimit"`
+}
+
+type RateLimit struct {
+	Nonce struct {
+		Window       int `mapstructure:"window"`
+		IPLimit      int `mapstructure:"ipLimit"`
+		AddressLimit int `mapstructure:"addressLimit"`
+	} `mapstructure:"nonce"`
+	Login struct {
+		Window        int `mapstructure:"window"`
+		MaxFailures   int `mapstructure:"maxFailures"`
+		IPMaxFailures int `mapstructure:"ipMaxFailures"`
+		Lockout       int `mapstructure:"lockout"`
+	} `mapstructure:"login"`
 }
 
 type RpcRedPacket struct {
-	ApiURL  string `mapstructure:"apiURL"`
-	Timeout int    `mapstructure:"timeout"`
+	ApiURL   string `mapstructure:"apiURL"`
+	Timeout  int    `mapstructure:"timeout"`
+	Expire   int    `mapstructure:"expire"`
+	MaxCount int    `mapstructure:"maxCount"`
+	// RefundInterval is the number of seconds between two sweeps of expired red packets
+	RefundInterval int `mapstructure:"refundInterval"`
 }
 
 type RpcRegisterName struct {
@@ -145,11 +164,44 @@ type Chat struct {
 			SMTPPort                int    `mapstructure:"smtpPort"`
 		} `mapstructure:"mail"`
 	} `mapstructure:"verifyCode"`
+	Nonce struct {
+		ValidTime int    `mapstructure:"validTime"`
+		Domain    string `mapstructure:"domain"`
+		URI       string `mapstructure:"uri"`
+		ChainID   int64  `mapstructure:"chainID"`
+		Statement string `mapstructure:"statement"`
+	} `mapstructure:"nonce"`
+	Recovery struct {
+		Expire   int `mapstructure:"expire"`
+		TimeLock int `mapstructure:"timeLock"`
+	} `mapstructure:"recovery"`
 	LiveKit struct {
 		URL    string `mapstructure:"url"`
 		Key    string `mapstructure:"key"`
 		Secret string `mapstructure:"secret"`
 	} `mapstructure:"liveKit"`
+	Callback struct {
+		Timeout int `mapstructure:"timeout"`
+	} `mapstructure:"callback"`
+	Post struct {
+		EditWindow        int `mapstructure:"editWindow"`
+		TrashWindow       int `mapstructure:"trashWindow"`
+		PurgeInterval     int `mapstructure:"purgeInterval"`
+		ReconcileInterval int `mapstructure:"reconcileInterval"`
+		Timeline          struct {
+			Length      int64 `mapstructure:"length"`
+			Expire      int   `mapstructure:"expire"`
+			FanOutLimit int64 `mapstructure:"fanOutLimit"`
+		} `mapstructure:"timeline"`
+		Discover struct {
+			Interval int `mapstructure:"interval"`
+			Window   int `mapstructure:"window"`
+		} `mapstructure:"discover"`
+		View struct {
+			FlushInterval int `mapstructure:"flushInterval"`
+			Dedupe        int `mapstructure:"dedupe"`
+		} `mapstructure:"view"`
+	} `mapstructure:"post"`
 }
 
 type Admin struct {
@@ -159,7 +211,12 @@ type Admin struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	TokenPolicy struct {
-		Expire int `mapstructure:"expire"`
+		Expire       int `mapstructure:"expire"`
+		AccessExpire int `mapstructure:"accessExpire"`
+		MultiLogin   struct {
+			Policy      int `mapstructure:"policy"`
+			MaxSessions int `mapstructure:"maxSessions"`
+		} `mapstructure:"multiLogin"`
 	} `mapstructure:"tokenPolicy"`
 	Secret string `mapstructure:"secret"`
 }
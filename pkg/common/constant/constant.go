@@ -118,6 +118,7 @@ const (
 	Reply     = 2
 	Like      = 3
 	Collect   = 4
+	Discover  = 5
 )
 
 const (
@@ -164,3 +165,158 @@ const (
 	Pinned   = 1
 	UnPinned = 0
 )
+
+// Post visibility, posts created before visibility existed have no value and are public.
+const (
+	PostVisibilityPublic    = 0
+	PostVisibilityFollowers = 1
+	PostVisibilityFriends   = 2
+	PostVisibilityPrivate   = 3
+	PostVisibilityCustom    = 4
+
+	MaxPostVisibleUsers = 1000
+)
+
+const (
+	PostNotDeleted = 0
+	PostDeleted    = 1
+)
+
+// Posts hidden by an admin are left out of every feed and show up as tombstones where they are referenced.
+const (
+	PostNotHidden = 0
+	PostHidden    = 1
+)
+
+// Post report reasons.
+const (
+	PostReportSpam       = 1
+	PostReportHarassment = 2
+	PostReportSexual     = 3
+	PostReportIllegal    = 4
+	PostReportOther      = 5
+
+	MaxPostReportDescription = 500
+)
+
+// Post report statuses, a report leaves pending when an admin moderates the post.
+const (
+	PostReportPending   = 0
+	PostReportResolved  = 1
+	PostReportDismissed = 2
+)
+
+// Post moderation actions.
+const (
+	// PostModerationDismiss dismisses the pending reports and leaves the post as it is.
+	PostModerationDismiss = 1
+	PostModerationHide    = 2
+	PostModerationUnhide  = 3
+	// PostModerationRemove hides the post and deletes it with its comments, the author can not restore it from the trash.
+	PostModerationRemove = 4
+	// PostModerationWarn sends the author a warning.
+	PostModerationWarn = 5
+	// PostModerationBan hides the post and blocks the author.
+	PostModerationBan = 6
+)
+
+// Comment sort modes.
+const (
+	CommentSortNewest = 0
+	CommentSortOldest = 1
+	CommentSortTop    = 2
+)
+
+// Hashtag limits.
+const (
+	// MaxPostHashtags is the number of hashtags kept from the content of a post, the rest are ignored.
+	MaxPostHashtags = 10
+	// MaxHashtagLength is the max length of a hashtag in runes, longer tags are ignored.
+	MaxHashtagLength = 64
+)
+
+// Who can like, comment on, forward or reference a user's posts, users without the setting allow everyone.
+const (
+	PostInteractionEveryone  = 0
+	PostInteractionFollowers = 1
+	PostInteractionFriends   = 2
+)
+
+// Post notification types.
+const (
+	NotificationLike      = 1
+	NotificationComment   = 2
+	NotificationForward   = 3
+	NotificationReference = 4
+	NotificationMention   = 5
+)
+
+const (
+	NotificationUnread = 0
+	NotificationRead   = 1
+
+	// MaxNotificationActors is the number of latest actors kept in an aggregated notification.
+	MaxNotificationActors = 10
+)
+
+// Limits of the replies returned under each comment.
+const (
+	MaxCommentReplyDepth = 3
+	MaxCommentReplyCount = 20
+)
+
+const (
+	UserKeyActive  = 1
+	UserKeyRevoked = 2
+)
+
+const (
+	RecoveryModeGuardian = 1
+	RecoveryModeAdmin    = 2
+)
+
+const (
+	RecoveryPending   = 1
+	RecoveryExecuted  = 2
+	RecoveryCancelled = 3
+)
+
+const MaxRecoveryGuardians = 10
+
+// Multi-login policies for user sessions.
+const (
+	// MultiLoginUnlimited never kicks an older session.
+	MultiLoginUnlimited = 0
+	// MultiLoginPerPlatform keeps one session per platform.
+	MultiLoginPerPlatform = 1
+	// MultiLoginPerClass keeps one session per PC, mobile or web class, other platforms are kept one per platform.
+	MultiLoginPerClass = 2
+	// MultiLoginMaxSessions keeps at most maxSessions sessions, kicking the oldest.
+	MultiLoginMaxSessions = 3
+)
+
+const (
+	RedPacketPending  = 1
+	RedPacketRefunded = 2
+)
+
+// Where the money of a red packet is settled.
+const (
+	RedPacketSettlementLedger = "ledger"
+	RedPacketSettlementHTTP   = "http"
+)
+
+// Operations of red packet message callbacks.
+const (
+	RedPacketCallbackSend    = "send"
+	RedPacketCallbackReceive = "receive"
+)
+
+// Red packet settlement statuses, a settlement stays pending until the payment backend accepts it.
+const (
+	RedPacketSettlementPending = 0
+	RedPacketSettlementDone    = 1
+
+	// RedPacketSettlementRefund is the operation of a refund settlement, sends and receives use the callback operations.
+	RedPacketSettlementRefund = "refund"
+)
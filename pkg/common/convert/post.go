@@ -28,6 +28,13 @@ func PostDB2Pb(postDB *chat.Post) *chatpb.Post {
 	}
 	postPB.CreateTime = postDB.CreateTime.UnixMilli()
 	postPB.UpdateTime = postDB.UpdateTime.UnixMilli()
+	if postDB.EditCount > 0 {
+		postPB.Edited = 1
+		postPB.EditTime = postDB.EditTime.UnixMilli()
+	}
+	if postDB.Deleted == constant.PostDeleted {
+		postPB.DeleteTime = postDB.DeleteTime.UnixMilli()
+	}
 	postPB.UserInfo = DbToPbAttribute(postDB.UserInfo)
 	postPB.AtUserInfoList = DbToPbAttributes(postDB.AtUserInfoList)
 	postPB.MediaMsgs = PostMediasDB2Pb(postDB.MediaMsgs)
@@ -38,6 +45,38 @@ func PostsDB2Pb(postsDB []*chat.Post) []*chatpb.Post {
 	return datautil.Slice(postsDB, PostDB2Pb)
 }
 
+func PostRevisionDB2Pb(revision *chat.PostRevision) *chatpb.PostRevision {
+	return &chatpb.PostRevision{
+		PostID:     revision.PostID,
+		Version:    revision.Version,
+		Content:    revision.Content,
+		AtUserIds:  revision.AtUserIds,
+		MediaMsgs:  PostMediasDB2Pb(revision.MediaMsgs),
+		CreateTime: revision.CreateTime.UnixMilli(),
+		EditTime:   revision.EditTime.UnixMilli(),
+	}
+}
+
+func PostRevisionsDB2Pb(revisions []*chat.PostRevision) []*chatpb.PostRevision {
+	return datautil.Slice(revisions, PostRevisionDB2Pb)
+}
+
+func UserCountersDB2Pb(userIDs []string, counters []*chat.UserCounter) []*chatpb.UserCounter {
+	counterMap := datautil.SliceToMap(counters, func(e *chat.UserCounter) string { return e.UserID })
+	return datautil.Slice(userIDs, func(userID string) *chatpb.UserCounter {
+		counter, ok := counterMap[userID]
+		if !ok {
+			return &chatpb.UserCounter{UserID: userID}
+		}
+		return &chatpb.UserCounter{
+			UserID:         counter.UserID,
+			PostCount:      counter.PostCount,
+			FollowerCount:  counter.FollowerCount,
+			FollowingCount: counter.FollowingCount,
+		}
+	})
+}
+
 func PostPb2DB(postPB *chatpb.Post) *chat.Post {
 	postDB := &chat.Post{}
 	if err := datautil.CopyStructFields(postDB, postPB); err != nil {
@@ -0,0 +1,58 @@
+package convert
+
+import (
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/redpacket"
+)
+
+func RedPacketDB2Pb(packet *chat.RedPacket, claims []*chat.RedPacketClaim) *chatpb.RedPacketInfo {
+	var remain int64
+	for _, amount := range packet.RemainAmounts {
+		remain += amount
+	}
+	info := &chatpb.RedPacketInfo{
+		RedPacketID:   packet.RedPacketID,
+		SendUserID:    packet.SendUserID,
+		Type:          packet.Type,
+		GroupID:       packet.GroupID,
+		ReceiveUserID: packet.ReceiveUserID,
+		TotalAmount:   redpacket.FormatAmount(packet.TotalAmount),
+		TotalCount:    packet.TotalCount,
+		RemainAmount:  redpacket.FormatAmount(remain),
+		RemainCount:   int32(len(packet.RemainAmounts)),
+		Status:        packet.Status,
+		Remark:        packet.Remark,
+		Emoji:         packet.Emoji,
+		CreateTime:    packet.CreateTime.UnixMilli(),
+		ExpireTime:    packet.ExpireTime.UnixMilli(),
+		RefundAmount:  redpacket.FormatAmount(packet.RefundAmount),
+		Claims:        make([]*chatpb.RedPacketClaim, 0, len(claims)),
+	}
+	if !packet.RefundTime.IsZero() {
+		info.RefundTime = packet.RefundTime.UnixMilli()
+	}
+	for _, claim := range claims {
+		info.Claims = append(info.Claims, &chatpb.RedPacketClaim{
+			UserID:    claim.UserID,
+			Amount:    redpacket.FormatAmount(claim.Amount),
+			ClaimTime: claim.ClaimTime.UnixMilli(),
+		})
+	}
+	return info
+}
+
+func RedPacketCallbacksDB2Pb(callbacks []*chat.RedPacketCallback) []*chatpb.RedPacketCallback {
+	res := make([]*chatpb.RedPacketCallback, 0, len(callbacks))
+	for _, callback := range callbacks {
+		res = append(res, &chatpb.RedPacketCallback{
+			ClientMsgID: callback.ClientMsgID,
+			Operation:   callback.Operation,
+			UserID:      callback.UserID,
+			RedPacketID: callback.RedPacketID,
+			Amount:      redpacket.FormatAmount(callback.Amount),
+			CreateTime:  callback.CreateTime.UnixMilli(),
+		})
+	}
+	return res
+}
@@ -2,6 +2,7 @@ package convert
 
 import (
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/chat/pkg/protocol/common"
 	"github.com/openimsdk/tools/utils/datautil"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -65,9 +66,62 @@ func DbToPbUserFullInfo(attribute *chat.Attribute) *common.UserFullInfo {
 		AllowVibration:   attribute.AllowVibration,
 		GlobalRecvMsgOpt: attribute.GlobalRecvMsgOpt,
 		RegisterType:     attribute.RegisterType,
+		PostInteraction:  attribute.PostInteraction,
 	}
 }
 
 func DbToPbUserFullInfos(attributes []*chat.Attribute) []*common.UserFullInfo {
 	return datautil.Slice(attributes, DbToPbUserFullInfo)
 }
+
+func UserKeyDB2Pb(key *chat.UserKey, isPrimary bool) *chatpb.UserKeyInfo {
+	info := &chatpb.UserKeyInfo{
+		Address:    key.Address,
+		PublicKey:  key.PublicKey,
+		Name:       key.Name,
+		DeviceID:   key.DeviceID,
+		Platform:   key.Platform,
+		Status:     key.Status,
+		IsPrimary:  isPrimary,
+		CreateTime: key.CreateTime.UnixMilli(),
+	}
+	if !key.RevokeTime.IsZero() {
+		info.RevokeTime = key.RevokeTime.UnixMilli()
+	}
+	if !key.LastLoginTime.IsZero() {
+		info.LastLoginTime = key.LastLoginTime.UnixMilli()
+	}
+	return info
+}
+
+func RecoveryDB2Pb(request *chat.RecoveryRequest) *chatpb.RecoveryInfo {
+	info := &chatpb.RecoveryInfo{
+		RecoveryID:      request.RecoveryID,
+		UserID:          request.UserID,
+		NewAddress:      request.NewAddress,
+		NewPublicKey:    request.NewPublicKey,
+		Mode:            request.Mode,
+		Status:          request.Status,
+		GuardianUserIDs: request.GuardianUserIDs,
+		Threshold:       request.Threshold,
+		Approvals:       make([]*chatpb.RecoveryApproval, 0, len(request.Approvals)),
+		OperatorUserID:  request.OperatorUserID,
+		Reason:          request.Reason,
+		CreateTime:      request.CreateTime.UnixMilli(),
+		ExpireTime:      request.ExpireTime.UnixMilli(),
+	}
+	for _, approval := range request.Approvals {
+		info.Approvals = append(info.Approvals, &chatpb.RecoveryApproval{
+			GuardianUserID: approval.GuardianUserID,
+			Address:        approval.Address,
+			ApproveTime:    approval.ApproveTime.UnixMilli(),
+		})
+	}
+	if !request.ExecuteAfter.IsZero() {
+		info.ExecuteAfter = request.ExecuteAfter.UnixMilli()
+	}
+	if !request.ExecuteTime.IsZero() {
+		info.ExecuteTime = request.ExecuteTime.UnixMilli()
+	}
+	return info
+}
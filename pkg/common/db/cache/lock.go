@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	jobLock = "CHAT_JOB_LOCK:"
+)
+
+// unlockScript only deletes the lock if it is still held by the same owner.
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// renewScript only extends the lock if it is still held by the same owner.
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// LockInterface keeps a background job running on one instance at a time.
+type LockInterface interface {
+	// Lock takes the lock for owner until expire, it returns false if another owner holds it.
+	Lock(ctx context.Context, name string, owner string, expire time.Duration) (bool, error)
+	// Renew extends a lock held by owner to expire, it returns false if owner no longer holds it.
+	Renew(ctx context.Context, name string, owner string, expire time.Duration) (bool, error)
+	Unlock(ctx context.Context, name string, owner string) error
+}
+
+type LockRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLockInterface(rdb redis.UniversalClient) *LockRedis {
+	return &LockRedis{rdb: rdb}
+}
+
+func (l *LockRedis) Lock(ctx context.Context, name string, owner string, expire time.Duration) (bool, error) {
+	ok, err := l.rdb.SetNX(ctx, jobLock+name, owner, expire).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return ok, nil
+}
+
+func (l *LockRedis) Renew(ctx context.Context, name string, owner string, expire time.Duration) (bool, error) {
+	n, err := renewScript.Run(ctx, l.rdb, []string{jobLock + name}, owner, expire.Milliseconds()).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n == 1, nil
+}
+
+func (l *LockRedis) Unlock(ctx context.Context, name string, owner string) error {
+	return errs.Wrap(unlockScript.Run(ctx, l.rdb, []string{jobLock + name}, owner).Err())
+}
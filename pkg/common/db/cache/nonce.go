@@ -0,0 +1,113 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	walletNonce = "CHAT_WALLET_NONCE:"
+)
+
+const (
+	NonceValid = iota
+	NonceNotFound
+	NonceExpired
+	NonceUsed
+)
+
+// useNonceScript checks the nonce owner, expiry and used flag and marks it used in one step.
+var useNonceScript = redis.NewScript(`
+local v = redis.call("HMGET", KEYS[1], "owner", "expire", "used")
+if not v[1] then
+	return 1
+end
+local matched = false
+for i = 2, #ARGV do
+	if ARGV[i] == v[1] then
+		matched = true
+		break
+	end
+end
+if not matched then
+	return 1
+end
+if v[3] == "1" then
+	return 3
+end
+if tonumber(v[2]) < tonumber(ARGV[1]) then
+	return 2
+end
+redis.call("HSET", KEYS[1], "used", "1")
+return 0
+`)
+
+type NonceInterface interface {
+	SetNonce(ctx context.Context, nonce string, owner string, message string, expire time.Duration) error
+	GetNonceMessage(ctx context.Context, nonce string) (string, error)
+	// UseNonce consumes the nonce if it was issued to one of owners, returning one of the Nonce* states.
+	UseNonce(ctx context.Context, nonce string, owners []string) (int, error)
+}
+
+type NonceCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewNonceInterface(rdb redis.UniversalClient) *NonceCacheRedis {
+	return &NonceCacheRedis{rdb: rdb}
+}
+
+func (n *NonceCacheRedis) SetNonce(ctx context.Context, nonce string, owner string, message string, expire time.Duration) error {
+	key := walletNonce + nonce
+	pipe := n.rdb.TxPipeline()
+	pipe.HSet(ctx, key, "owner", strings.ToLower(owner), "message", message, "expire", time.Now().Add(expire).UnixMilli(), "used", 0)
+	// keep the record past its expiry so a late or replayed attempt is reported as expired or used, not unknown
+	pipe.Expire(ctx, key, expire*2)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+// GetNonceMessage returns the message issued with nonce, or an empty string if the nonce is unknown.
+func (n *NonceCacheRedis) GetNonceMessage(ctx context.Context, nonce string) (string, error) {
+	message, err := n.rdb.HGet(ctx, walletNonce+nonce, "message").Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return message, nil
+}
+
+func (n *NonceCacheRedis) UseNonce(ctx context.Context, nonce string, owners []string) (int, error) {
+	args := make([]any, 0, len(owners)+1)
+	args = append(args, time.Now().UnixMilli())
+	for _, owner := range owners {
+		if owner != "" {
+			args = append(args, strings.ToLower(owner))
+		}
+	}
+	state, err := useNonceScript.Run(ctx, n.rdb, []string{walletNonce + nonce}, args...).Int()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return state, nil
+}
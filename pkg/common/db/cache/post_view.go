@@ -0,0 +1,101 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	postViewer = "CHAT_POST_VIEWER:"
+	// the counted views and the ones being flushed share a hash slot so that they can be renamed in a cluster
+	postViews         = "{CHAT_POST_VIEWS}"
+	postViewsFlushing = "{CHAT_POST_VIEWS}:FLUSHING"
+)
+
+// takeViewsScript moves the counted views to the flushing hash unless a failed flush left it, and returns the flushing hash.
+var takeViewsScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[2]) == 0 and redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("RENAME", KEYS[1], KEYS[2])
+end
+return redis.call("HGETALL", KEYS[2])
+`)
+
+// PostViewInterface counts post views in redis so that reading a post does not write it,
+// the counted views are flushed to the posts by a background job.
+type PostViewInterface interface {
+	// AddView counts a view of the post by the user at most once per dedupe, every view is counted when dedupe is 0.
+	// It returns false if the view was not counted.
+	AddView(ctx context.Context, postID string, userID string, dedupe time.Duration) (bool, error)
+	// TakeViews moves the views counted since the last call to a flushing hash and returns it,
+	// the views left in the flushing hash by a failed flush are returned again instead.
+	TakeViews(ctx context.Context) (map[string]int64, error)
+	// DoneViews removes the views of the flushed posts from the flushing hash.
+	DoneViews(ctx context.Context, postIDs ...string) error
+}
+
+type PostViewRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewPostViewInterface(rdb redis.UniversalClient) *PostViewRedis {
+	return &PostViewRedis{rdb: rdb}
+}
+
+func (p *PostViewRedis) AddView(ctx context.Context, postID string, userID string, dedupe time.Duration) (bool, error) {
+	// SetNX without an expiry would keep the viewer forever
+	if dedupe > 0 {
+		ok, err := p.rdb.SetNX(ctx, postViewer+postID+":"+userID, "", dedupe).Result()
+		if err != nil {
+			return false, errs.Wrap(err)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	if err := p.rdb.HIncrBy(ctx, postViews, postID, 1).Err(); err != nil {
+		return false, errs.Wrap(err)
+	}
+	return true, nil
+}
+
+func (p *PostViewRedis) TakeViews(ctx context.Context) (map[string]int64, error) {
+	values, err := takeViewsScript.Run(ctx, p.rdb, []string{postViews, postViewsFlushing}).StringSlice()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	views := make(map[string]int64, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		postID, value := values[i], values[i+1]
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		views[postID] = n
+	}
+	return views, nil
+}
+
+func (p *PostViewRedis) DoneViews(ctx context.Context, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(p.rdb.HDel(ctx, postViewsFlushing, postIDs...).Err())
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TestTakeViewsAfterFailedFlush checks that the views of a post that was not flushed are taken again.
+func TestTakeViewsAfterFailedFlush(t *testing.T) {
+	ctx := context.Background()
+	rdb := testRedis(t)
+	views := NewPostViewInterface(rdb)
+	flushed, failed := "test_"+uuid.New().String(), "test_"+uuid.New().String()
+	t.Cleanup(func() {
+		_ = rdb.HDel(ctx, postViews, flushed, failed).Err()
+		_ = views.DoneViews(ctx, flushed, failed)
+	})
+
+	for _, postID := range []string{flushed, failed} {
+		if _, err := views.AddView(ctx, postID, "user", time.Minute); err != nil {
+			t.Fatalf("add view: %v", err)
+		}
+	}
+	taken, err := views.TakeViews(ctx)
+	if err != nil {
+		t.Fatalf("take views: %v", err)
+	}
+	if taken[flushed] != 1 || taken[failed] != 1 {
+		t.Fatalf("take views = %v, want one view of each post", taken)
+	}
+	// 只有 flushed 写入成功, failed 留在 flushing hash 中
+	if err := views.DoneViews(ctx, flushed); err != nil {
+		t.Fatalf("done views: %v", err)
+	}
+	if _, err := views.AddView(ctx, flushed, "other", time.Minute); err != nil {
+		t.Fatalf("add view: %v", err)
+	}
+
+	taken, err = views.TakeViews(ctx)
+	if err != nil {
+		t.Fatalf("take views: %v", err)
+	}
+	if taken[failed] != 1 {
+		t.Fatalf("views of the failed post = %d, want 1", taken[failed])
+	}
+	if _, ok := taken[flushed]; ok {
+		t.Fatalf("new views were taken before the failed flush was finished")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	rateLimit = "CHAT_RATE_LIMIT:"
+)
+
+// slidingWindowScript drops hits older than the window, then adds a hit unless the limit is reached.
+// A limit of 0 always adds. It returns the number of hits in the window before this one, or -1 if rejected.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+local count = redis.call("ZCARD", KEYS[1])
+if limit > 0 and count >= limit then
+	return -1
+end
+redis.call("ZADD", KEYS[1], now, ARGV[4])
+redis.call("PEXPIRE", KEYS[1], window)
+return count
+`)
+
+type RateLimitInterface interface {
+	// Allow records a hit on key and reports whether it is within limit hits per window.
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+	// Hit records a hit on key and returns the number of hits inside the window, this one included.
+	Hit(ctx context.Context, key string, window time.Duration) (int64, error)
+	Reset(ctx context.Context, key string) error
+}
+
+type RateLimitRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewRateLimitInterface(rdb redis.UniversalClient) *RateLimitRedis {
+	return &RateLimitRedis{rdb: rdb}
+}
+
+func (r *RateLimitRedis) run(ctx context.Context, key string, limit int, window time.Duration) (int64, error) {
+	now := time.Now()
+	member := strconv.FormatInt(now.UnixNano(), 10) + ":" + strconv.Itoa(rand.Int())
+	count, err := slidingWindowScript.Run(ctx, r.rdb, []string{rateLimit + key}, now.UnixMilli(), window.Milliseconds(), limit, member).Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return count, nil
+}
+
+func (r *RateLimitRedis) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	count, err := r.run(ctx, key, limit, window)
+	if err != nil {
+		return false, err
+	}
+	return count >= 0, nil
+}
+
+func (r *RateLimitRedis) Hit(ctx context.Context, key string, window time.Duration) (int64, error) {
+	count, err := r.run(ctx, key, 0, window)
+	if err != nil {
+		return 0, err
+	}
+	return count + 1, nil
+}
+
+func (r *RateLimitRedis) Reset(ctx context.Context, key string) error {
+	return errs.Wrap(r.rdb.Del(ctx, rateLimit+key).Err())
+}
@@ -0,0 +1,146 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	postTimeline        = "CHAT_POST_TIMELINE:"
+	postTimelineFollows = "CHAT_POST_TIMELINE_FOLLOWS:"
+)
+
+// timelineBuilt is kept at score 0 so that a built timeline without posts still exists,
+// trimming drops it first once the timeline is full.
+const timelineBuilt = "0"
+
+// pushTimelineScript adds a post to a timeline that has been built and trims it to the max length,
+// a timeline that does not exist is left for the next read to build.
+var pushTimelineScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -tonumber(ARGV[3]) - 1)
+return 1
+`)
+
+// TimelineItem is a post in a timeline, scored by its create time in milliseconds.
+type TimelineItem struct {
+	PostID     string
+	CreateTime int64
+}
+
+// TimelineInterface keeps the follow timeline of each user as a sorted set of post IDs.
+type TimelineInterface interface {
+	// Follows returns the digest of the followed users the timeline of userID was built with, empty if it is not built.
+	Follows(ctx context.Context, userID string) (string, error)
+	// Build replaces the timeline of userID with items until expire, recording the digest of the followed users.
+	Build(ctx context.Context, userID string, follows string, items []TimelineItem, expire time.Duration) error
+	// Push adds the post to the built timelines of userIDs, keeping at most length posts in each.
+	Push(ctx context.Context, userIDs []string, item TimelineItem, length int64) error
+	// Range returns up to count posts after the given one, newest first, a nil after starts from the newest.
+	Range(ctx context.Context, userID string, after *TimelineItem, count int64) ([]TimelineItem, error)
+}
+
+type TimelineRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewTimelineInterface(rdb redis.UniversalClient) *TimelineRedis {
+	return &TimelineRedis{rdb: rdb}
+}
+
+func (t *TimelineRedis) Follows(ctx context.Context, userID string) (string, error) {
+	follows, err := t.rdb.Get(ctx, postTimelineFollows+userID).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return follows, nil
+}
+
+func (t *TimelineRedis) Build(ctx context.Context, userID string, follows string, items []TimelineItem, expire time.Duration) error {
+	key := postTimeline + userID
+	members := make([]redis.Z, 0, len(items)+1)
+	members = append(members, redis.Z{Score: 0, Member: timelineBuilt})
+	for _, item := range items {
+		members = append(members, redis.Z{Score: float64(item.CreateTime), Member: item.PostID})
+	}
+	pipe := t.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.ZAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, expire)
+	pipe.Set(ctx, postTimelineFollows+userID, follows, expire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (t *TimelineRedis) Push(ctx context.Context, userIDs []string, item TimelineItem, length int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	if err := pushTimelineScript.Load(ctx, t.rdb).Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	pipe := t.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pushTimelineScript.EvalSha(ctx, pipe, []string{postTimeline + userID}, item.CreateTime, item.PostID, length)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (t *TimelineRedis) Range(ctx context.Context, userID string, after *TimelineItem, count int64) ([]TimelineItem, error) {
+	maxScore := "+inf"
+	if after != nil {
+		maxScore = strconv.FormatInt(after.CreateTime, 10)
+	}
+	items := make([]TimelineItem, 0, count)
+	// 同一毫秒的帖子按帖子ID倒序排列, 跳过 after 及之前的帖子
+	for offset := int64(0); int64(len(items)) < count; {
+		res, err := t.rdb.ZRevRangeByScoreWithScores(ctx, postTimeline+userID, &redis.ZRangeBy{Min: "(0", Max: maxScore, Offset: offset, Count: count}).Result()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		for _, z := range res {
+			postID, _ := z.Member.(string)
+			item := TimelineItem{PostID: postID, CreateTime: int64(z.Score)}
+			if after != nil && item.CreateTime == after.CreateTime && item.PostID >= after.PostID {
+				continue
+			}
+			if int64(len(items)) < count {
+				items = append(items, item)
+			}
+		}
+		if int64(len(res)) < count {
+			break
+		}
+		offset += int64(len(res))
+	}
+	return items, nil
+}
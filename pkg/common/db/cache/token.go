@@ -16,21 +16,67 @@ package cache
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
 
 	"github.com/openimsdk/tools/utils/stringutil"
 
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
+
 	"github.com/openimsdk/tools/errs"
 	"github.com/redis/go-redis/v9"
 )
 
 const (
-	chatToken = "CHAT_UID_TOKEN_STATUS:"
+	chatToken        = "CHAT_UID_TOKEN_STATUS:"
+	chatSession      = "CHAT_UID_SESSION:"
+	chatRefreshToken = "CHAT_REFRESH_TOKEN:"
 )
 
+// Session is one signed-in device. AccessToken is the token currently cached for it in CHAT_UID_TOKEN_STATUS,
+// the refresh token itself is never stored, only its hash.
+type Session struct {
+	SessionID        string `json:"sessionID"`
+	UserID           string `json:"userID"`
+	UserType         int32  `json:"userType"`
+	PlatformID       int32  `json:"platformID"`
+	DeviceID         string `json:"deviceID"`
+	IP               string `json:"ip"`
+	AccessToken      string `json:"accessToken"`
+	RefreshTokenHash string `json:"refreshTokenHash"`
+	CreateTime       int64  `json:"createTime"`
+	RefreshTime      int64  `json:"refreshTime"`
+	ExpireTime       int64  `json:"expireTime"`
+}
+
+// extendExpireScript sets the expiry of a key to ARGV[1] milliseconds unless it already lives longer,
+// the sessions of a user share one hash and re-saving an older session must not cut the newer ones short.
+var extendExpireScript = redis.NewScript(`
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl >= 0 and ttl >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call("PEXPIRE", KEYS[1], ARGV[1])
+return 1
+`)
+
 type TokenInterface interface {
 	AddTokenFlag(ctx context.Context, userID string, token string, flag int) error
 	GetTokensWithoutError(ctx context.Context, userID string) (map[string]int32, error)
 	DeleteTokenByUid(ctx context.Context, userID string) error
+	// SetSession caches the session with its access token and makes refreshToken redeemable until the session expires.
+	SetSession(ctx context.Context, session *Session, flag int, refreshToken string) error
+	// TakeRefreshToken redeems a refresh token once, returning the user and session it belongs to.
+	TakeRefreshToken(ctx context.Context, refreshToken string) (userID string, sessionID string, err error)
+	GetSession(ctx context.Context, userID string, sessionID string) (*Session, error)
+	GetSessions(ctx context.Context, userID string) ([]*Session, error)
+	DeleteSession(ctx context.Context, userID string, sessionID string) error
+	// KickSessions ends the sessions and keeps their access tokens with flag so they are reported as kicked.
+	KickSessions(ctx context.Context, userID string, sessions []*Session, flag int) error
+	DeleteAccessToken(ctx context.Context, userID string, token string) error
 }
 
 type TokenCacheRedis struct {
@@ -41,11 +87,40 @@ func NewTokenInterface(rdb redis.UniversalClient) *TokenCacheRedis {
 	return &TokenCacheRedis{rdb: rdb}
 }
 
+func refreshTokenHash(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
 func (t *TokenCacheRedis) AddTokenFlag(ctx context.Context, userID string, token string, flag int) error {
+	if err := t.pruneExpiredTokens(ctx, userID); err != nil {
+		return err
+	}
 	key := chatToken + userID
 	return errs.Wrap(t.rdb.HSet(ctx, key, token, flag).Err())
 }
 
+// pruneExpiredTokens removes the expired tokens of the user, tokens are only added to the hash
+// and would otherwise pile up for as long as the user keeps signing in.
+func (t *TokenCacheRedis) pruneExpiredTokens(ctx context.Context, userID string) error {
+	key := chatToken + userID
+	tokens, err := t.rdb.HKeys(ctx, key).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	now := time.Now()
+	var expired []string
+	for _, token := range tokens {
+		if tokenverify.IsExpired(token, now) {
+			expired = append(expired, token)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	return errs.Wrap(t.rdb.HDel(ctx, key, expired...).Err())
+}
+
 func (t *TokenCacheRedis) GetTokensWithoutError(ctx context.Context, userID string) (map[string]int32, error) {
 	key := chatToken + userID
 	m, err := t.rdb.HGetAll(ctx, key).Result()
@@ -60,6 +135,113 @@ func (t *TokenCacheRedis) GetTokensWithoutError(ctx context.Context, userID stri
 }
 
 func (t *TokenCacheRedis) DeleteTokenByUid(ctx context.Context, userID string) error {
-	key := chatToken + userID
-	return errs.Wrap(t.rdb.Del(ctx, key).Err())
+	sessions, err := t.GetSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	pipe := t.rdb.TxPipeline()
+	for _, session := range sessions {
+		pipe.Del(ctx, chatRefreshToken+session.RefreshTokenHash)
+	}
+	pipe.Del(ctx, chatToken+userID, chatSession+userID)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) SetSession(ctx context.Context, session *Session, flag int, refreshToken string) error {
+	if err := t.pruneExpiredTokens(ctx, session.UserID); err != nil {
+		return err
+	}
+	session.RefreshTokenHash = refreshTokenHash(refreshToken)
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	expire := time.Until(time.UnixMilli(session.ExpireTime))
+	if err := extendExpireScript.Load(ctx, t.rdb).Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	pipe := t.rdb.TxPipeline()
+	pipe.HSet(ctx, chatToken+session.UserID, session.AccessToken, flag)
+	pipe.HSet(ctx, chatSession+session.UserID, session.SessionID, data)
+	extendExpireScript.EvalSha(ctx, pipe, []string{chatSession + session.UserID}, expire.Milliseconds())
+	pipe.Set(ctx, chatRefreshToken+session.RefreshTokenHash, session.UserID+":"+session.SessionID, expire)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) TakeRefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
+	val, err := t.rdb.GetDel(ctx, chatRefreshToken+refreshTokenHash(refreshToken)).Result()
+	if err != nil {
+		return "", "", errs.Wrap(err)
+	}
+	userID, sessionID, ok := strings.Cut(val, ":")
+	if !ok {
+		return "", "", errs.ErrInternalServer.WrapMsg("refresh token value is invalid")
+	}
+	return userID, sessionID, nil
+}
+
+func (t *TokenCacheRedis) GetSession(ctx context.Context, userID string, sessionID string) (*Session, error) {
+	data, err := t.rdb.HGet(ctx, chatSession+userID, sessionID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var session Session
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &session, nil
+}
+
+// GetSessions returns the sessions of the user that have not expired.
+func (t *TokenCacheRedis) GetSessions(ctx context.Context, userID string) ([]*Session, error) {
+	m, err := t.rdb.HGetAll(ctx, chatSession+userID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	now := time.Now().UnixMilli()
+	sessions := make([]*Session, 0, len(m))
+	for _, data := range m {
+		var session Session
+		if err := json.Unmarshal([]byte(data), &session); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if session.ExpireTime < now {
+			continue
+		}
+		sessions = append(sessions, &session)
+	}
+	return sessions, nil
+}
+
+func (t *TokenCacheRedis) DeleteSession(ctx context.Context, userID string, sessionID string) error {
+	session, err := t.GetSession(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	pipe := t.rdb.TxPipeline()
+	pipe.HDel(ctx, chatToken+userID, session.AccessToken)
+	pipe.HDel(ctx, chatSession+userID, sessionID)
+	pipe.Del(ctx, chatRefreshToken+session.RefreshTokenHash)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) KickSessions(ctx context.Context, userID string, sessions []*Session, flag int) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	pipe := t.rdb.TxPipeline()
+	for _, session := range sessions {
+		pipe.HSet(ctx, chatToken+userID, session.AccessToken, flag)
+		pipe.HDel(ctx, chatSession+userID, session.SessionID)
+		pipe.Del(ctx, chatRefreshToken+session.RefreshTokenHash)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) DeleteAccessToken(ctx context.Context, userID string, token string) error {
+	return errs.Wrap(t.rdb.HDel(ctx, chatToken+userID, token).Err())
 }
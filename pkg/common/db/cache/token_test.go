@@ -0,0 +1,82 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// testRedis connects to the redis at CHAT_TEST_REDIS_ADDR, the test is skipped when it is not set.
+func testRedis(t *testing.T) redis.UniversalClient {
+	t.Helper()
+	addr := os.Getenv("CHAT_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("CHAT_TEST_REDIS_ADDR is not set")
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: addr, Password: os.Getenv("CHAT_TEST_REDIS_PASSWORD")})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		t.Fatalf("ping redis: %v", err)
+	}
+	t.Cleanup(func() { _ = rdb.Close() })
+	return rdb
+}
+
+// TestRefreshOlderSession checks that refreshing an older session keeps the sessions signed in after it.
+func TestRefreshOlderSession(t *testing.T) {
+	ctx := context.Background()
+	rdb := testRedis(t)
+	tokens := NewTokenInterface(rdb)
+	userID := "test_" + uuid.New().String()
+	t.Cleanup(func() { _ = tokens.DeleteTokenByUid(ctx, userID) })
+
+	now := time.Now()
+	older := &Session{SessionID: "older", UserID: userID, AccessToken: "older_token", CreateTime: now.UnixMilli(), ExpireTime: now.Add(time.Hour).UnixMilli()}
+	newer := &Session{SessionID: "newer", UserID: userID, AccessToken: "newer_token", CreateTime: now.UnixMilli(), ExpireTime: now.Add(2 * time.Hour).UnixMilli()}
+	if err := tokens.SetSession(ctx, older, 0, "older_refresh"); err != nil {
+		t.Fatalf("set older session: %v", err)
+	}
+	if err := tokens.SetSession(ctx, newer, 0, "newer_refresh"); err != nil {
+		t.Fatalf("set newer session: %v", err)
+	}
+
+	// 刷新较早的会话, 会话的过期时间不变
+	if _, sessionID, err := tokens.TakeRefreshToken(ctx, "older_refresh"); err != nil || sessionID != older.SessionID {
+		t.Fatalf("take older refresh token = %q, %v", sessionID, err)
+	}
+	session, err := tokens.GetSession(ctx, userID, older.SessionID)
+	if err != nil {
+		t.Fatalf("get older session: %v", err)
+	}
+	session.AccessToken = "older_token_2"
+	if err := tokens.SetSession(ctx, session, 0, "older_refresh_2"); err != nil {
+		t.Fatalf("renew older session: %v", err)
+	}
+
+	if ttl := rdb.PTTL(ctx, chatSession+userID).Val(); ttl < time.Hour+30*time.Minute {
+		t.Fatalf("session hash ttl = %v, want the newer session's expiry", ttl)
+	}
+	if _, sessionID, err := tokens.TakeRefreshToken(ctx, "newer_refresh"); err != nil || sessionID != newer.SessionID {
+		t.Fatalf("take newer refresh token = %q, %v", sessionID, err)
+	}
+	if _, err := tokens.GetSession(ctx, userID, newer.SessionID); err != nil {
+		t.Fatalf("get newer session: %v", err)
+	}
+}
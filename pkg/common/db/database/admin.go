@@ -55,6 +55,7 @@ type AdminDatabaseInterface interface {
 	AddIPForbidden(ctx context.Context, ms []*admindb.IPForbidden) error
 	FindIPForbidden(ctx context.Context, ms []string) ([]*admindb.IPForbidden, error)
 	DelIPForbidden(ctx context.Context, ips []string) error
+	LockIPForbidden(ctx context.Context, m *admindb.IPForbidden) error
 	FindDefaultFriend(ctx context.Context, userIDs []string) ([]string, error)
 	AddDefaultFriend(ctx context.Context, ms []*admindb.RegisterAddFriend) error
 	DelDefaultFriend(ctx context.Context, userIDs []string) error
@@ -74,9 +75,17 @@ type AdminDatabaseInterface interface {
 	DelUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error
 	CountLimitUserLoginIP(ctx context.Context, userID string) (uint32, error)
 	GetLimitUserLoginIP(ctx context.Context, userID string, ip string) (*admindb.LimitUserLoginIP, error)
+	LockUserLoginIP(ctx context.Context, m *admindb.LimitUserLoginIP) error
 	CacheToken(ctx context.Context, userID string, token string) error
 	GetTokens(ctx context.Context, userID string) (map[string]int32, error)
 	DeleteToken(ctx context.Context, userID string) error
+	CacheSession(ctx context.Context, session *cache.Session, refreshToken string) error
+	TakeRefreshToken(ctx context.Context, refreshToken string) (string, string, error)
+	GetSession(ctx context.Context, userID string, sessionID string) (*cache.Session, error)
+	GetSessions(ctx context.Context, userID string) ([]*cache.Session, error)
+	DeleteSession(ctx context.Context, userID string, sessionID string) error
+	KickSessions(ctx context.Context, userID string, sessions []*cache.Session) error
+	DeleteAccessToken(ctx context.Context, userID string, token string) error
 }
 
 func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDatabaseInterface, error) {
@@ -245,6 +254,10 @@ func (o *AdminDatabase) FindIPForbidden(ctx context.Context, ms []string) ([]*ad
 	return o.ipForbidden.Find(ctx, ms)
 }
 
+func (o *AdminDatabase) LockIPForbidden(ctx context.Context, m *admindb.IPForbidden) error {
+	return o.ipForbidden.Upsert(ctx, m)
+}
+
 func (o *AdminDatabase) DelIPForbidden(ctx context.Context, ips []string) error {
 	return o.ipForbidden.Delete(ctx, ips)
 }
@@ -305,6 +318,10 @@ func (o *AdminDatabase) FindBlockUser(ctx context.Context, userIDs []string) ([]
 	return o.forbiddenAccount.Find(ctx, userIDs)
 }
 
+func (o *AdminDatabase) LockUserLoginIP(ctx context.Context, m *admindb.LimitUserLoginIP) error {
+	return o.limitUserLoginIP.Upsert(ctx, m)
+}
+
 func (o *AdminDatabase) SearchUserLimitLogin(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.LimitUserLoginIP, error) {
 	return o.limitUserLoginIP.Search(ctx, keyword, pagination)
 }
@@ -336,3 +353,31 @@ func (o *AdminDatabase) GetTokens(ctx context.Context, userID string) (map[strin
 func (o *AdminDatabase) DeleteToken(ctx context.Context, userID string) error {
 	return o.cache.DeleteTokenByUid(ctx, userID)
 }
+
+func (o *AdminDatabase) CacheSession(ctx context.Context, session *cache.Session, refreshToken string) error {
+	return o.cache.SetSession(ctx, session, constant.NormalToken, refreshToken)
+}
+
+func (o *AdminDatabase) TakeRefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
+	return o.cache.TakeRefreshToken(ctx, refreshToken)
+}
+
+func (o *AdminDatabase) GetSession(ctx context.Context, userID string, sessionID string) (*cache.Session, error) {
+	return o.cache.GetSession(ctx, userID, sessionID)
+}
+
+func (o *AdminDatabase) GetSessions(ctx context.Context, userID string) ([]*cache.Session, error) {
+	return o.cache.GetSessions(ctx, userID)
+}
+
+func (o *AdminDatabase) DeleteSession(ctx context.Context, userID string, sessionID string) error {
+	return o.cache.DeleteSession(ctx, userID, sessionID)
+}
+
+func (o *AdminDatabase) KickSessions(ctx context.Context, userID string, sessions []*cache.Session) error {
+	return o.cache.KickSessions(ctx, userID, sessions, constant.KickedToken)
+}
+
+func (o *AdminDatabase) DeleteAccessToken(ctx context.Context, userID string, token string) error {
+	return o.cache.DeleteAccessToken(ctx, userID, token)
+}
@@ -16,17 +16,26 @@ package database
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	admindb "github.com/openimsdk/chat/pkg/common/db/model/admin"
 	"github.com/openimsdk/chat/pkg/common/db/model/chat"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
 	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/redpacket"
 )
 
 type ChatDatabaseInterface interface {
@@ -41,12 +50,22 @@ type ChatDatabaseInterface interface {
 	TakeAttributeByUserID(ctx context.Context, userID string) (*chatdb.Attribute, error)
 	Search(ctx context.Context, normalUser int32, keyword string, gender int32, pagination pagination.Pagination) (int64, []*chatdb.Attribute, error)
 	SearchUser(ctx context.Context, keyword string, userIDs []string, genders []int32, pagination pagination.Pagination) (int64, []*chatdb.Attribute, error)
+	FindForbiddenUserIDs(ctx context.Context, userIDs []string) ([]string, error)
 	CountVerifyCodeRange(ctx context.Context, account string, start time.Time, end time.Time) (int64, error)
 	AddVerifyCode(ctx context.Context, verifyCode *chatdb.VerifyCode, fn func() error) error
 	UpdateVerifyCodeIncrCount(ctx context.Context, id string) error
 	TakeLastVerifyCode(ctx context.Context, account string) (*chatdb.VerifyCode, error)
 	DelVerifyCode(ctx context.Context, id string) error
-	RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute) error
+	AddNonce(ctx context.Context, nonce string, owner string, message string, expire time.Duration) error
+	GetNonceMessage(ctx context.Context, nonce string) (string, error)
+	UseNonce(ctx context.Context, nonce string, owners []string) (int, error)
+	LockJob(ctx context.Context, name string, owner string, expire time.Duration) (bool, error)
+	RenewJob(ctx context.Context, name string, owner string, expire time.Duration) (bool, error)
+	UnlockJob(ctx context.Context, name string, owner string) error
+	AllowRate(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+	HitRate(ctx context.Context, key string, window time.Duration) (int64, error)
+	ResetRate(ctx context.Context, key string) error
+	RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute, key *chatdb.UserKey) error
 	GetAllUserID(ctx context.Context, pagination pagination.Pagination) (int64, []string, error)
 	GetAccount(ctx context.Context, userID string) (*chatdb.Account, error)
 	GetAttribute(ctx context.Context, userID string) (*chatdb.Attribute, error)
@@ -55,6 +74,43 @@ type ChatDatabaseInterface interface {
 	GetAttributeByPhone(ctx context.Context, areaCode string, phoneNumber string) (*chatdb.Attribute, error)
 	GetAttributeByEmail(ctx context.Context, email string) (*chatdb.Attribute, error)
 	LoginRecord(ctx context.Context, record *chatdb.UserLoginRecord) error
+	AddUserKey(ctx context.Context, key *chatdb.UserKey) error
+	TakeUserKeyByAddress(ctx context.Context, address string) (*chatdb.UserKey, error)
+	FindUserKeys(ctx context.Context, userID string) ([]*chatdb.UserKey, error)
+	RevokeUserKey(ctx context.Context, userID string, address string) error
+	SetRecoveryGuardian(ctx context.Context, guardian *chatdb.RecoveryGuardian) error
+	TakeRecoveryGuardian(ctx context.Context, userID string) (*chatdb.RecoveryGuardian, error)
+	CreateRecovery(ctx context.Context, request *chatdb.RecoveryRequest) error
+	TakeRecovery(ctx context.Context, recoveryID string) (*chatdb.RecoveryRequest, error)
+	ApproveRecovery(ctx context.Context, recoveryID string, approval *chatdb.RecoveryApproval) (*chatdb.RecoveryRequest, error)
+	CancelRecovery(ctx context.Context, recoveryID string) error
+	// RecoverUser executes a pending recovery: it makes key the only active key of the user and its primary key.
+	RecoverUser(ctx context.Context, recoveryID string, key *chatdb.UserKey) error
+	// CreateRedPacket records a packet, its callback and the pending settlement of a packet settled over HTTP in one transaction,
+	// ledger packets are paid from the sender balance.
+	CreateRedPacket(ctx context.Context, packet *chatdb.RedPacket, callback *chatdb.RedPacketCallback, settlement *chatdb.RedPacketSettlement) error
+	TakeRedPacket(ctx context.Context, redPacketID string) (*chatdb.RedPacket, error)
+	FindRedPacketClaims(ctx context.Context, redPacketID string) ([]*chatdb.RedPacketClaim, error)
+	// ClaimRedPacket takes one share of a packet for the callback user and records the callback with the claimed amount,
+	// ledger shares are credited to the user balance and HTTP shares get a pending settlement that is returned.
+	ClaimRedPacket(ctx context.Context, redPacketID string, callback *chatdb.RedPacketCallback) (*chatdb.RedPacketClaim, *chatdb.RedPacketSettlement, error)
+	TakeRedPacketCallback(ctx context.Context, clientMsgID string, operation string) (*chatdb.RedPacketCallback, error)
+	FindRedPacketCallbacks(ctx context.Context, clientMsgID string) ([]*chatdb.RedPacketCallback, error)
+	// RefundRedPacket returns the unclaimed shares of a packet to its sender, an HTTP packet gets a pending settlement that is returned.
+	RefundRedPacket(ctx context.Context, redPacketID string) (*chatdb.RedPacket, *chatdb.RedPacketSettlement, error)
+	TakeRedPacketSettlement(ctx context.Context, settlementID string) (*chatdb.RedPacketSettlement, error)
+	// CompleteRedPacketSettlement marks a pending settlement done, a settled send also makes its packet claimable.
+	CompleteRedPacketSettlement(ctx context.Context, settlement *chatdb.RedPacketSettlement) error
+	FailRedPacketSettlement(ctx context.Context, settlementID string, reason string) error
+	FindPendingRedPacketSettlements(ctx context.Context, before time.Time, maxAttempts int32, limit int64) ([]*chatdb.RedPacketSettlement, error)
+	FindExpiredRedPackets(ctx context.Context, now time.Time, limit int64) ([]*chatdb.RedPacket, error)
+	FindUnnotifiedRedPackets(ctx context.Context, limit int64) ([]*chatdb.RedPacket, error)
+	// SetRedPacketNotified claims the refund notification of a packet, it fails with not found when another sweep claimed it.
+	SetRedPacketNotified(ctx context.Context, redPacketID string) error
+	// ResetRedPacketNotified gives back a claimed notification that could not be sent.
+	ResetRedPacketNotified(ctx context.Context, redPacketID string) error
+	TakeRedPacketBalance(ctx context.Context, userID string) (int64, error)
+	AdjustRedPacketBalance(ctx context.Context, userID string, amount int64) error
 	UpdatePassword(ctx context.Context, userID string, password string) error
 	UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, codeID string) error
 	NewUserCountTotal(ctx context.Context, before *time.Time) (int64, error)
@@ -67,32 +123,74 @@ type ChatDatabaseInterface interface {
 
 	CreatePost(ctx context.Context, post []*chatdb.PostDB) error
 	UpdatePost(ctx context.Context, postID string, data map[string]any) error
+	UpdatePostVisibility(ctx context.Context, postID string, visibility int32, visibleUserIDs []string, commentVisibleUserIDs []string) error
 	DeletePost(ctx context.Context, postIDs []string) error
+	UnforwardPost(ctx context.Context, userID string, forwardPostID string) error
+	SetUserPostRelation(ctx context.Context, userID string, postID string, field string, value int32) error
+	AddPostView(ctx context.Context, postID string, userID string, dedupe time.Duration) error
+	FlushPostViews(ctx context.Context) (int, error)
+	FindUserCounters(ctx context.Context, userIDs []string) ([]*chatdb.UserCounter, error)
+	ReconcilePostCounters(ctx context.Context, afterPostID string, limit int64) (lastPostID string, repaired int, err error)
+	ReconcileUserCounters(ctx context.Context, userIDs []string) (repaired int, err error)
+	SoftDeletePost(ctx context.Context, postID string) error
+	RestorePost(ctx context.Context, postID string) error
+	PurgeDeletedPosts(ctx context.Context, before time.Time, limit int64) (int, error)
+	GetDeletedPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*chatdb.Post, string, error)
 	GetPostByID(ctx context.Context, postID string) (*chatdb.Post, error)
+	TakePostDB(ctx context.Context, postID string) (*chatdb.PostDB, error)
+	EditPost(ctx context.Context, revision *chatdb.PostRevision, data map[string]any, hashtags []string) error
+	FindPostRevisions(ctx context.Context, postID string) ([]*chatdb.PostRevision, error)
 	GetPostByForwardPostID(ctx context.Context, userID, forwardPostID string) (*chatdb.Post, error)
 
-	GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, userIDs []string, count int64) ([]*chatdb.Post, string, error)
-	GetPostsByCursorAndUser(ctx context.Context, cursor int64, userID string, count int64) ([]*chatdb.Post, string, error)
-	GetPostsByCursorAndPostIDs(ctx context.Context, cursor int64, postIDs []string, count int64) ([]*chatdb.Post, string, error)
-	GetCommentPostsByPostID(ctx context.Context, cursor int64, postID string, count int64) ([]*chatdb.Post, string, error)
+	GetPostsByCursorAndUserIDs(ctx context.Context, cursor string, userIDs []string, count int64) ([]*chatdb.Post, string, error)
+	GetPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*chatdb.Post, string, error)
+	GetPostsByCursorAndPostIDs(ctx context.Context, cursor string, postIDs []string, count int64) ([]*chatdb.Post, string, error)
+	GetCommentPostsByPostID(ctx context.Context, cursor string, postID string, sort int32, count int64) ([]*chatdb.Post, string, error)
+	FindCommentReplies(ctx context.Context, commentPostIDs []string, sort int32, count int64) (map[string][]*chatdb.Post, error)
 	GetCommentPostIDsByUser(ctx context.Context, userID string) ([]string, error)
 	GetFollowedUserIDs(ctx context.Context, userID string) ([]string, error)
+	IsFollowing(ctx context.Context, userID string, relatedUserID string) (bool, error)
+	FilterFollowedUserIDs(ctx context.Context, userID string, relatedUserIDs []string) ([]string, error)
+	FilterFollowerUserIDs(ctx context.Context, userID string, ownerUserIDs []string) ([]string, error)
 	GetSubscriberUserIDs(ctx context.Context, userID string) ([]string, error)
+	FanOutPost(ctx context.Context, post *chatdb.PostDB, opt TimelineOption) error
+	GetTimelinePosts(ctx context.Context, userID string, cursor string, count int64, opt TimelineOption) ([]*chatdb.Post, string, error)
+	GetPostsByCursorAndHashtag(ctx context.Context, cursor string, hashtag string, count int64) ([]*chatdb.Post, string, error)
+	SearchPosts(ctx context.Context, search *chatdb.PostSearch, pagination pagination.Pagination) (int64, []*chatdb.Post, error)
+	TakeTopic(ctx context.Context, name string) (*chatdb.Topic, error)
+	GetDiscoverPostsByCursor(ctx context.Context, cursor string, count int64) ([]*chatdb.Post, string, error)
+	TakeDiscoverDirty(ctx context.Context, limit int64) ([]*chatdb.PostDB, error)
+	SetDiscoverScores(ctx context.Context, scores map[string]float64) error
+	SetPostDiscover(ctx context.Context, postID string, pinned int32, boost float64) error
+	TakePostForModeration(ctx context.Context, postID string) (*chatdb.Post, error)
+	CreatePostReport(ctx context.Context, report *chatdb.PostReport) error
+	SearchPostReports(ctx context.Context, search *chatdb.PostReportSearch, pagination pagination.Pagination) (int64, []*chatdb.PostReport, error)
+	FindPostReports(ctx context.Context, postID string, limit int64) ([]*chatdb.PostReport, error)
+	ModeratePost(ctx context.Context, log *chatdb.PostModerationLog, data map[string]any, remove bool) error
+	FindPostModerationLogs(ctx context.Context, postID string) ([]*chatdb.PostModerationLog, error)
+	SearchPostModerationLogs(ctx context.Context, postID string, authorUserID string, operatorUserID string, pagination pagination.Pagination) (int64, []*chatdb.PostModerationLog, error)
 
 	GetUserPostRelation(ctx context.Context, userID, postID string) (*chatdb.UserPostRelation, error)
 	CreateUserPostRelation(ctx context.Context, relations []*chatdb.UserPostRelation) error
 	UpdateUserPostRelation(ctx context.Context, userID, postID string, data map[string]any) error
 	DeleteUserPostRelation(ctx context.Context, userID, postID string) error
 
+	AddNotification(ctx context.Context, notification *chatdb.Notification) (*chatdb.Notification, error)
+	FindNotifications(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*chatdb.Notification, error)
+	MarkNotificationsRead(ctx context.Context, userID string, notificationIDs []string) error
+	CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+
 	GetPostIDsByLike(ctx context.Context, userID string) ([]string, error)
 	GetPostIDsByCollect(ctx context.Context, userID string) ([]string, error)
 	GetPinnedPostByUserID(ctx context.Context, userID string) (*chatdb.Post, error)
 
 	GetVersionConfig(ctx context.Context) (*chatdb.AppVersionConfig, error)
 	GetFakeUserConfig(ctx context.Context) (*chatdb.AppFakeUserConfig, error)
+	GetDiscoverConfig(ctx context.Context) (*chatdb.DiscoverConfig, error)
+	SetDiscoverConfig(ctx context.Context, config *chatdb.DiscoverConfig, since time.Time) error
 }
 
-func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
+func NewChatDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (ChatDatabaseInterface, error) {
 	register, err := chat.NewRegister(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -127,7 +225,80 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 		return nil, err
 	}
 
+	userKey, err := chat.NewUserKey(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryGuardian, err := chat.NewRecoveryGuardian(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryRequest, err := chat.NewRecoveryRequest(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	redPacket, err := chat.NewRedPacket(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	redPacketClaim, err := chat.NewRedPacketClaim(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	redPacketBalance, err := chat.NewRedPacketBalance(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	redPacketCallback, err := chat.NewRedPacketCallback(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	redPacketSettlement, err := chat.NewRedPacketSettlement(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	userCounter, err := chat.NewUserCounter(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	postRevision, err := chat.NewPostRevision(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
 	userPostRelation, err := chat.NewUserPostRelation(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := chat.NewNotification(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	topic, err := chat.NewTopic(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	postReport, err := chat.NewPostReport(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	postModerationLog, err := chat.NewPostModerationLog(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 
 	appConfig, err := chat.NewAppConfig(cli.GetDB())
 	if err != nil {
@@ -139,34 +310,70 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 	}
 
 	return &ChatDatabase{
-		tx: cli.GetTx(),
-		//rdb:              rdb,
-		register:         register,
-		account:          account,
-		contact:          contact,
-		attribute:        attribute,
-		userLoginRecord:  userLoginRecord,
-		verifyCode:       verifyCode,
-		forbiddenAccount: forbiddenAccount,
-		post:             post,
-		userPostRelation: userPostRelation,
-		appConfig:        appConfig,
+		tx:                  cli.GetTx(),
+		nonce:               cache.NewNonceInterface(rdb),
+		rateLimit:           cache.NewRateLimitInterface(rdb),
+		lock:                cache.NewLockInterface(rdb),
+		timeline:            cache.NewTimelineInterface(rdb),
+		postView:            cache.NewPostViewInterface(rdb),
+		register:            register,
+		account:             account,
+		contact:             contact,
+		attribute:           attribute,
+		userLoginRecord:     userLoginRecord,
+		userKey:             userKey,
+		recoveryGuardian:    recoveryGuardian,
+		recoveryRequest:     recoveryRequest,
+		redPacket:           redPacket,
+		redPacketClaim:      redPacketClaim,
+		redPacketBalance:    redPacketBalance,
+		redPacketCallback:   redPacketCallback,
+		redPacketSettlement: redPacketSettlement,
+		verifyCode:          verifyCode,
+		forbiddenAccount:    forbiddenAccount,
+		post:                post,
+		postRevision:        postRevision,
+		userCounter:         userCounter,
+		userPostRelation:    userPostRelation,
+		notification:        notification,
+		topic:               topic,
+		postReport:          postReport,
+		postModerationLog:   postModerationLog,
+		appConfig:           appConfig,
 	}, nil
 }
 
 type ChatDatabase struct {
-	tx tx.Tx
-	//rdb              redis.UniversalClient
-	register         chatdb.RegisterInterface
-	contact          chatdb.ContactInterface
-	account          chatdb.AccountInterface
-	attribute        chatdb.AttributeInterface
-	userLoginRecord  chatdb.UserLoginRecordInterface
-	verifyCode       chatdb.VerifyCodeInterface
-	forbiddenAccount admin.ForbiddenAccountInterface
-	post             chatdb.PostInterface
-	userPostRelation chatdb.UserPostRelationInterface
-	appConfig        chatdb.AppConfigInterface
+	tx                  tx.Tx
+	nonce               cache.NonceInterface
+	rateLimit           cache.RateLimitInterface
+	lock                cache.LockInterface
+	timeline            cache.TimelineInterface
+	postView            cache.PostViewInterface
+	register            chatdb.RegisterInterface
+	contact             chatdb.ContactInterface
+	account             chatdb.AccountInterface
+	attribute           chatdb.AttributeInterface
+	userLoginRecord     chatdb.UserLoginRecordInterface
+	userKey             chatdb.UserKeyInterface
+	recoveryGuardian    chatdb.RecoveryGuardianInterface
+	recoveryRequest     chatdb.RecoveryRequestInterface
+	redPacket           chatdb.RedPacketInterface
+	redPacketClaim      chatdb.RedPacketClaimInterface
+	redPacketBalance    chatdb.RedPacketBalanceInterface
+	redPacketCallback   chatdb.RedPacketCallbackInterface
+	redPacketSettlement chatdb.RedPacketSettlementInterface
+	verifyCode          chatdb.VerifyCodeInterface
+	forbiddenAccount    admin.ForbiddenAccountInterface
+	post                chatdb.PostInterface
+	postRevision        chatdb.PostRevisionInterface
+	userCounter         chatdb.UserCounterInterface
+	userPostRelation    chatdb.UserPostRelationInterface
+	notification        chatdb.NotificationInterface
+	topic               chatdb.TopicInterface
+	postReport          chatdb.PostReportInterface
+	postModerationLog   chatdb.PostModerationLogInterface
+	appConfig           chatdb.AppConfigInterface
 }
 
 // DeleteGroupFromContact implements ChatDatabaseInterface.
@@ -235,6 +442,14 @@ func (o *ChatDatabase) Search(ctx context.Context, normalUser int32, keyword str
 	return total, totalUser, nil
 }
 
+func (o *ChatDatabase) FindForbiddenUserIDs(ctx context.Context, userIDs []string) ([]string, error) {
+	accounts, err := o.forbiddenAccount.Find(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Slice(accounts, func(e *admin.ForbiddenAccount) string { return e.UserID }), nil
+}
+
 func (o *ChatDatabase) SearchUser(ctx context.Context, keyword string, userIDs []string, genders []int32, pagination pagination.Pagination) (int64, []*chatdb.Attribute, error) {
 	return o.attribute.SearchUser(ctx, keyword, userIDs, genders, pagination)
 }
@@ -267,11 +482,43 @@ func (o *ChatDatabase) DelVerifyCode(ctx context.Context, id string) error {
 	return o.verifyCode.Delete(ctx, id)
 }
 
-//func (o *ChatDatabase) ChallengeNonce(ctx context.Context, publicKey string, nonce string) error {
-//	return o.rdb.HSet(ctx, publicKey, nonce, 5*time.Minute).Err()
-//}
+func (o *ChatDatabase) AddNonce(ctx context.Context, nonce string, owner string, message string, expire time.Duration) error {
+	return o.nonce.SetNonce(ctx, nonce, owner, message, expire)
+}
+
+func (o *ChatDatabase) GetNonceMessage(ctx context.Context, nonce string) (string, error) {
+	return o.nonce.GetNonceMessage(ctx, nonce)
+}
+
+func (o *ChatDatabase) UseNonce(ctx context.Context, nonce string, owners []string) (int, error) {
+	return o.nonce.UseNonce(ctx, nonce, owners)
+}
+
+func (o *ChatDatabase) LockJob(ctx context.Context, name string, owner string, expire time.Duration) (bool, error) {
+	return o.lock.Lock(ctx, name, owner, expire)
+}
+
+func (o *ChatDatabase) RenewJob(ctx context.Context, name string, owner string, expire time.Duration) (bool, error) {
+	return o.lock.Renew(ctx, name, owner, expire)
+}
+
+func (o *ChatDatabase) UnlockJob(ctx context.Context, name string, owner string) error {
+	return o.lock.Unlock(ctx, name, owner)
+}
+
+func (o *ChatDatabase) AllowRate(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	return o.rateLimit.Allow(ctx, key, limit, window)
+}
 
-func (o *ChatDatabase) RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute) error {
+func (o *ChatDatabase) HitRate(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return o.rateLimit.Hit(ctx, key, window)
+}
+
+func (o *ChatDatabase) ResetRate(ctx context.Context, key string) error {
+	return o.rateLimit.Reset(ctx, key)
+}
+
+func (o *ChatDatabase) RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute, key *chatdb.UserKey) error {
 	return o.tx.Transaction(ctx, func(ctx context.Context) error {
 		if err := o.register.Create(ctx, register); err != nil {
 			return err
@@ -282,6 +529,11 @@ func (o *ChatDatabase) RegisterUser(ctx context.Context, register *chatdb.Regist
 		if err := o.attribute.Create(ctx, attribute); err != nil {
 			return err
 		}
+		if key != nil {
+			if err := o.userKey.Save(ctx, key); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
@@ -319,10 +571,249 @@ func (o *ChatDatabase) LoginRecord(ctx context.Context, record *chatdb.UserLogin
 		if err := o.userLoginRecord.Create(ctx, record); err != nil {
 			return err
 		}
+		if record.Address != "" {
+			if err := o.userKey.UpdateLoginTime(ctx, record.Address, record.LoginTime); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (o *ChatDatabase) AddUserKey(ctx context.Context, key *chatdb.UserKey) error {
+	return o.userKey.Save(ctx, key)
+}
+
+func (o *ChatDatabase) TakeUserKeyByAddress(ctx context.Context, address string) (*chatdb.UserKey, error) {
+	return o.userKey.TakeByAddress(ctx, address)
+}
+
+func (o *ChatDatabase) FindUserKeys(ctx context.Context, userID string) ([]*chatdb.UserKey, error) {
+	return o.userKey.FindByUserID(ctx, userID)
+}
+
+func (o *ChatDatabase) RevokeUserKey(ctx context.Context, userID string, address string) error {
+	return o.userKey.Revoke(ctx, userID, address)
+}
+
+func (o *ChatDatabase) SetRecoveryGuardian(ctx context.Context, guardian *chatdb.RecoveryGuardian) error {
+	return o.recoveryGuardian.Set(ctx, guardian)
+}
+
+func (o *ChatDatabase) TakeRecoveryGuardian(ctx context.Context, userID string) (*chatdb.RecoveryGuardian, error) {
+	return o.recoveryGuardian.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) CreateRecovery(ctx context.Context, request *chatdb.RecoveryRequest) error {
+	return o.recoveryRequest.Create(ctx, request)
+}
+
+func (o *ChatDatabase) TakeRecovery(ctx context.Context, recoveryID string) (*chatdb.RecoveryRequest, error) {
+	return o.recoveryRequest.Take(ctx, recoveryID)
+}
+
+func (o *ChatDatabase) ApproveRecovery(ctx context.Context, recoveryID string, approval *chatdb.RecoveryApproval) (*chatdb.RecoveryRequest, error) {
+	return o.recoveryRequest.AddApproval(ctx, recoveryID, approval)
+}
+
+func (o *ChatDatabase) CancelRecovery(ctx context.Context, recoveryID string) error {
+	return o.recoveryRequest.UpdateStatus(ctx, recoveryID, constant.RecoveryCancelled, nil)
+}
+
+func (o *ChatDatabase) RecoverUser(ctx context.Context, recoveryID string, key *chatdb.UserKey) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.recoveryRequest.UpdateStatus(ctx, recoveryID, constant.RecoveryExecuted, map[string]any{"execute_time": key.CreateTime}); err != nil {
+			return err
+		}
+		if err := o.attribute.Update(ctx, key.UserID, map[string]any{"address": key.Address, "public_key": key.PublicKey, "change_time": key.CreateTime}); err != nil {
+			return err
+		}
+		if err := o.userKey.RevokeAll(ctx, key.UserID); err != nil {
+			return err
+		}
+		return o.userKey.Save(ctx, key)
+	})
+}
+
+func (o *ChatDatabase) CreateRedPacket(ctx context.Context, packet *chatdb.RedPacket, callback *chatdb.RedPacketCallback, settlement *chatdb.RedPacketSettlement) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if packet.Settlement == constant.RedPacketSettlementLedger {
+			if err := o.redPacketBalance.Decr(ctx, packet.SendUserID, packet.TotalAmount); err != nil {
+				return err
+			}
+		}
+		if err := o.redPacket.Create(ctx, packet); err != nil {
+			return err
+		}
+		if err := o.redPacketCallback.Create(ctx, callback); err != nil {
+			return err
+		}
+		if settlement != nil {
+			return o.redPacketSettlement.Create(ctx, settlement)
+		}
+		return nil
+	})
+}
+
+func (o *ChatDatabase) TakeRedPacket(ctx context.Context, redPacketID string) (*chatdb.RedPacket, error) {
+	return o.redPacket.Take(ctx, redPacketID)
+}
+
+func (o *ChatDatabase) FindRedPacketClaims(ctx context.Context, redPacketID string) ([]*chatdb.RedPacketClaim, error) {
+	return o.redPacketClaim.Find(ctx, redPacketID)
+}
+
+func (o *ChatDatabase) ClaimRedPacket(ctx context.Context, redPacketID string, callback *chatdb.RedPacketCallback) (*chatdb.RedPacketClaim, *chatdb.RedPacketSettlement, error) {
+	var (
+		claim      *chatdb.RedPacketClaim
+		settlement *chatdb.RedPacketSettlement
+	)
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		packet, err := o.redPacket.Claim(ctx, redPacketID, callback.UserID, now)
+		if err != nil {
+			return err
+		}
+		claim = &chatdb.RedPacketClaim{
+			RedPacketID: redPacketID,
+			UserID:      callback.UserID,
+			Amount:      packet.RemainAmounts[len(packet.RemainAmounts)-1],
+			ClaimTime:   now,
+		}
+		if err := o.redPacketClaim.Create(ctx, claim); err != nil {
+			return err
+		}
+		callback.Amount = claim.Amount
+		if err := o.redPacketCallback.Create(ctx, callback); err != nil {
+			return err
+		}
+		switch packet.Settlement {
+		case constant.RedPacketSettlementLedger:
+			return o.redPacketBalance.Incr(ctx, claim.UserID, claim.Amount)
+		case constant.RedPacketSettlementHTTP:
+			settlement = &chatdb.RedPacketSettlement{
+				SettlementID: redpacket.SettlementID(constant.RedPacketCallbackReceive, callback.ClientMsgID),
+				Operation:    constant.RedPacketCallbackReceive,
+				UserID:       claim.UserID,
+				RedPacketID:  redPacketID,
+				ClientMsgID:  callback.ClientMsgID,
+				Amount:       claim.Amount,
+				Status:       constant.RedPacketSettlementPending,
+				CreateTime:   now,
+				UpdateTime:   now,
+			}
+			return o.redPacketSettlement.Create(ctx, settlement)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return claim, settlement, nil
+}
+
+func (o *ChatDatabase) TakeRedPacketCallback(ctx context.Context, clientMsgID string, operation string) (*chatdb.RedPacketCallback, error) {
+	return o.redPacketCallback.Take(ctx, clientMsgID, operation)
+}
+
+func (o *ChatDatabase) FindRedPacketCallbacks(ctx context.Context, clientMsgID string) ([]*chatdb.RedPacketCallback, error) {
+	return o.redPacketCallback.Find(ctx, clientMsgID)
+}
+
+func (o *ChatDatabase) RefundRedPacket(ctx context.Context, redPacketID string) (*chatdb.RedPacket, *chatdb.RedPacketSettlement, error) {
+	var (
+		packet     *chatdb.RedPacket
+		settlement *chatdb.RedPacketSettlement
+	)
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		var err error
+		packet, err = o.redPacket.Refund(ctx, redPacketID, now)
+		if err != nil {
+			return err
+		}
+		switch packet.Settlement {
+		case constant.RedPacketSettlementLedger:
+			return o.redPacketBalance.Incr(ctx, packet.SendUserID, packet.RefundAmount)
+		case constant.RedPacketSettlementHTTP:
+			settlement = &chatdb.RedPacketSettlement{
+				SettlementID: redpacket.SettlementID(constant.RedPacketSettlementRefund, redPacketID),
+				Operation:    constant.RedPacketSettlementRefund,
+				UserID:       packet.SendUserID,
+				RedPacketID:  redPacketID,
+				Amount:       packet.RefundAmount,
+				Status:       constant.RedPacketSettlementPending,
+				CreateTime:   now,
+				UpdateTime:   now,
+			}
+			return o.redPacketSettlement.Create(ctx, settlement)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return packet, settlement, nil
+}
+
+func (o *ChatDatabase) TakeRedPacketSettlement(ctx context.Context, settlementID string) (*chatdb.RedPacketSettlement, error) {
+	return o.redPacketSettlement.Take(ctx, settlementID)
+}
+
+func (o *ChatDatabase) CompleteRedPacketSettlement(ctx context.Context, settlement *chatdb.RedPacketSettlement) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.redPacketSettlement.Complete(ctx, settlement.SettlementID, time.Now()); err != nil {
+			return err
+		}
+		if settlement.Operation == constant.RedPacketCallbackSend {
+			return o.redPacket.SetSettled(ctx, settlement.RedPacketID)
+		}
 		return nil
 	})
 }
 
+func (o *ChatDatabase) FailRedPacketSettlement(ctx context.Context, settlementID string, reason string) error {
+	return o.redPacketSettlement.Fail(ctx, settlementID, reason, time.Now())
+}
+
+func (o *ChatDatabase) FindPendingRedPacketSettlements(ctx context.Context, before time.Time, maxAttempts int32, limit int64) ([]*chatdb.RedPacketSettlement, error) {
+	return o.redPacketSettlement.FindPending(ctx, before, maxAttempts, limit)
+}
+
+func (o *ChatDatabase) FindExpiredRedPackets(ctx context.Context, now time.Time, limit int64) ([]*chatdb.RedPacket, error) {
+	return o.redPacket.FindExpired(ctx, now, limit)
+}
+
+func (o *ChatDatabase) FindUnnotifiedRedPackets(ctx context.Context, limit int64) ([]*chatdb.RedPacket, error) {
+	return o.redPacket.FindUnnotified(ctx, limit)
+}
+
+func (o *ChatDatabase) SetRedPacketNotified(ctx context.Context, redPacketID string) error {
+	return o.redPacket.SetNotified(ctx, redPacketID, true)
+}
+
+func (o *ChatDatabase) ResetRedPacketNotified(ctx context.Context, redPacketID string) error {
+	return o.redPacket.SetNotified(ctx, redPacketID, false)
+}
+
+func (o *ChatDatabase) TakeRedPacketBalance(ctx context.Context, userID string) (int64, error) {
+	balance, err := o.redPacketBalance.Take(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return balance.Balance, nil
+}
+
+func (o *ChatDatabase) AdjustRedPacketBalance(ctx context.Context, userID string, amount int64) error {
+	if amount < 0 {
+		return o.redPacketBalance.Decr(ctx, userID, -amount)
+	}
+	return o.redPacketBalance.Incr(ctx, userID, amount)
+}
+
 func (o *ChatDatabase) UpdatePassword(ctx context.Context, userID string, password string) error {
 	return o.account.UpdatePassword(ctx, userID, password)
 }
@@ -370,10 +861,129 @@ func (o *ChatDatabase) DeletePost(ctx context.Context, postIDs []string) error {
 	return o.post.Delete(ctx, postIDs)
 }
 
+// SoftDeletePost moves a post and its whole comment subtree to the trash, comments deleted earlier keep their own root.
+func (o *ChatDatabase) SoftDeletePost(ctx context.Context, postID string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		return o.softDeletePost(ctx, postID)
+	})
+}
+
+func (o *ChatDatabase) softDeletePost(ctx context.Context, postID string) error {
+	postIDs, err := o.postTreeIDs(ctx, postID)
+	if err != nil {
+		return err
+	}
+	post, err := o.post.TakeDB(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if err := o.post.SoftDelete(ctx, postIDs, postID, time.Now()); err != nil {
+		return err
+	}
+	return o.countPost(ctx, post, -1)
+}
+
+// removePost deletes the post with its comments for moderation, the author can not restore them and purging skips them.
+func (o *ChatDatabase) removePost(ctx context.Context, postID string) error {
+	post, err := o.post.TakeDB(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if post.Removed {
+		return nil
+	}
+	postIDs, err := o.postTreeIDs(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if err := o.post.Remove(ctx, postIDs, time.Now()); err != nil {
+		return err
+	}
+	// 作者已删除的帖子在删除时已扣减计数
+	if post.Deleted == constant.PostDeleted {
+		return nil
+	}
+	return o.countPost(ctx, post, -1)
+}
+
+// postTreeIDs returns postID and the IDs of its comments that are not deleted, at every depth.
+func (o *ChatDatabase) postTreeIDs(ctx context.Context, postID string) ([]string, error) {
+	postIDs := []string{postID}
+	for parentIDs := postIDs; len(parentIDs) > 0; {
+		commentIDs, err := o.post.FindCommentPostIDs(ctx, parentIDs)
+		if err != nil {
+			return nil, err
+		}
+		postIDs = append(postIDs, commentIDs...)
+		parentIDs = commentIDs
+	}
+	return postIDs, nil
+}
+
+func (o *ChatDatabase) RestorePost(ctx context.Context, postID string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		post, err := o.post.TakeDB(ctx, postID)
+		if err != nil {
+			return err
+		}
+		if err := o.post.Restore(ctx, postID); err != nil {
+			return err
+		}
+		return o.countPost(ctx, post, 1)
+	})
+}
+
+// PurgeDeletedPosts clears the posts deleted before before together with their revisions, relations and notifications.
+// The posts are kept as tombstones so that the posts forwarding, referencing or replying to them still show them as deleted.
+func (o *ChatDatabase) PurgeDeletedPosts(ctx context.Context, before time.Time, limit int64) (int, error) {
+	var n int
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		postIDs, err := o.post.FindDeletedPostIDs(ctx, before, limit)
+		if err != nil {
+			return err
+		}
+		if err := o.userPostRelation.DeleteByPostIDs(ctx, postIDs); err != nil {
+			return err
+		}
+		if err := o.postRevision.Delete(ctx, postIDs); err != nil {
+			return err
+		}
+		if err := o.notification.DeleteByPostIDs(ctx, postIDs); err != nil {
+			return err
+		}
+		n = len(postIDs)
+		return o.post.Purge(ctx, postIDs)
+	})
+	return n, err
+}
+
+func (o *ChatDatabase) GetDeletedPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*chatdb.Post, string, error) {
+	return o.post.GetDeletedPostsByCursorAndUser(ctx, cursor, userID, count)
+}
+
 func (o *ChatDatabase) GetFollowedUserIDs(ctx context.Context, userID string) ([]string, error) {
 	return o.post.GetFollowedUserIDs(ctx, userID)
 }
 
+// IsFollowing reports whether userID follows relatedUserID.
+func (o *ChatDatabase) IsFollowing(ctx context.Context, userID string, relatedUserID string) (bool, error) {
+	followedUserIDs, err := o.post.FilterFollowedUserIDs(ctx, userID, []string{relatedUserID})
+	if err != nil {
+		return false, err
+	}
+	return len(followedUserIDs) > 0, nil
+}
+
+// FilterFollowedUserIDs returns the users in relatedUserIDs that userID follows.
+func (o *ChatDatabase) FilterFollowedUserIDs(ctx context.Context, userID string, relatedUserIDs []string) ([]string, error) {
+	return o.post.FilterFollowedUserIDs(ctx, userID, relatedUserIDs)
+}
+
+// FilterFollowerUserIDs returns the users in ownerUserIDs that follow userID.
+func (o *ChatDatabase) FilterFollowerUserIDs(ctx context.Context, userID string, ownerUserIDs []string) ([]string, error) {
+	return o.post.FilterFollowerUserIDs(ctx, userID, ownerUserIDs)
+}
+
 func (o *ChatDatabase) GetSubscriberUserIDs(ctx context.Context, userID string) ([]string, error) {
 	return o.post.GetSubscriberUserIDs(ctx, userID)
 }
@@ -382,30 +992,533 @@ func (o *ChatDatabase) GetPostByForwardPostID(ctx context.Context, userID, forwa
 	return o.post.GetPostByForwardPostID(ctx, userID, forwardPostID)
 }
 
-func (o *ChatDatabase) GetCommentPostsByPostID(ctx context.Context, cursor int64, postID string, count int64) ([]*chatdb.Post, string, error) {
-	return o.post.GetCommentPostsByPostID(ctx, cursor, postID, count)
+// GetCommentPostsByPostID pages the comments replying to postID, the comment pinned by the author leads the first page.
+func (o *ChatDatabase) GetCommentPostsByPostID(ctx context.Context, cursor string, postID string, sort int32, count int64) ([]*chatdb.Post, string, error) {
+	post, err := o.post.TakeDB(ctx, postID)
+	if err != nil {
+		return nil, "", err
+	}
+	comments, nextCursor, err := o.post.GetCommentPostsByPostID(ctx, cursor, postID, sort, post.PinnedCommentID, count)
+	if err != nil {
+		return nil, "", err
+	}
+	if cursor != "" || post.PinnedCommentID == "" {
+		return comments, nextCursor, nil
+	}
+	pinned, err := o.post.Take(ctx, post.PinnedCommentID)
+	if err != nil {
+		// 置顶的评论已删除或不可见
+		if dbutil.IsDBNotFound(err) {
+			return comments, nextCursor, nil
+		}
+		return nil, "", err
+	}
+	pinned.IsPinned = constant.Pinned
+	return append([]*chatdb.Post{pinned}, comments...), nextCursor, nil
+}
+
+// FindCommentReplies returns up to count replies of each comment in sort order.
+func (o *ChatDatabase) FindCommentReplies(ctx context.Context, commentPostIDs []string, sort int32, count int64) (map[string][]*chatdb.Post, error) {
+	replyPostIDs, err := o.post.FindReplyPostIDs(ctx, commentPostIDs, sort, count)
+	if err != nil {
+		return nil, err
+	}
+	var postIDs []string
+	for _, ids := range replyPostIDs {
+		postIDs = append(postIDs, ids...)
+	}
+	replies := make(map[string][]*chatdb.Post, len(replyPostIDs))
+	if len(postIDs) == 0 {
+		return replies, nil
+	}
+	posts, _, err := o.post.GetPostsByCursorAndPostIDs(ctx, "", postIDs, int64(len(postIDs)))
+	if err != nil {
+		return nil, err
+	}
+	postMap := datautil.SliceToMap(posts, func(e *chatdb.Post) string { return e.PostID })
+	for commentPostID, ids := range replyPostIDs {
+		for _, id := range ids {
+			if post, ok := postMap[id]; ok {
+				replies[commentPostID] = append(replies[commentPostID], post)
+			}
+		}
+	}
+	return replies, nil
 }
 
-func (o *ChatDatabase) GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, userIDs []string, count int64) ([]*chatdb.Post, string, error) {
+func (o *ChatDatabase) GetPostsByCursorAndUserIDs(ctx context.Context, cursor string, userIDs []string, count int64) ([]*chatdb.Post, string, error) {
 	return o.post.GetPostsByCursorAndUserIDs(ctx, cursor, userIDs, count)
 }
 
-func (o *ChatDatabase) GetPostsByCursorAndUser(ctx context.Context, cursor int64, userID string, count int64) ([]*chatdb.Post, string, error) {
+func (o *ChatDatabase) GetPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*chatdb.Post, string, error) {
 	return o.post.GetPostsByCursorAndUser(ctx, cursor, userID, count)
 }
 
+// TimelineOption controls the follow timelines kept in redis.
+type TimelineOption struct {
+	// Length is the number of posts kept in each timeline.
+	Length int64
+	Expire time.Duration
+	// FanOutLimit is the follower count above which an author's posts are not pushed but merged in on read.
+	FanOutLimit int64
+}
+
+// FanOutPost pushes a post that is not a comment to the timelines of its author and followers,
+// only the author's timeline gets it when the author has more followers than the fan out limit.
+func (o *ChatDatabase) FanOutPost(ctx context.Context, post *chatdb.PostDB, opt TimelineOption) error {
+	if post.CommentPostID != "" {
+		return nil
+	}
+	userIDs := []string{post.UserID}
+	counters, err := o.userCounter.Find(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if len(counters) == 0 || counters[0].FollowerCount <= opt.FanOutLimit {
+		followerUserIDs, err := o.post.GetFollowerUserIDs(ctx, post.UserID)
+		if err != nil {
+			return err
+		}
+		userIDs = append(userIDs, followerUserIDs...)
+	}
+	item := cache.TimelineItem{PostID: post.PostID, CreateTime: post.CreateTime.UnixMilli()}
+	return o.timeline.Push(ctx, userIDs, item, opt.Length)
+}
+
+// GetTimelinePosts reads the follow feed of userID from its timeline, building the timeline on a miss
+// or when the followed users changed since it was built, and merges in the posts of followed authors above the fan out limit.
+func (o *ChatDatabase) GetTimelinePosts(ctx context.Context, userID string, cursor string, count int64, opt TimelineOption) ([]*chatdb.Post, string, error) {
+	after, err := dbutil.DecodePostCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	// 关注关系由 OpenIM 写入, 没有变更通知, 读取时比对时间线构建时的关注列表
+	followedUserIDs, err := o.post.GetFollowedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	pulledUserIDs, err := o.userCounter.FindUserIDsByFollowerCount(ctx, opt.FanOutLimit)
+	if err != nil {
+		return nil, "", err
+	}
+	follows, err := o.timeline.Follows(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if digest := followsDigest(followedUserIDs); follows != digest {
+		if err := o.buildTimeline(ctx, userID, digest, followedUserIDs, pulledUserIDs, opt); err != nil {
+			return nil, "", err
+		}
+	}
+	followed := datautil.SliceSet(followedUserIDs)
+	pulledUserIDs = datautil.Filter(pulledUserIDs, func(e string) (string, bool) {
+		_, ok := followed[e]
+		return e, ok
+	})
+	var afterItem *cache.TimelineItem
+	if after != nil {
+		afterItem = &cache.TimelineItem{PostID: after.PostID, CreateTime: after.CreateTime}
+	}
+	items, err := o.timeline.Range(ctx, userID, afterItem, count)
+	if err != nil {
+		return nil, "", err
+	}
+	// 每个来源只取一页, 取满一页的来源在最后一条之后可能还有帖子, 只返回各来源都已读到的部分
+	var floor *cache.TimelineItem
+	if len(items) > 0 && int64(len(items)) == count {
+		floor = &items[len(items)-1]
+	}
+	var posts []*chatdb.Post
+	if len(items) > 0 {
+		postIDs := datautil.Slice(items, func(e cache.TimelineItem) string { return e.PostID })
+		posts, _, err = o.post.GetPostsByCursorAndPostIDs(ctx, cursor, postIDs, count)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if len(pulledUserIDs) > 0 {
+		pulled, _, err := o.post.GetPostsByCursorAndUserIDs(ctx, cursor, pulledUserIDs, count)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(pulled) > 0 && int64(len(pulled)) == count {
+			last := timelineItem(pulled[len(pulled)-1])
+			if floor == nil || timelineBefore(*floor, last) {
+				floor = &last
+			}
+		}
+		posts = append(posts, pulled...)
+	}
+	posts = mergeTimelinePosts(posts, floor, count)
+	switch {
+	case len(posts) > 0:
+		last := posts[len(posts)-1]
+		return posts, dbutil.EncodePostCursor(dbutil.PostCursor{CreateTime: last.CreateTime.UnixMilli(), PostID: last.PostID}), nil
+	case floor != nil:
+		// 这一页的帖子都已删除或不可见, 从读到的位置继续
+		return posts, dbutil.EncodePostCursor(dbutil.PostCursor{CreateTime: floor.CreateTime, PostID: floor.PostID}), nil
+	default:
+		return posts, "", nil
+	}
+}
+
+// buildTimeline fills the timeline of userID with the latest posts of the user and the followed authors
+// that are pushed on publish, the authors in pulledUserIDs are merged in on read instead.
+func (o *ChatDatabase) buildTimeline(ctx context.Context, userID string, follows string, followedUserIDs []string, pulledUserIDs []string, opt TimelineOption) error {
+	pulled := datautil.SliceSet(pulledUserIDs)
+	userIDs := []string{userID}
+	for _, followedUserID := range followedUserIDs {
+		if _, ok := pulled[followedUserID]; !ok {
+			userIDs = append(userIDs, followedUserID)
+		}
+	}
+	posts, err := o.post.FindTimeline(ctx, userIDs, opt.Length)
+	if err != nil {
+		return err
+	}
+	items := datautil.Slice(posts, func(e *chatdb.PostDB) cache.TimelineItem {
+		return cache.TimelineItem{PostID: e.PostID, CreateTime: e.CreateTime.UnixMilli()}
+	})
+	return o.timeline.Build(ctx, userID, follows, items, opt.Expire)
+}
+
+// followsDigest identifies a set of followed users regardless of their order.
+func followsDigest(userIDs []string) string {
+	userIDs = append([]string(nil), userIDs...)
+	sort.Strings(userIDs)
+	sum := md5.Sum([]byte(strings.Join(userIDs, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+func timelineItem(post *chatdb.Post) cache.TimelineItem {
+	return cache.TimelineItem{PostID: post.PostID, CreateTime: post.CreateTime.UnixMilli()}
+}
+
+// timelineBefore reports whether a comes before b in a timeline, newest first with ties broken by post ID.
+func timelineBefore(a, b cache.TimelineItem) bool {
+	if a.CreateTime != b.CreateTime {
+		return a.CreateTime > b.CreateTime
+	}
+	return a.PostID > b.PostID
+}
+
+// mergeTimelinePosts orders posts as a timeline without duplicates and keeps up to count posts not after floor.
+func mergeTimelinePosts(posts []*chatdb.Post, floor *cache.TimelineItem, count int64) []*chatdb.Post {
+	sort.Slice(posts, func(i, j int) bool {
+		return timelineBefore(timelineItem(posts[i]), timelineItem(posts[j]))
+	})
+	seen := make(map[string]struct{}, len(posts))
+	res := make([]*chatdb.Post, 0, len(posts))
+	for _, post := range posts {
+		if int64(len(res)) == count || (floor != nil && timelineBefore(*floor, timelineItem(post))) {
+			break
+		}
+		if _, ok := seen[post.PostID]; ok {
+			continue
+		}
+		seen[post.PostID] = struct{}{}
+		res = append(res, post)
+	}
+	return res
+}
+
 func (o *ChatDatabase) GetPostByID(ctx context.Context, postID string) (*chatdb.Post, error) {
 	return o.post.Take(ctx, postID)
 }
 
+func (o *ChatDatabase) TakePostDB(ctx context.Context, postID string) (*chatdb.PostDB, error) {
+	return o.post.TakeDB(ctx, postID)
+}
+
+// EditPost keeps the replaced content as a revision and applies the edit in one transaction,
+// the unique revision version rejects a concurrent edit of the same post.
+// hashtags replaces the hashtags of the post and moves the topic counts of a top level post.
+func (o *ChatDatabase) EditPost(ctx context.Context, revision *chatdb.PostRevision, data map[string]any, hashtags []string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		post, err := o.post.TakeDB(ctx, revision.PostID)
+		if err != nil {
+			return err
+		}
+		if err := o.postRevision.Create(ctx, []*chatdb.PostRevision{revision}); err != nil {
+			return err
+		}
+		data["hashtags"] = hashtags
+		if err := o.post.UpdateByMap(ctx, revision.PostID, data); err != nil {
+			return err
+		}
+		if post.CommentPostID != "" {
+			return nil
+		}
+		removed := datautil.Filter(post.Hashtags, func(tag string) (string, bool) { return tag, !datautil.Contain(tag, hashtags...) })
+		if err := o.topic.Incr(ctx, removed, -1); err != nil {
+			return err
+		}
+		added := datautil.Filter(hashtags, func(tag string) (string, bool) { return tag, !datautil.Contain(tag, post.Hashtags...) })
+		return o.topic.Incr(ctx, added, 1)
+	})
+}
+
+func (o *ChatDatabase) FindPostRevisions(ctx context.Context, postID string) ([]*chatdb.PostRevision, error) {
+	return o.postRevision.Find(ctx, postID)
+}
+
+// CreatePost creates posts and counts them on their author, and on the post they comment or forward.
 func (o *ChatDatabase) CreatePost(ctx context.Context, posts []*chatdb.PostDB) error {
-	return o.post.Create(ctx, posts)
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.post.Create(ctx, posts); err != nil {
+			return err
+		}
+		for _, post := range posts {
+			if err := o.countPost(ctx, post, 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UnforwardPost removes the forward of forwardPostID made by userID.
+func (o *ChatDatabase) UnforwardPost(ctx context.Context, userID string, forwardPostID string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		post, err := o.post.GetPostByForwardPostID(ctx, userID, forwardPostID)
+		if err != nil {
+			return err
+		}
+		if err := o.post.Delete(ctx, []string{post.PostID}); err != nil {
+			return err
+		}
+		if post.Deleted == constant.PostDeleted {
+			// already uncounted when it was moved to the trash
+			return nil
+		}
+		if err := o.userCounter.Incr(ctx, userID, chatdb.UserPostCount, -1); err != nil {
+			return err
+		}
+		return o.setUserPostRelation(ctx, userID, forwardPostID, "is_forwarded", constant.NotForwarded)
+	})
+}
+
+// countPost adds delta to the counters a post contributes to: the comment count of the post it comments,
+// or the post count of its author, the post count of its topics and the forward count of the post it forwards.
+func (o *ChatDatabase) countPost(ctx context.Context, post *chatdb.PostDB, delta int64) error {
+	if post.CommentPostID != "" {
+		if err := o.post.IncrCount(ctx, post.CommentPostID, chatdb.PostCommentCount, delta); err != nil {
+			return err
+		}
+		if delta > 0 {
+			return o.setUserPostRelation(ctx, post.UserID, post.CommentPostID, "is_commented", constant.Commented)
+		}
+		return nil
+	}
+	if err := o.userCounter.Incr(ctx, post.UserID, chatdb.UserPostCount, delta); err != nil {
+		return err
+	}
+	if err := o.topic.Incr(ctx, post.Hashtags, delta); err != nil {
+		return err
+	}
+	if post.ForwardPostID != "" {
+		forwarded := int32(constant.NotForwarded)
+		if delta > 0 {
+			forwarded = constant.Forwarded
+		}
+		return o.setUserPostRelation(ctx, post.UserID, post.ForwardPostID, "is_forwarded", forwarded)
+	}
+	return nil
+}
+
+// postRelationCounters maps the user post relation flags to the post counter they are counted in.
+var postRelationCounters = map[string]string{
+	"is_liked":     chatdb.PostLikeCount,
+	"is_collected": chatdb.PostCollectCount,
+	"is_forwarded": chatdb.PostForwardCount,
+}
+
+// SetUserPostRelation sets a relation flag and moves the post counter by the change.
+func (o *ChatDatabase) SetUserPostRelation(ctx context.Context, userID string, postID string, field string, value int32) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		return o.setUserPostRelation(ctx, userID, postID, field, value)
+	})
+}
+
+func (o *ChatDatabase) setUserPostRelation(ctx context.Context, userID string, postID string, field string, value int32) error {
+	var old int32
+	relation, err := o.userPostRelation.Take(ctx, userID, postID)
+	switch {
+	case err == nil:
+		switch field {
+		case "is_liked":
+			old = relation.IsLiked
+		case "is_collected":
+			old = relation.IsCollected
+		case "is_forwarded":
+			old = relation.IsForwarded
+		case "is_commented":
+			old = relation.IsCommented
+		}
+		if old == value {
+			return nil
+		}
+		if err := o.userPostRelation.UpdateByMap(ctx, userID, postID, map[string]any{field: value}); err != nil {
+			return err
+		}
+	case dbutil.IsDBNotFound(err):
+		if value == 0 {
+			return nil
+		}
+		relation = &chatdb.UserPostRelation{UserID: userID, PostID: postID}
+		switch field {
+		case "is_liked":
+			relation.IsLiked = value
+		case "is_collected":
+			relation.IsCollected = value
+		case "is_forwarded":
+			relation.IsForwarded = value
+		case "is_commented":
+			relation.IsCommented = value
+		}
+		if err := o.userPostRelation.Create(ctx, []*chatdb.UserPostRelation{relation}); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+	counter, ok := postRelationCounters[field]
+	if !ok {
+		return nil
+	}
+	return o.post.IncrCount(ctx, postID, counter, int64(value-old))
+}
+
+// AddPostView counts a view of the post in redis, a user's views of a post are counted once per dedupe or every time when it is 0.
+func (o *ChatDatabase) AddPostView(ctx context.Context, postID string, userID string, dedupe time.Duration) error {
+	_, err := o.postView.AddView(ctx, postID, userID, dedupe)
+	return err
+}
+
+// FlushPostViews adds the views counted in redis to the posts and returns the number of posts updated.
+// A post leaves the flushing hash only once its views are written, so a failed flush is picked up by the next one.
+func (o *ChatDatabase) FlushPostViews(ctx context.Context) (int, error) {
+	views, err := o.postView.TakeViews(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var flushed int
+	for postID, n := range views {
+		if err := o.post.IncrCount(ctx, postID, chatdb.PostViewCount, n); err != nil {
+			return flushed, err
+		}
+		if err := o.postView.DoneViews(ctx, postID); err != nil {
+			return flushed, err
+		}
+		flushed++
+	}
+	return flushed, nil
+}
+
+func (o *ChatDatabase) FindUserCounters(ctx context.Context, userIDs []string) ([]*chatdb.UserCounter, error) {
+	return o.userCounter.Find(ctx, userIDs)
+}
+
+// ReconcilePostCounters recounts the posts after afterPostID from their relations and comments, and overwrites
+// the counters that drifted. It returns the last post checked, empty once every post has been checked.
+func (o *ChatDatabase) ReconcilePostCounters(ctx context.Context, afterPostID string, limit int64) (string, int, error) {
+	posts, err := o.post.FindAfter(ctx, afterPostID, limit)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(posts) == 0 {
+		return "", 0, nil
+	}
+	postIDs := datautil.Slice(posts, func(e *chatdb.PostDB) string { return e.PostID })
+	counts := make(map[string]map[string]int64)
+	for field, counter := range postRelationCounters {
+		if counts[counter], err = o.userPostRelation.CountByPostIDs(ctx, postIDs, field); err != nil {
+			return "", 0, err
+		}
+	}
+	if counts[chatdb.PostCommentCount], err = o.post.CountComments(ctx, postIDs); err != nil {
+		return "", 0, err
+	}
+	var repaired int
+	for _, post := range posts {
+		current := map[string]int64{
+			chatdb.PostLikeCount:    post.LikeCount,
+			chatdb.PostCommentCount: post.CommentCount,
+			chatdb.PostForwardCount: post.ForwardCount,
+			chatdb.PostCollectCount: post.CollectCount,
+		}
+		drift := make(map[string]int64)
+		for counter, value := range current {
+			if actual := counts[counter][post.PostID]; actual != value {
+				drift[counter] = actual
+			}
+		}
+		if len(drift) == 0 {
+			continue
+		}
+		if err := o.post.SetCounts(ctx, post.PostID, drift); err != nil {
+			return "", 0, err
+		}
+		repaired++
+	}
+	return posts[len(posts)-1].PostID, repaired, nil
+}
+
+// ReconcileUserCounters recounts the posts, followers and followings of users and overwrites the counters that drifted.
+func (o *ChatDatabase) ReconcileUserCounters(ctx context.Context, userIDs []string) (int, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	counters, err := o.userCounter.Find(ctx, userIDs)
+	if err != nil {
+		return 0, err
+	}
+	postCounts, err := o.post.CountUserPosts(ctx, userIDs)
+	if err != nil {
+		return 0, err
+	}
+	followers, following, err := o.userCounter.CountFollows(ctx, userIDs)
+	if err != nil {
+		return 0, err
+	}
+	current := datautil.SliceToMap(counters, func(e *chatdb.UserCounter) string { return e.UserID })
+	var repaired int
+	for _, userID := range userIDs {
+		actual := &chatdb.UserCounter{
+			UserID:         userID,
+			PostCount:      postCounts[userID],
+			FollowerCount:  followers[userID],
+			FollowingCount: following[userID],
+		}
+		if counter, ok := current[userID]; ok {
+			if counter.PostCount == actual.PostCount && counter.FollowerCount == actual.FollowerCount && counter.FollowingCount == actual.FollowingCount {
+				continue
+			}
+		} else if actual.PostCount == 0 && actual.FollowerCount == 0 && actual.FollowingCount == 0 {
+			continue
+		}
+		if err := o.userCounter.Set(ctx, actual); err != nil {
+			return 0, err
+		}
+		repaired++
+	}
+	return repaired, nil
 }
 
 func (o *ChatDatabase) UpdatePost(ctx context.Context, postID string, data map[string]any) error {
 	return o.post.UpdateByMap(ctx, postID, data)
 }
 
+// UpdatePostVisibility changes who can see a post together with the audience of its comments.
+func (o *ChatDatabase) UpdatePostVisibility(ctx context.Context, postID string, visibility int32, visibleUserIDs []string, commentVisibleUserIDs []string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.post.UpdateByMap(ctx, postID, map[string]any{"visibility": visibility, "visible_user_ids": visibleUserIDs}); err != nil {
+			return err
+		}
+		return o.post.UpdateCommentVisibility(ctx, postID, visibility, commentVisibleUserIDs)
+	})
+}
+
 func (o *ChatDatabase) GetCommentPostIDsByUser(ctx context.Context, userID string) ([]string, error) {
 	return o.post.GetCommentPostIDsByUser(ctx, userID)
 }
@@ -438,7 +1551,7 @@ func (o *ChatDatabase) GetPinnedPostByUserID(ctx context.Context, userID string)
 	return o.post.GetPinnedPostByUserID(ctx, userID)
 }
 
-func (o *ChatDatabase) GetPostsByCursorAndPostIDs(ctx context.Context, cursor int64, postIDs []string, count int64) ([]*chatdb.Post, string, error) {
+func (o *ChatDatabase) GetPostsByCursorAndPostIDs(ctx context.Context, cursor string, postIDs []string, count int64) ([]*chatdb.Post, string, error) {
 	return o.post.GetPostsByCursorAndPostIDs(ctx, cursor, postIDs, count)
 }
 
@@ -449,3 +1562,140 @@ func (o *ChatDatabase) GetVersionConfig(ctx context.Context) (*chatdb.AppVersion
 func (o *ChatDatabase) GetFakeUserConfig(ctx context.Context) (*chatdb.AppFakeUserConfig, error) {
 	return o.appConfig.GetFakeUserConfig(ctx)
 }
+
+// defaultDiscoverConfig is used until an admin sets the discover config.
+var defaultDiscoverConfig = chatdb.DiscoverConfig{
+	LikeWeight:    1,
+	CommentWeight: 3,
+	ForwardWeight: 5,
+	ViewWeight:    0.1,
+	DecayHours:    12,
+}
+
+func (o *ChatDatabase) GetDiscoverConfig(ctx context.Context) (*chatdb.DiscoverConfig, error) {
+	config, err := o.appConfig.GetDiscoverConfig(ctx)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			res := defaultDiscoverConfig
+			return &res, nil
+		}
+		return nil, err
+	}
+	return &config.Config, nil
+}
+
+// SetDiscoverConfig saves the config and marks the posts created since since to be scored again with it.
+func (o *ChatDatabase) SetDiscoverConfig(ctx context.Context, config *chatdb.DiscoverConfig, since time.Time) error {
+	if err := o.appConfig.SetDiscoverConfig(ctx, config); err != nil {
+		return err
+	}
+	return o.post.MarkDiscoverDirty(ctx, since)
+}
+
+func (o *ChatDatabase) AddNotification(ctx context.Context, notification *chatdb.Notification) (*chatdb.Notification, error) {
+	return o.notification.Add(ctx, notification)
+}
+
+func (o *ChatDatabase) FindNotifications(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*chatdb.Notification, error) {
+	return o.notification.FindPage(ctx, userID, pagination)
+}
+
+func (o *ChatDatabase) MarkNotificationsRead(ctx context.Context, userID string, notificationIDs []string) error {
+	return o.notification.MarkRead(ctx, userID, notificationIDs)
+}
+
+func (o *ChatDatabase) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	return o.notification.CountUnread(ctx, userID)
+}
+
+func (o *ChatDatabase) GetPostsByCursorAndHashtag(ctx context.Context, cursor string, hashtag string, count int64) ([]*chatdb.Post, string, error) {
+	return o.post.GetPostsByCursorAndHashtag(ctx, cursor, hashtag, count)
+}
+
+func (o *ChatDatabase) SearchPosts(ctx context.Context, search *chatdb.PostSearch, pagination pagination.Pagination) (int64, []*chatdb.Post, error) {
+	return o.post.Search(ctx, search, pagination)
+}
+
+func (o *ChatDatabase) TakeTopic(ctx context.Context, name string) (*chatdb.Topic, error) {
+	return o.topic.Take(ctx, name)
+}
+
+func (o *ChatDatabase) GetDiscoverPostsByCursor(ctx context.Context, cursor string, count int64) ([]*chatdb.Post, string, error) {
+	return o.post.GetDiscoverPostsByCursor(ctx, cursor, count)
+}
+
+// TakeDiscoverDirty returns up to limit posts to score and clears their mark before their counters are read,
+// a counter that changes after the read marks the post again so it is scored in the next run.
+func (o *ChatDatabase) TakeDiscoverDirty(ctx context.Context, limit int64) ([]*chatdb.PostDB, error) {
+	posts, err := o.post.FindDiscoverDirty(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	postIDs := datautil.Slice(posts, func(e *chatdb.PostDB) string { return e.PostID })
+	if err := o.post.ClearDiscoverDirty(ctx, postIDs); err != nil {
+		return nil, err
+	}
+	return o.post.FindDB(ctx, postIDs)
+}
+
+func (o *ChatDatabase) SetDiscoverScores(ctx context.Context, scores map[string]float64) error {
+	for postID, score := range scores {
+		if err := o.post.SetDiscoverScore(ctx, postID, score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *ChatDatabase) SetPostDiscover(ctx context.Context, postID string, pinned int32, boost float64) error {
+	return o.post.SetDiscover(ctx, postID, pinned, boost)
+}
+
+func (o *ChatDatabase) TakePostForModeration(ctx context.Context, postID string) (*chatdb.Post, error) {
+	return o.post.TakeForModeration(ctx, postID)
+}
+
+func (o *ChatDatabase) CreatePostReport(ctx context.Context, report *chatdb.PostReport) error {
+	return o.postReport.Create(ctx, report)
+}
+
+func (o *ChatDatabase) SearchPostReports(ctx context.Context, search *chatdb.PostReportSearch, pagination pagination.Pagination) (int64, []*chatdb.PostReport, error) {
+	return o.postReport.Search(ctx, search, pagination)
+}
+
+func (o *ChatDatabase) FindPostReports(ctx context.Context, postID string, limit int64) ([]*chatdb.PostReport, error) {
+	return o.postReport.FindByPostID(ctx, postID, limit)
+}
+
+// ModeratePost applies data to the post, moving it to the trash first when remove is set and it is not deleted yet,
+// then closes its pending reports and records the decision in log with the number of reports closed.
+func (o *ChatDatabase) ModeratePost(ctx context.Context, log *chatdb.PostModerationLog, data map[string]any, remove bool) error {
+	reportStatus := int32(constant.PostReportResolved)
+	if log.Action == constant.PostModerationDismiss {
+		reportStatus = constant.PostReportDismissed
+	}
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if remove {
+			if err := o.removePost(ctx, log.PostID); err != nil {
+				return err
+			}
+		}
+		if err := o.post.UpdateByMap(ctx, log.PostID, data); err != nil {
+			return err
+		}
+		n, err := o.postReport.Handle(ctx, log.PostID, reportStatus, log.Action, log.OperatorUserID, log.CreateTime)
+		if err != nil {
+			return err
+		}
+		log.ReportCount = n
+		return o.postModerationLog.Create(ctx, log)
+	})
+}
+
+func (o *ChatDatabase) FindPostModerationLogs(ctx context.Context, postID string) ([]*chatdb.PostModerationLog, error) {
+	return o.postModerationLog.FindByPostID(ctx, postID)
+}
+
+func (o *ChatDatabase) SearchPostModerationLogs(ctx context.Context, postID string, authorUserID string, operatorUserID string, pagination pagination.Pagination) (int64, []*chatdb.PostModerationLog, error) {
+	return o.postModerationLog.FindPage(ctx, postID, authorUserID, operatorUserID, pagination)
+}
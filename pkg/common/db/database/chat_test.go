@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func TestMergeTimelinePosts(t *testing.T) {
+	post := func(postID string, ms int64) *chatdb.Post {
+		return &chatdb.Post{PostID: postID, CreateTime: time.UnixMilli(ms)}
+	}
+	tests := []struct {
+		name  string
+		posts []*chatdb.Post
+		floor *cache.TimelineItem
+		count int64
+		want  []string
+	}{
+		{
+			name:  "newest first",
+			posts: []*chatdb.Post{post("a", 1), post("c", 3), post("b", 2)},
+			count: 10,
+			want:  []string{"c", "b", "a"},
+		},
+		{
+			name:  "same millisecond by post id",
+			posts: []*chatdb.Post{post("a", 2), post("c", 2), post("b", 2), post("d", 1)},
+			count: 10,
+			want:  []string{"c", "b", "a", "d"},
+		},
+		{
+			name:  "pushed and pulled duplicates",
+			posts: []*chatdb.Post{post("b", 2), post("a", 1), post("b", 2), post("c", 3)},
+			count: 10,
+			want:  []string{"c", "b", "a"},
+		},
+		{
+			name:  "count",
+			posts: []*chatdb.Post{post("a", 1), post("b", 2), post("c", 3)},
+			count: 2,
+			want:  []string{"c", "b"},
+		},
+		{
+			name:  "stops at floor",
+			posts: []*chatdb.Post{post("a", 1), post("b", 2), post("c", 3)},
+			floor: &cache.TimelineItem{PostID: "b", CreateTime: 2},
+			count: 10,
+			want:  []string{"c", "b"},
+		},
+		{
+			name:  "floor in the same millisecond",
+			posts: []*chatdb.Post{post("a", 2), post("b", 2), post("c", 2)},
+			floor: &cache.TimelineItem{PostID: "b", CreateTime: 2},
+			count: 10,
+			want:  []string{"c", "b"},
+		},
+		{
+			name:  "empty",
+			count: 10,
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := mergeTimelinePosts(tt.posts, tt.floor, tt.count)
+			got := make([]string, 0, len(res))
+			for _, post := range res {
+				got = append(got, post.PostID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("mergeTimelinePosts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFollowsDigest(t *testing.T) {
+	if followsDigest([]string{"a", "b"}) != followsDigest([]string{"b", "a"}) {
+		t.Fatal("followsDigest() depends on the order of the user ids")
+	}
+	if followsDigest([]string{"a", "b"}) == followsDigest([]string{"a"}) {
+		t.Fatal("followsDigest() does not change with a follow")
+	}
+	if followsDigest(nil) == "" {
+		t.Fatal("followsDigest() of no follows is empty, it would read as a timeline that is not built")
+	}
+	userIDs := []string{"b", "a"}
+	followsDigest(userIDs)
+	if userIDs[0] != "b" {
+		t.Fatal("followsDigest() sorted the caller's slice")
+	}
+}
@@ -16,9 +16,8 @@ package dbutil
 
 import (
 	"context"
-	"fmt"
-	"reflect"
-	"strconv"
+	"encoding/base64"
+	"encoding/json"
 	"time"
 
 	"github.com/openimsdk/tools/errs"
@@ -30,35 +29,169 @@ func IsDBNotFound(err error) bool {
 	return errs.Unwrap(err) == mongo.ErrNoDocuments
 }
 
-func FindPageWithCursor[T any](ctx context.Context, coll *mongo.Collection, cursor int64, cursorField string, sortDirection int32, limit int64, filter bson.M, sort bson.D, pipeline mongo.Pipeline) ([]T, string, error) {
+func IsDBDuplicateKey(err error) bool {
+	return mongo.IsDuplicateKeyError(errs.Unwrap(err))
+}
 
-	var _pipeline []bson.D
+// PostCursor is the position of the last post of a page, clients get it as an opaque string.
+type PostCursor struct {
+	Pinned     int32   `json:"p,omitempty"`
+	LikeCount  int64   `json:"l,omitempty"`
+	Score      float64 `json:"s,omitempty"`
+	CreateTime int64   `json:"t"`
+	PostID     string  `json:"i"`
+}
 
-	// 添加过滤条件
-	if filter != nil {
-		_pipeline = append(_pipeline, bson.D{{Key: "$match", Value: filter}})
+func EncodePostCursor(cursor PostCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePostCursor returns nil for an empty cursor, which starts from the first page.
+func DecodePostCursor(cursor string) (*PostCursor, error) {
+	if cursor == "" {
+		return nil, nil
 	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	var res PostCursor
+	if err := json.Unmarshal(data, &res); err != nil || res.PostID == "" {
+		return nil, errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	return &res, nil
+}
 
-	// 添加游标条件
-	if cursor != 0 {
-		var comparisonOperator string
-		if sortDirection >= 0 {
-			comparisonOperator = "$gt"
-		} else {
-			comparisonOperator = "$lt"
-		}
-		cursorTime := time.Unix(0, cursor*int64(time.Millisecond))
-		_pipeline = append(_pipeline, bson.D{{Key: "$match", Value: bson.M{cursorField: bson.M{comparisonOperator: cursorTime}}}})
+// PostOrder is the order of a post page, post_id orders the posts created in the same millisecond.
+type PostOrder int
+
+const (
+	PostNewest PostOrder = iota
+	// PostPinnedNewest puts the post pinned by the user first.
+	PostPinnedNewest
+	PostOldest
+	// PostMostLiked orders by likes, then newest first.
+	PostMostLiked
+	// PostDiscover puts the posts pinned by admins first, then orders by discover score.
+	PostDiscover
+)
+
+// 排序使用的计算字段, 未置顶与缺少 is_pined 的帖子同为 0, 缺少 like_count 的帖子为 0, 发现页的置顶与分数同理
+const (
+	pinnedOrder         = "pinned_order"
+	likeOrder           = "like_order"
+	discoverPinnedOrder = "discover_pinned_order"
+	discoverScoreOrder  = "discover_score_order"
+)
+
+type sortKey struct {
+	field     string
+	direction int
+	value     any
+}
+
+// postSortKeys 返回 order 的排序字段, 值为 cursor 所在的位置
+func postSortKeys(order PostOrder, cursor *PostCursor) []sortKey {
+	var pos PostCursor
+	if cursor != nil {
+		pos = *cursor
 	}
+	createTime := time.UnixMilli(pos.CreateTime)
+	if order == PostOldest {
+		return []sortKey{{"create_time", 1, createTime}, {"post_id", 1, pos.PostID}}
+	}
+	keys := []sortKey{{"create_time", -1, createTime}, {"post_id", -1, pos.PostID}}
+	switch order {
+	case PostPinnedNewest:
+		keys = append([]sortKey{{pinnedOrder, -1, pos.Pinned}}, keys...)
+	case PostMostLiked:
+		keys = append([]sortKey{{likeOrder, -1, pos.LikeCount}}, keys...)
+	case PostDiscover:
+		keys = append([]sortKey{{discoverPinnedOrder, -1, pos.Pinned}, {discoverScoreOrder, -1, pos.Score}}, keys...)
+	}
+	return keys
+}
 
-	_pipeline = append(_pipeline, pipeline...)
+// SortPosts returns the stages that sort posts in order.
+func SortPosts(order PostOrder) mongo.Pipeline {
+	var pipeline mongo.Pipeline
+	if stage := postOrderFields(order); stage != nil {
+		pipeline = append(pipeline, stage)
+	}
+	return append(pipeline, bson.D{{Key: "$sort", Value: postSort(postSortKeys(order, nil))}})
+}
 
+func postOrderFields(order PostOrder) bson.D {
+	switch order {
+	case PostPinnedNewest:
+		return bson.D{{Key: "$addFields", Value: bson.M{
+			pinnedOrder: bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$is_pined", 1}}, 1, 0}},
+		}}}
+	case PostMostLiked:
+		return bson.D{{Key: "$addFields", Value: bson.M{
+			likeOrder: bson.M{"$ifNull": bson.A{"$like_count", 0}},
+		}}}
+	case PostDiscover:
+		return bson.D{{Key: "$addFields", Value: bson.M{
+			discoverPinnedOrder: bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$discover_pinned", 1}}, 1, 0}},
+			discoverScoreOrder:  bson.M{"$ifNull": bson.A{"$discover_score", 0.0}},
+		}}}
+	default:
+		return nil
+	}
+}
+
+func postSort(keys []sortKey) bson.D {
+	sort := make(bson.D, 0, len(keys))
+	for _, key := range keys {
+		sort = append(sort, bson.E{Key: key.field, Value: key.direction})
+	}
+	return sort
+}
+
+// afterPostFilter 匹配排在 keys 所在位置之后的帖子
+func afterPostFilter(keys []sortKey) bson.M {
+	or := make([]bson.M, 0, len(keys))
+	for i, key := range keys {
+		cond := bson.M{}
+		for _, prev := range keys[:i] {
+			cond[prev.field] = prev.value
+		}
+		op := "$lt"
+		if key.direction > 0 {
+			op = "$gt"
+		}
+		cond[key.field] = bson.M{op: key.value}
+		or = append(or, cond)
+	}
+	return bson.M{"$or": or}
+}
+
+// FindPostPage returns up to limit posts after cursor in order.
+// pipeline runs on the page and must keep every document.
+func FindPostPage[T any](ctx context.Context, coll *mongo.Collection, cursor string, order PostOrder, limit int64, filter bson.M, pipeline mongo.Pipeline, key func(T) PostCursor) ([]T, string, error) {
+	after, err := DecodePostCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	var _pipeline mongo.Pipeline
+	if filter != nil {
+		_pipeline = append(_pipeline, bson.D{{Key: "$match", Value: filter}})
+	}
+	if stage := postOrderFields(order); stage != nil {
+		_pipeline = append(_pipeline, stage)
+	}
+	keys := postSortKeys(order, after)
+	if after != nil {
+		_pipeline = append(_pipeline, bson.D{{Key: "$match", Value: afterPostFilter(keys)}})
+	}
 	_pipeline = append(_pipeline,
-		bson.D{{Key: "$sort", Value: sort}},
+		bson.D{{Key: "$sort", Value: postSort(keys)}},
 		bson.D{{Key: "$limit", Value: limit}},
 	)
+	_pipeline = append(_pipeline, pipeline...)
 
-	// 执行聚合查询
 	cur, err := coll.Aggregate(ctx, _pipeline)
 	if err != nil {
 		return nil, "", errs.WrapMsg(err, "mongo failed to execute aggregation")
@@ -69,63 +202,9 @@ func FindPageWithCursor[T any](ctx context.Context, coll *mongo.Collection, curs
 	if err := cur.All(ctx, &results); err != nil {
 		return nil, "", errs.WrapMsg(err, "mongo failed to decode aggregation results")
 	}
-
-	// 获取下一个游标
 	var nextCursor string
 	if len(results) > 0 {
-		lastResult := results[len(results)-1]
-		nextCursor, err = getFieldValue(lastResult, cursorField)
-		if err != nil {
-			return nil, "", errs.WrapMsg(err, "failed to get next cursor")
-		}
+		nextCursor = EncodePostCursor(key(results[len(results)-1]))
 	}
-
 	return results, nextCursor, nil
 }
-func getFieldValue(v interface{}, field string) (string, error) {
-	r := reflect.ValueOf(v)
-	if r.Kind() == reflect.Ptr {
-		r = r.Elem()
-	}
-	if r.Kind() != reflect.Struct {
-		return "", fmt.Errorf("v must be a struct or pointer to struct")
-	}
-
-	f := r.FieldByName(field)
-	if !f.IsValid() {
-		return "", fmt.Errorf("no such field: %s in obj", field)
-	}
-
-	switch f.Kind() {
-	case reflect.String:
-		return f.String(), nil
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return strconv.FormatInt(f.Int(), 10), nil
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return strconv.FormatUint(f.Uint(), 10), nil
-	case reflect.Float32, reflect.Float64:
-		return strconv.FormatFloat(f.Float(), 'f', -1, 64), nil
-	case reflect.Bool:
-		return strconv.FormatBool(f.Bool()), nil
-	case reflect.Struct:
-		// Handle common MongoDB types
-		if f.Type().String() == "primitive.ObjectID" {
-			// Use String() method if available
-			if strMethod := f.MethodByName("String"); strMethod.IsValid() {
-				return strMethod.Call(nil)[0].String(), nil
-			}
-		}
-		// Handle time.Time
-		if f.Type().String() == "time.Time" {
-			t := f.Interface().(time.Time)
-			if t.IsZero() {
-				return "0", nil
-			}
-			return strconv.FormatInt(t.UnixMilli(), 10), nil
-		}
-		// Convert the struct to a string representation
-		return fmt.Sprintf("%v", f.Interface()), nil
-	default:
-		return "", fmt.Errorf("unsupported field type: %v", f.Kind())
-	}
-}
@@ -0,0 +1,255 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbutil
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestPostCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor PostCursor
+	}{
+		{name: "newest", cursor: PostCursor{CreateTime: 1700000000123, PostID: "p1"}},
+		{name: "pinned", cursor: PostCursor{Pinned: 1, CreateTime: 1700000000123, PostID: "p2"}},
+		{name: "most liked", cursor: PostCursor{LikeCount: 42, CreateTime: 1700000000123, PostID: "p3"}},
+		{name: "discover", cursor: PostCursor{Pinned: 1, Score: 3.25, CreateTime: 1700000000123, PostID: "p4"}},
+		{name: "zero create time", cursor: PostCursor{PostID: "p5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodePostCursor(EncodePostCursor(tt.cursor))
+			if err != nil {
+				t.Fatalf("DecodePostCursor() error = %v", err)
+			}
+			if got == nil || *got != tt.cursor {
+				t.Fatalf("DecodePostCursor() = %+v, want %+v", got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodePostCursor(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name    string
+		in      string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "empty starts from the first page", in: "", wantNil: true},
+		{name: "not base64", in: "!!!", wantErr: true},
+		{name: "padded base64", in: base64.URLEncoding.EncodeToString([]byte(`{"t":1,"i":"p1"}`)), wantErr: true},
+		{name: "not json", in: encode("p1"), wantErr: true},
+		{name: "missing post id", in: encode(`{"t":1}`), wantErr: true},
+		{name: "wrong field type", in: encode(`{"t":"1","i":"p1"}`), wantErr: true},
+		{name: "valid", in: encode(`{"t":1,"i":"p1"}`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodePostCursor(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodePostCursor(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && (got == nil) != tt.wantNil {
+				t.Fatalf("DecodePostCursor(%q) = %+v, wantNil %v", tt.in, got, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestAfterPostFilter(t *testing.T) {
+	ms := time.UnixMilli(1700000000123)
+	tests := []struct {
+		name   string
+		order  PostOrder
+		cursor PostCursor
+		want   bson.M
+	}{
+		{
+			name:   "newest",
+			order:  PostNewest,
+			cursor: PostCursor{CreateTime: ms.UnixMilli(), PostID: "p1"},
+			want: bson.M{"$or": []bson.M{
+				{"create_time": bson.M{"$lt": ms}},
+				{"create_time": ms, "post_id": bson.M{"$lt": "p1"}},
+			}},
+		},
+		{
+			name:   "pinned",
+			order:  PostPinnedNewest,
+			cursor: PostCursor{Pinned: 1, CreateTime: ms.UnixMilli(), PostID: "p1"},
+			want: bson.M{"$or": []bson.M{
+				{pinnedOrder: bson.M{"$lt": int32(1)}},
+				{pinnedOrder: int32(1), "create_time": bson.M{"$lt": ms}},
+				{pinnedOrder: int32(1), "create_time": ms, "post_id": bson.M{"$lt": "p1"}},
+			}},
+		},
+		{
+			name:   "oldest",
+			order:  PostOldest,
+			cursor: PostCursor{CreateTime: ms.UnixMilli(), PostID: "p1"},
+			want: bson.M{"$or": []bson.M{
+				{"create_time": bson.M{"$gt": ms}},
+				{"create_time": ms, "post_id": bson.M{"$gt": "p1"}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := afterPostFilter(postSortKeys(tt.order, &tt.cursor))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("afterPostFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAfterPostFilterPages checks that paging from the cursor of each post matches exactly the posts sorted after it,
+// including the posts created in the same millisecond.
+func TestAfterPostFilterPages(t *testing.T) {
+	t1, t2 := time.UnixMilli(1700000000001), time.UnixMilli(1700000000002)
+	tests := []struct {
+		name  string
+		order PostOrder
+		// posts in the expected order
+		posts []bson.M
+	}{
+		{
+			name:  "newest",
+			order: PostNewest,
+			posts: []bson.M{
+				{"create_time": t2, "post_id": "c"},
+				{"create_time": t2, "post_id": "b"},
+				{"create_time": t1, "post_id": "d"},
+				{"create_time": t1, "post_id": "a"},
+			},
+		},
+		{
+			name:  "pinned",
+			order: PostPinnedNewest,
+			posts: []bson.M{
+				{pinnedOrder: int32(1), "create_time": t1, "post_id": "b"},
+				{pinnedOrder: int32(1), "create_time": t1, "post_id": "a"},
+				{pinnedOrder: int32(0), "create_time": t2, "post_id": "d"},
+				{pinnedOrder: int32(0), "create_time": t2, "post_id": "c"},
+				{pinnedOrder: int32(0), "create_time": t1, "post_id": "e"},
+			},
+		},
+		{
+			name:  "oldest",
+			order: PostOldest,
+			posts: []bson.M{
+				{"create_time": t1, "post_id": "a"},
+				{"create_time": t1, "post_id": "d"},
+				{"create_time": t2, "post_id": "b"},
+				{"create_time": t2, "post_id": "c"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, post := range tt.posts {
+				cursor := PostCursor{CreateTime: post["create_time"].(time.Time).UnixMilli(), PostID: post["post_id"].(string)}
+				if pinned, ok := post[pinnedOrder]; ok {
+					cursor.Pinned = pinned.(int32)
+				}
+				filter := afterPostFilter(postSortKeys(tt.order, &cursor))
+				for j, other := range tt.posts {
+					if got, want := matchFilter(t, filter, other), j > i; got != want {
+						t.Errorf("after %v: match %v = %v, want %v", post["post_id"], other["post_id"], got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// matchFilter evaluates the subset of the mongo query language used by afterPostFilter.
+func matchFilter(t *testing.T, filter bson.M, doc bson.M) bool {
+	t.Helper()
+	for field, cond := range filter {
+		if field == "$or" {
+			matched := false
+			for _, sub := range cond.([]bson.M) {
+				if matchFilter(t, sub, doc) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+		op, ok := cond.(bson.M)
+		if !ok {
+			if compareValue(t, doc[field], cond) != 0 {
+				return false
+			}
+			continue
+		}
+		for name, value := range op {
+			c := compareValue(t, doc[field], value)
+			switch name {
+			case "$lt":
+				if c >= 0 {
+					return false
+				}
+			case "$gt":
+				if c <= 0 {
+					return false
+				}
+			default:
+				t.Fatalf("unsupported operator %s", name)
+			}
+		}
+	}
+	return true
+}
+
+func compareValue(t *testing.T, a, b any) int {
+	t.Helper()
+	switch a := a.(type) {
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case int32:
+		b := b.(int32)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case string:
+		b := b.(string)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}
+	t.Fatalf("unsupported value %T", a)
+	return 0
+}
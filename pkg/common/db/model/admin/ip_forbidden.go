@@ -87,6 +87,11 @@ func (o *IPForbidden) Create(ctx context.Context, ms []*admindb.IPForbidden) err
 	return mongoutil.InsertMany(ctx, o.coll, ms)
 }
 
+func (o *IPForbidden) Upsert(ctx context.Context, m *admindb.IPForbidden) error {
+	_, err := o.coll.ReplaceOne(ctx, bson.M{"ip": m.IP}, m, options.Replace().SetUpsert(true))
+	return errs.Wrap(err)
+}
+
 func (o *IPForbidden) Delete(ctx context.Context, ips []string) error {
 	if len(ips) == 0 {
 		return nil
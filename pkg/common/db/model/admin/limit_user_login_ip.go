@@ -52,12 +52,17 @@ func (o *LimitUserLoginIP) Create(ctx context.Context, ms []*admin.LimitUserLogi
 	return mongoutil.InsertMany(ctx, o.coll, ms)
 }
 
+func (o *LimitUserLoginIP) Upsert(ctx context.Context, m *admin.LimitUserLoginIP) error {
+	_, err := o.coll.ReplaceOne(ctx, bson.M{"user_id": m.UserID, "ip": m.IP}, m, options.Replace().SetUpsert(true))
+	return errs.Wrap(err)
+}
+
 func (o *LimitUserLoginIP) Delete(ctx context.Context, ms []*admin.LimitUserLoginIP) error {
 	return mongoutil.DeleteMany(ctx, o.coll, o.limitUserLoginIPFilter(ms))
 }
 
 func (o *LimitUserLoginIP) Count(ctx context.Context, userID string) (uint32, error) {
-	count, err := mongoutil.Count(ctx, o.coll, bson.M{"user_id": userID})
+	count, err := mongoutil.Count(ctx, o.coll, bson.M{"user_id": userID, "locked": bson.M{"$ne": true}})
 	if err != nil {
 		return 0, err
 	}
@@ -27,6 +27,9 @@ import (
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 )
 
+// addressCollation compares addresses case-insensitively, legacy attributes store them either checksummed or in lower case.
+var addressCollation = &options.Collation{Locale: "en", Strength: 2}
+
 func NewAttribute(db *mongo.Database) (chat.AttributeInterface, error) {
 	coll := db.Collection("attribute")
 	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
@@ -46,6 +49,12 @@ func NewAttribute(db *mongo.Database) (chat.AttributeInterface, error) {
 				{Key: "email", Value: 1},
 			},
 		},
+		{
+			Keys: bson.D{
+				{Key: "address", Value: 1},
+			},
+			Options: options.Index().SetCollation(addressCollation),
+		},
 		{
 			Keys: bson.D{
 				{Key: "area_code", Value: 1},
@@ -116,8 +125,9 @@ func (o *Attribute) TakeAccount(ctx context.Context, account string) (*chat.Attr
 	return mongoutil.FindOne[*chat.Attribute](ctx, o.coll, bson.M{"account": account})
 }
 
+// TakeAddress matches the address case-insensitively with the collation of the address index, so it stays an index lookup.
 func (o *Attribute) TakeAddress(ctx context.Context, address string) (*chat.Attribute, error) {
-	return mongoutil.FindOne[*chat.Attribute](ctx, o.coll, bson.M{"address": address})
+	return mongoutil.FindOne[*chat.Attribute](ctx, o.coll, bson.M{"address": address}, options.FindOne().SetCollation(addressCollation))
 }
 
 func (o *Attribute) Take(ctx context.Context, userID string) (*chat.Attribute, error) {
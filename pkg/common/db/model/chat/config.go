@@ -7,6 +7,7 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func NewAppConfig(db *mongo.Database) (chat.AppConfigInterface, error) {
@@ -27,3 +28,13 @@ func (o *AppConfig) GetFakeUserConfig(ctx context.Context) (*chat.AppFakeUserCon
 	filter := bson.D{{Key: "name", Value: "app_fake_user"}}
 	return mongoutil.FindOne[*chat.AppFakeUserConfig](ctx, o.coll, filter)
 }
+
+func (o *AppConfig) GetDiscoverConfig(ctx context.Context) (*chat.AppDiscoverConfig, error) {
+	filter := bson.D{{Key: "name", Value: "post_discover"}}
+	return mongoutil.FindOne[*chat.AppDiscoverConfig](ctx, o.coll, filter)
+}
+
+func (o *AppConfig) SetDiscoverConfig(ctx context.Context, config *chat.DiscoverConfig) error {
+	filter := bson.D{{Key: "name", Value: "post_discover"}}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": bson.M{"config": config}}, false, options.Update().SetUpsert(true))
+}
@@ -0,0 +1,103 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type Notification struct {
+	coll *mongo.Collection
+}
+
+func NewNotification(db *mongo.Database) (chat.NotificationInterface, error) {
+	coll := db.Collection("notifications")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "notification_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// 每个帖子的同类通知只有一条未读
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "post_id", Value: 1},
+				{Key: "type", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"is_read": constant.NotificationUnread}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "update_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Notification{coll: coll}, nil
+}
+
+func (o *Notification) Add(ctx context.Context, notification *chat.Notification) (*chat.Notification, error) {
+	filter := bson.M{
+		"user_id": notification.UserID,
+		"post_id": notification.PostID,
+		"type":    notification.Type,
+		"is_read": constant.NotificationUnread,
+	}
+	actors := bson.M{"$ifNull": bson.A{"$actor_user_ids", bson.A{}}}
+	var actorUserIDs, actorCount any = actors, bson.M{"$ifNull": bson.A{"$actor_count", 0}}
+	// 新的操作用户放在最前, 已在列表中的用户不重复计数
+	for _, userID := range notification.ActorUserIDs {
+		actorCount = bson.M{"$add": bson.A{actorCount, bson.M{"$cond": bson.A{bson.M{"$in": bson.A{userID, actors}}, 0, 1}}}}
+		actorUserIDs = bson.M{"$concatArrays": bson.A{
+			bson.A{userID},
+			bson.M{"$filter": bson.M{"input": actorUserIDs, "cond": bson.M{"$ne": bson.A{"$$this", userID}}}},
+		}}
+	}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"notification_id": bson.M{"$ifNull": bson.A{"$notification_id", notification.NotificationID}},
+		"source_post_id":  notification.SourcePostID,
+		"actor_user_ids":  bson.M{"$slice": bson.A{actorUserIDs, constant.MaxNotificationActors}},
+		"actor_count":     actorCount,
+		"create_time":     bson.M{"$ifNull": bson.A{"$create_time", notification.CreateTime}},
+		"update_time":     notification.UpdateTime,
+	}}}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*chat.Notification](ctx, o.coll, filter, update, opts)
+}
+
+func (o *Notification) FindPage(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*chat.Notification, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "update_time", Value: -1}})
+	return mongoutil.FindPage[*chat.Notification](ctx, o.coll, bson.M{"user_id": userID}, pagination, opts)
+}
+
+func (o *Notification) MarkRead(ctx context.Context, userID string, notificationIDs []string) error {
+	filter := bson.M{"user_id": userID, "is_read": constant.NotificationUnread}
+	if len(notificationIDs) > 0 {
+		filter["notification_id"] = bson.M{"$in": notificationIDs}
+	}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, bson.M{"$set": bson.M{"is_read": constant.NotificationRead}})
+	return err
+}
+
+func (o *Notification) CountUnread(ctx context.Context, userID string) (int64, error) {
+	return mongoutil.Count(ctx, o.coll, bson.M{"user_id": userID, "is_read": constant.NotificationUnread})
+}
+
+func (o *Notification) DeleteByPostIDs(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}})
+}
@@ -3,6 +3,8 @@ package chat
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
@@ -10,19 +12,67 @@ import (
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func NewPost(db *mongo.Database) (chat.PostInterface, error) {
 	coll := db.Collection("post")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "post_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "post_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+				{Key: "post_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "comment_post_id", Value: 1},
+				{Key: "create_time", Value: -1},
+				{Key: "post_id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "hashtags", Value: 1},
+				{Key: "create_time", Value: -1},
+				{Key: "post_id", Value: -1},
+			},
+		},
+		{
+			// 不按语言做词干处理, 内容按空白与标点分词, 含中日韩文字的关键词不使用该索引
+			Keys: bson.D{
+				{Key: "content", Value: "text"},
+				{Key: "hashtags", Value: "text"},
+			},
+			Options: options.Index().SetDefaultLanguage("none"),
+		},
+		{
+			// 回收站清理查找未清除的已删除帖子
+			Keys: bson.D{
+				{Key: "deleted", Value: 1},
+				{Key: "purged", Value: 1},
+				{Key: "delete_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "discover_dirty", Value: 1},
+			},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"discover_dirty": true}),
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -34,14 +84,13 @@ type Post struct {
 	coll *mongo.Collection
 }
 
-func (g *Post) sortByCreateTime() bson.D {
-	return bson.D{{Key: "create_time", Value: -1}}
-}
-
-func (o *Post) sortByPinedAndCreateTime() bson.D {
-	return bson.D{
-		{Key: "is_pined", Value: -1},
-		{Key: "create_time", Value: -1},
+// postCursor 帖子在分页中的位置
+func postCursor(post *chat.Post) dbutil.PostCursor {
+	return dbutil.PostCursor{
+		Pinned:     post.IsPinned,
+		LikeCount:  post.LikeCount,
+		CreateTime: post.CreateTime.UnixMilli(),
+		PostID:     post.PostID,
 	}
 }
 
@@ -53,6 +102,7 @@ func (o *Post) Create(ctx context.Context, posts []*chat.PostDB) error {
 		if post.UpdateTime.IsZero() {
 			posts[i].UpdateTime = time.Now()
 		}
+		posts[i].DiscoverDirty = true
 	}
 	return mongoutil.InsertMany(ctx, o.coll, posts)
 }
@@ -63,9 +113,97 @@ func (o *Post) Delete(ctx context.Context, postIDs []string) error {
 	}
 	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}})
 }
+
+func (o *Post) FindCommentPostIDs(ctx context.Context, postIDs []string) ([]string, error) {
+	if len(postIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"comment_post_id": bson.M{"$in": postIDs}, "deleted": bson.M{"$ne": constant.PostDeleted}}
+	return mongoutil.Find[string](ctx, o.coll, filter, options.Find().SetProjection(bson.M{"post_id": 1, "_id": 0}))
+}
+
+func (o *Post) SoftDelete(ctx context.Context, postIDs []string, rootID string, deleteTime time.Time) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"post_id": bson.M{"$in": postIDs}, "deleted": bson.M{"$ne": constant.PostDeleted}}
+	update := bson.M{"$set": bson.M{
+		"deleted":        constant.PostDeleted,
+		"delete_time":    deleteTime,
+		"delete_root_id": rootID,
+		"is_pined":       constant.UnPinned,
+		"update_time":    deleteTime,
+	}}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	return err
+}
+
+func (o *Post) Restore(ctx context.Context, rootID string) error {
+	filter := bson.M{"delete_root_id": rootID, "deleted": constant.PostDeleted, "removed": bson.M{"$ne": true}}
+	update := bson.M{
+		"$set":   bson.M{"deleted": constant.PostNotDeleted, "update_time": time.Now()},
+		"$unset": bson.M{"delete_time": "", "delete_root_id": ""},
+	}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	return err
+}
+
+func (o *Post) Remove(ctx context.Context, postIDs []string, removeTime time.Time) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	if err := o.SoftDelete(ctx, postIDs, "", removeTime); err != nil {
+		return err
+	}
+	filter := bson.M{"post_id": bson.M{"$in": postIDs}}
+	update := bson.M{
+		"$set":   bson.M{"removed": true, "update_time": removeTime},
+		"$unset": bson.M{"delete_root_id": ""},
+	}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	return err
+}
+
+// Purge 清除已删除帖子的内容, 保留ID、作者与删除标记, 转发与引用它的帖子仍显示为已删除
+func (o *Post) Purge(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"post_id": bson.M{"$in": postIDs}, "deleted": constant.PostDeleted}
+	update := mongo.Pipeline{{{Key: "$replaceWith", Value: bson.M{
+		"_id":         "$_id",
+		"post_id":     "$post_id",
+		"user_id":     "$user_id",
+		"deleted":     "$deleted",
+		"delete_time": "$delete_time",
+		"create_time": "$create_time",
+		"purged":      true,
+	}}}}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	return err
+}
+
+func (o *Post) FindDeletedPostIDs(ctx context.Context, before time.Time, limit int64) ([]string, error) {
+	filter := bson.M{"deleted": constant.PostDeleted, "delete_time": bson.M{"$lt": before}, "purged": bson.M{"$ne": true}, "removed": bson.M{"$ne": true}}
+	return mongoutil.Find[string](ctx, o.coll, filter, options.Find().SetProjection(bson.M{"post_id": 1, "_id": 0}).SetLimit(limit))
+}
+
+func (o *Post) GetDeletedPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*chat.Post, string, error) {
+	// 只返回用户删除的帖子, 随之删除的评论在恢复时一并恢复
+	filter := bson.M{
+		"user_id": userID,
+		"deleted": constant.PostDeleted,
+		"$expr":   bson.M{"$eq": bson.A{"$delete_root_id", "$post_id"}},
+	}
+	return dbutil.FindPostPage(ctx, o.coll, cursor, dbutil.PostNewest, count, filter, GetAggregationPipeline(ctx, nil), postCursor)
+}
 func (o *Post) Take(ctx context.Context, postID string) (*chat.Post, error) {
-	filter := bson.M{"post_id": postID}
-	results, err := mongoutil.Aggregate[*chat.Post](ctx, o.coll, GetAggregationPipeline(ctx, filter))
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := liveFilter(bson.M{"post_id": postID}, visible)
+	results, err := mongoutil.Aggregate[*chat.Post](ctx, o.coll, GetAggregationPipeline(ctx, visible, filter))
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +215,28 @@ func (o *Post) Take(ctx context.Context, postID string) (*chat.Post, error) {
 	return results[0], nil
 }
 
+func (o *Post) TakeForModeration(ctx context.Context, postID string) (*chat.Post, error) {
+	results, err := mongoutil.Aggregate[*chat.Post](ctx, o.coll, GetAggregationPipeline(ctx, nil, bson.M{"post_id": postID}))
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return results[0], nil
+}
+
+func (o *Post) TakeDB(ctx context.Context, postID string) (*chat.PostDB, error) {
+	return mongoutil.FindOne[*chat.PostDB](ctx, o.coll, bson.M{"post_id": postID})
+}
+
+func (o *Post) FindDB(ctx context.Context, postIDs []string) ([]*chat.PostDB, error) {
+	if len(postIDs) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*chat.PostDB](ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}})
+}
+
 func (o *Post) UpdateByMap(ctx context.Context, postID string, data map[string]any) error {
 	if len(data) == 0 {
 		return nil
@@ -86,7 +246,14 @@ func (o *Post) UpdateByMap(ctx context.Context, postID string, data map[string]a
 	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": data}, false)
 }
 
-func (o *Post) GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, userIDs []string, count int64) ([]*chat.Post, string, error) {
+func (o *Post) UpdateCommentVisibility(ctx context.Context, commentPostID string, visibility int32, visibleUserIDs []string) error {
+	filter := bson.M{"comment_post_id": commentPostID}
+	update := bson.M{"$set": bson.M{"visibility": visibility, "visible_user_ids": visibleUserIDs, "update_time": time.Now()}}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	return err
+}
+
+func (o *Post) GetPostsByCursorAndUserIDs(ctx context.Context, cursor string, userIDs []string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{
 		"user_id": bson.M{"$in": userIDs},
 		"$or": []bson.M{
@@ -95,30 +262,222 @@ func (o *Post) GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, use
 			{"comment_post_id": bson.M{"$exists": false}},
 		},
 	}
-	sort := o.sortByCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return dbutil.FindPostPage(ctx, o.coll, cursor, dbutil.PostNewest, count, liveFilter(filter, visible), GetAggregationPipeline(ctx, visible), postCursor)
 }
 
-func (o *Post) GetPostsByCursorAndUser(ctx context.Context, cursor int64, userID string, count int64) ([]*chat.Post, string, error) {
+func (o *Post) GetPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{"user_id": userID}
-	sort := o.sortByPinedAndCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return dbutil.FindPostPage(ctx, o.coll, cursor, dbutil.PostPinnedNewest, count, liveFilter(filter, visible), GetAggregationPipeline(ctx, visible), postCursor)
 }
 
-func (o *Post) GetPostsByCursorAndPostIDs(ctx context.Context, cursor int64, postIDs []string, count int64) ([]*chat.Post, string, error) {
+func (o *Post) GetPostsByCursorAndPostIDs(ctx context.Context, cursor string, postIDs []string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{"post_id": bson.M{"$in": postIDs}}
-	sort := o.sortByCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return dbutil.FindPostPage(ctx, o.coll, cursor, dbutil.PostNewest, count, liveFilter(filter, visible), GetAggregationPipeline(ctx, visible), postCursor)
 }
 
-func (o *Post) GetCommentPostsByPostID(ctx context.Context, cursor int64, postID string, count int64) ([]*chat.Post, string, error) {
+func (o *Post) GetCommentPostsByPostID(ctx context.Context, cursor string, postID string, sort int32, excludePostID string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{"comment_post_id": postID}
-	sort := o.sortByCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	if excludePostID != "" {
+		filter["post_id"] = bson.M{"$ne": excludePostID}
+	}
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return dbutil.FindPostPage(ctx, o.coll, cursor, commentOrder(sort), count, liveFilter(filter, visible), GetAggregationPipeline(ctx, visible), postCursor)
+}
+
+func (o *Post) FindReplyPostIDs(ctx context.Context, commentPostIDs []string, sort int32, count int64) (map[string][]string, error) {
+	if len(commentPostIDs) == 0 {
+		return map[string][]string{}, nil
+	}
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: liveFilter(bson.M{"comment_post_id": bson.M{"$in": commentPostIDs}}, visible)}},
+	}
+	pipeline = append(pipeline, dbutil.SortPosts(commentOrder(sort))...)
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: bson.M{"_id": "$comment_post_id", "post_ids": bson.M{"$push": "$post_id"}}}},
+		bson.D{{Key: "$project", Value: bson.M{"post_ids": bson.M{"$slice": bson.A{"$post_ids", count}}}}},
+	)
+	results, err := mongoutil.Aggregate[struct {
+		CommentPostID string   `bson:"_id"`
+		PostIDs       []string `bson:"post_ids"`
+	}](ctx, o.coll, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	replies := make(map[string][]string, len(results))
+	for _, result := range results {
+		replies[result.CommentPostID] = result.PostIDs
+	}
+	return replies, nil
+}
+
+func (o *Post) GetPostsByCursorAndHashtag(ctx context.Context, cursor string, hashtag string, count int64) ([]*chat.Post, string, error) {
+	filter := bson.M{
+		"hashtags": hashtag,
+		"$or": []bson.M{
+			{"comment_post_id": nil},
+			{"comment_post_id": ""},
+		},
+	}
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return dbutil.FindPostPage(ctx, o.coll, cursor, dbutil.PostNewest, count, liveFilter(filter, visible), GetAggregationPipeline(ctx, visible), postCursor)
+}
+
+// cjkRegexp 匹配中日韩文字
+var cjkRegexp = regexp.MustCompile(`[\p{Han}\p{Hiragana}\p{Katakana}\p{Hangul}]`)
+
+func (o *Post) Search(ctx context.Context, search *chat.PostSearch, pagination pagination.Pagination) (int64, []*chat.Post, error) {
+	filter := bson.M{
+		"$or": []bson.M{
+			{"comment_post_id": nil},
+			{"comment_post_id": ""},
+		},
+	}
+	sort := bson.D{{Key: "create_time", Value: -1}, {Key: "post_id", Value: -1}}
+	if search.Keyword != "" {
+		if cjkRegexp.MatchString(search.Keyword) {
+			// 文本索引按空白与标点分词, 匹配不到中日韩文字中的词, 改为内容包含每个关键词
+			terms := strings.Fields(search.Keyword)
+			and := make([]bson.M, 0, len(terms))
+			for _, term := range terms {
+				and = append(and, bson.M{"content": primitive.Regex{Pattern: regexp.QuoteMeta(term), Options: "i"}})
+			}
+			filter["$and"] = and
+		} else {
+			filter["$text"] = bson.M{"$search": search.Keyword}
+			sort = append(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}}, sort...)
+		}
+	}
+	if search.Hashtag != "" {
+		filter["hashtags"] = search.Hashtag
+	}
+	if search.UserID != "" {
+		filter["user_id"] = search.UserID
+	}
+	createTime := bson.M{}
+	if !search.StartTime.IsZero() {
+		createTime["$gte"] = search.StartTime
+	}
+	if !search.EndTime.IsZero() {
+		createTime["$lt"] = search.EndTime
+	}
+	if len(createTime) > 0 {
+		filter["create_time"] = createTime
+	}
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	filter = liveFilter(filter, visible)
+	total, err := mongoutil.Count(ctx, o.coll, filter)
+	if err != nil {
+		return 0, nil, err
+	}
+	if total == 0 {
+		return 0, nil, nil
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$sort", Value: sort}},
+		{{Key: "$skip", Value: int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())}},
+		{{Key: "$limit", Value: int64(pagination.GetShowNumber())}},
+	}
+	pipeline = append(pipeline, GetAggregationPipeline(ctx, visible)...)
+	posts, err := mongoutil.Aggregate[*chat.Post](ctx, o.coll, pipeline)
+	if err != nil {
+		return 0, nil, err
+	}
+	return total, posts, nil
+}
+
+// discoverCursor 帖子在发现页分页中的位置
+func discoverCursor(post *chat.Post) dbutil.PostCursor {
+	return dbutil.PostCursor{
+		Pinned:     post.DiscoverPinned,
+		Score:      post.DiscoverScore,
+		CreateTime: post.CreateTime.UnixMilli(),
+		PostID:     post.PostID,
+	}
+}
+
+func (o *Post) GetDiscoverPostsByCursor(ctx context.Context, cursor string, count int64) ([]*chat.Post, string, error) {
+	filter := bson.M{
+		"visibility": bson.M{"$in": []any{constant.PostVisibilityPublic, nil}},
+		"$or": []bson.M{
+			{"comment_post_id": nil},
+			{"comment_post_id": ""},
+		},
+		"forward_post_id": bson.M{"$in": []any{nil, ""}},
+	}
+	// 发现页对所有人相同, 被引用的帖子按当前用户的可见范围过滤
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return dbutil.FindPostPage(ctx, o.coll, cursor, dbutil.PostDiscover, count, liveFilter(filter, nil), GetAggregationPipeline(ctx, visible), discoverCursor)
+}
+
+func (o *Post) FindDiscoverDirty(ctx context.Context, limit int64) ([]*chat.PostDB, error) {
+	return mongoutil.Find[*chat.PostDB](ctx, o.coll, bson.M{"discover_dirty": true}, options.Find().SetLimit(limit))
+}
+
+func (o *Post) ClearDiscoverDirty(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}}, bson.M{"$set": bson.M{"discover_dirty": false}})
+	return err
+}
+
+func (o *Post) MarkDiscoverDirty(ctx context.Context, since time.Time) error {
+	_, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"create_time": bson.M{"$gte": since}}, bson.M{"$set": bson.M{"discover_dirty": true}})
+	return err
+}
+
+func (o *Post) SetDiscoverScore(ctx context.Context, postID string, score float64) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"post_id": postID}, bson.M{"$set": bson.M{"discover_score": score}}, false)
+}
+
+func (o *Post) SetDiscover(ctx context.Context, postID string, pinned int32, boost float64) error {
+	update := bson.M{"$set": bson.M{"discover_pinned": pinned, "discover_boost": boost, "discover_dirty": true}}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"post_id": postID}, update, false)
+}
+
+// commentOrder 评论排序方式对应的帖子排序
+func commentOrder(sort int32) dbutil.PostOrder {
+	switch sort {
+	case constant.CommentSortOldest:
+		return dbutil.PostOldest
+	case constant.CommentSortTop:
+		return dbutil.PostMostLiked
+	default:
+		return dbutil.PostNewest
+	}
 }
 
 func (o *Post) GetCommentPostIDsByUser(ctx context.Context, userID string) ([]string, error) {
-	filter := bson.M{"user_id": userID, "comment_post_id": bson.M{
+	filter := bson.M{"user_id": userID, "deleted": bson.M{"$ne": constant.PostDeleted}, "comment_post_id": bson.M{
 		"$exists": true,
 		"$nin":    []interface{}{nil, ""},
 	}}
@@ -126,10 +485,64 @@ func (o *Post) GetCommentPostIDsByUser(ctx context.Context, userID string) ([]st
 }
 
 func (o *Post) GetPinnedPostByUserID(ctx context.Context, userID string) (*chat.Post, error) {
-	filter := bson.M{"user_id": userID, "is_pined": constant.Pinned}
+	filter := liveFilter(bson.M{"user_id": userID, "is_pined": constant.Pinned}, nil)
 	return mongoutil.FindOne[*chat.Post](ctx, o.coll, filter)
 }
 
+func (o *Post) IncrCount(ctx context.Context, postID string, field string, delta int64) error {
+	update := bson.M{"$inc": bson.M{field: delta}, "$set": bson.M{"discover_dirty": true}}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"post_id": postID}, update, false)
+}
+
+func (o *Post) SetCounts(ctx context.Context, postID string, counts map[string]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	update := bson.M{"discover_dirty": true}
+	for field, count := range counts {
+		update[field] = count
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"post_id": postID}, bson.M{"$set": update}, false)
+}
+
+func (o *Post) FindAfter(ctx context.Context, postID string, limit int64) ([]*chat.PostDB, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "post_id", Value: 1}}).SetLimit(limit)
+	return mongoutil.Find[*chat.PostDB](ctx, o.coll, bson.M{"post_id": bson.M{"$gt": postID}}, opts)
+}
+
+func (o *Post) CountComments(ctx context.Context, postIDs []string) (map[string]int64, error) {
+	filter := bson.M{"comment_post_id": bson.M{"$in": postIDs}, "deleted": bson.M{"$ne": constant.PostDeleted}}
+	return countBy(ctx, o.coll, filter, "comment_post_id")
+}
+
+func (o *Post) CountUserPosts(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	filter := bson.M{
+		"user_id": bson.M{"$in": userIDs},
+		"deleted": bson.M{"$ne": constant.PostDeleted},
+		"$or": []bson.M{
+			{"comment_post_id": nil},
+			{"comment_post_id": ""},
+		},
+	}
+	return countBy(ctx, o.coll, filter, "user_id")
+}
+
+func (o *Post) FindTimeline(ctx context.Context, userIDs []string, limit int64) ([]*chat.PostDB, error) {
+	filter := bson.M{
+		"user_id": bson.M{"$in": userIDs},
+		"deleted": bson.M{"$ne": constant.PostDeleted},
+		"$or": []bson.M{
+			{"comment_post_id": nil},
+			{"comment_post_id": ""},
+		},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"post_id": 1, "create_time": 1, "_id": 0}).
+		SetSort(bson.D{{Key: "create_time", Value: -1}}).
+		SetLimit(limit)
+	return mongoutil.Find[*chat.PostDB](ctx, o.coll, filter, opts)
+}
+
 // 通过forward_post_id获取post
 func (o *Post) GetPostByForwardPostID(ctx context.Context, userID, forwardPostID string) (*chat.Post, error) {
 	filter := bson.M{"user_id": userID, "forward_post_id": forwardPostID}
@@ -190,7 +603,95 @@ func (o *Post) GetSubscriberUserIDs(ctx context.Context, userID string) ([]strin
 
 }
 
-func GetAggregationPipeline(ctx context.Context, filter ...bson.M) mongo.Pipeline {
+// visibleFilter 返回当前操作用户可见帖子的过滤条件, 管理员可见全部帖子时返回 nil
+func (o *Post) visibleFilter(ctx context.Context) (bson.M, error) {
+	opUserID, userType, err := mctx.CheckAdminOrUser(ctx)
+	if err != nil {
+		return postVisibleFilter("", nil, nil), nil
+	}
+	if userType == constant.AdminUser {
+		return nil, nil
+	}
+	followedUserIDs, err := o.GetFollowedUserIDs(ctx, opUserID)
+	if err != nil {
+		return nil, err
+	}
+	// 互相关注即为好友, 只在关注的用户中查找回关的用户, 不读取全部粉丝
+	friendUserIDs, err := o.FilterFollowerUserIDs(ctx, opUserID, followedUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	return postVisibleFilter(opUserID, followedUserIDs, friendUserIDs), nil
+}
+
+// GetFollowerUserIDs 获取关注了该用户的用户IDs
+func (o *Post) GetFollowerUserIDs(ctx context.Context, userID string) ([]string, error) {
+	userRelationColl := o.coll.Database().Collection("friend_relation")
+	filter := bson.M{"related_user_id": userID, "is_following": 1, "is_blocked": 0}
+	type follower struct {
+		OwnerUserID string `bson:"owner_user_id"`
+	}
+	results, err := mongoutil.Find[follower](ctx, userRelationColl, filter, options.Find().SetProjection(bson.M{"owner_user_id": 1, "_id": 0}))
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Slice(results, func(e follower) string { return e.OwnerUserID }), nil
+}
+
+// FilterFollowedUserIDs 获取 relatedUserIDs 中被该用户关注的用户IDs
+func (o *Post) FilterFollowedUserIDs(ctx context.Context, userID string, relatedUserIDs []string) ([]string, error) {
+	if len(relatedUserIDs) == 0 {
+		return nil, nil
+	}
+	userRelationColl := o.coll.Database().Collection("friend_relation")
+	filter := bson.M{"owner_user_id": userID, "related_user_id": bson.M{"$in": relatedUserIDs}, "is_following": 1, "is_blocked": 0}
+	return mongoutil.Find[string](ctx, userRelationColl, filter, options.Find().SetProjection(bson.M{"related_user_id": 1, "_id": 0}))
+}
+
+// FilterFollowerUserIDs 获取 ownerUserIDs 中关注了该用户的用户IDs
+func (o *Post) FilterFollowerUserIDs(ctx context.Context, userID string, ownerUserIDs []string) ([]string, error) {
+	if len(ownerUserIDs) == 0 {
+		return nil, nil
+	}
+	userRelationColl := o.coll.Database().Collection("friend_relation")
+	filter := bson.M{"owner_user_id": bson.M{"$in": ownerUserIDs}, "related_user_id": userID, "is_following": 1, "is_blocked": 0}
+	return mongoutil.Find[string](ctx, userRelationColl, filter, options.Find().SetProjection(bson.M{"owner_user_id": 1, "_id": 0}))
+}
+
+func postVisibleFilter(opUserID string, followedUserIDs []string, friendUserIDs []string) bson.M {
+	or := []bson.M{
+		{"visibility": bson.M{"$in": []any{constant.PostVisibilityPublic, nil}}},
+	}
+	if opUserID != "" {
+		or = append(or,
+			bson.M{"user_id": opUserID},
+			bson.M{"visibility": constant.PostVisibilityFollowers, "user_id": bson.M{"$in": followedUserIDs}},
+			bson.M{"visibility": constant.PostVisibilityFriends, "user_id": bson.M{"$in": friendUserIDs}},
+			bson.M{"visibility": constant.PostVisibilityCustom, "visible_user_ids": opUserID},
+		)
+	}
+	return bson.M{"$or": or}
+}
+
+// liveFilter 过滤已删除、被隐藏与不可见的帖子
+func liveFilter(filter bson.M, visible bson.M) bson.M {
+	and := []bson.M{filter, notDeleted()}
+	if visible != nil {
+		and = append(and, visible)
+	}
+	return bson.M{"$and": and}
+}
+
+// notDeleted 过滤已删除与被管理员隐藏的帖子
+func notDeleted() bson.M {
+	return bson.M{
+		"deleted": bson.M{"$ne": constant.PostDeleted},
+		"hidden":  bson.M{"$ne": constant.PostHidden},
+	}
+}
+
+// GetAggregationPipeline 组装帖子详情, 转发、评论与引用的帖子不满足 visible 时为空
+func GetAggregationPipeline(ctx context.Context, visible bson.M, filter ...bson.M) mongo.Pipeline {
 	opUserID, _ := mctx.CheckUser(ctx)
 	var _pipeline []bson.D
 	if len(filter) > 0 {
@@ -199,12 +700,12 @@ func GetAggregationPipeline(ctx context.Context, filter ...bson.M) mongo.Pipelin
 	_pipeline = append(_pipeline,
 		lookupUserInfo(),
 		unwindUserInfo(),
-		lookupRelations(),
-		lookupForwardPost(opUserID, 3),
+		lookupRelations(opUserID),
+		lookupForwardPost(opUserID, visible, 3),
 		unwindForwardPost(),
-		lookupCommentPost(opUserID, 3),
+		lookupCommentPost(opUserID, visible, 3),
 		unwindCommentPost(),
-		lookupRefPost(opUserID, 3),
+		lookupRefPost(opUserID, visible, 3),
 		unwindRefPost(),
 		lookupAtUserInfo(),
 		addFields(opUserID),
@@ -213,7 +714,7 @@ func GetAggregationPipeline(ctx context.Context, filter ...bson.M) mongo.Pipelin
 	return _pipeline
 }
 
-func lookupForwardPost(opUserID string, depth int) bson.D {
+func lookupForwardPost(opUserID string, visible bson.M, depth int) bson.D {
 	if depth <= 0 {
 		return bson.D{{"$addFields", bson.D{{"forward_post", nil}}}}
 	}
@@ -223,14 +724,16 @@ func lookupForwardPost(opUserID string, depth int) bson.D {
 			{"let", bson.D{{"forwardPostId", "$forward_post_id"}}},
 			{"pipeline", bson.A{
 				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$post_id", "$$forwardPostId"}}}}}}},
+				matchVisible(visible),
+				tombstone(),
 				lookupUserInfo(),
 				unwindUserInfo(),
-				lookupRelations(),
-				lookupForwardPost(opUserID, depth-1),
+				lookupRelations(opUserID),
+				lookupForwardPost(opUserID, visible, depth-1),
 				unwindForwardPost(),
-				lookupCommentPost(opUserID, depth-1),
+				lookupCommentPost(opUserID, visible, depth-1),
 				unwindCommentPost(),
-				lookupRefPost(opUserID, depth-1),
+				lookupRefPost(opUserID, visible, depth-1),
 				unwindRefPost(),
 				lookupAtUserInfo(),
 				addFields(opUserID),
@@ -240,7 +743,7 @@ func lookupForwardPost(opUserID string, depth int) bson.D {
 	}
 }
 
-func lookupCommentPost(opUserID string, depth int) bson.D {
+func lookupCommentPost(opUserID string, visible bson.M, depth int) bson.D {
 	if depth <= 0 {
 		return bson.D{{"$addFields", bson.D{{"comment_post", nil}}}}
 	}
@@ -250,14 +753,16 @@ func lookupCommentPost(opUserID string, depth int) bson.D {
 			{"let", bson.D{{"commentPostId", "$comment_post_id"}}},
 			{"pipeline", bson.A{
 				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$post_id", "$$commentPostId"}}}}}}},
+				matchVisible(visible),
+				tombstone(),
 				lookupUserInfo(),
 				unwindUserInfo(),
-				lookupRelations(),
-				lookupForwardPost(opUserID, depth-1),
+				lookupRelations(opUserID),
+				lookupForwardPost(opUserID, visible, depth-1),
 				unwindForwardPost(),
-				lookupCommentPost(opUserID, depth-1),
+				lookupCommentPost(opUserID, visible, depth-1),
 				unwindCommentPost(),
-				lookupRefPost(opUserID, depth-1),
+				lookupRefPost(opUserID, visible, depth-1),
 				unwindRefPost(),
 				lookupAtUserInfo(),
 				addFields(opUserID),
@@ -267,7 +772,7 @@ func lookupCommentPost(opUserID string, depth int) bson.D {
 	}
 }
 
-func lookupRefPost(opUserID string, depth int) bson.D {
+func lookupRefPost(opUserID string, visible bson.M, depth int) bson.D {
 	if depth <= 0 {
 		return bson.D{{"$addFields", bson.D{{"ref_post", nil}}}}
 	}
@@ -277,14 +782,16 @@ func lookupRefPost(opUserID string, depth int) bson.D {
 			{"let", bson.D{{"refPostId", "$ref_post_id"}}},
 			{"pipeline", bson.A{
 				bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$post_id", "$$refPostId"}}}}}}},
+				matchVisible(visible),
+				tombstone(),
 				lookupUserInfo(),
 				unwindUserInfo(),
-				lookupRelations(),
-				lookupForwardPost(opUserID, depth-1),
+				lookupRelations(opUserID),
+				lookupForwardPost(opUserID, visible, depth-1),
 				unwindForwardPost(),
-				lookupCommentPost(opUserID, depth-1),
+				lookupCommentPost(opUserID, visible, depth-1),
 				unwindCommentPost(),
-				lookupRefPost(opUserID, depth-1),
+				lookupRefPost(opUserID, visible, depth-1),
 				unwindRefPost(),
 				lookupAtUserInfo(),
 				addFields(opUserID),
@@ -294,6 +801,26 @@ func lookupRefPost(opUserID string, depth int) bson.D {
 	}
 }
 
+// tombstone 被删除或隐藏的帖子只保留ID与删除标记, 转发与引用它的帖子仍可展示
+func tombstone() bson.D {
+	deleted := bson.D{{"$or", bson.A{
+		bson.D{{"$eq", bson.A{"$deleted", constant.PostDeleted}}},
+		bson.D{{"$eq", bson.A{"$hidden", constant.PostHidden}}},
+	}}}
+	return bson.D{{"$addFields", bson.D{
+		{"content", bson.D{{"$cond", bson.A{deleted, "", "$content"}}}},
+		{"at_user_ids", bson.D{{"$cond", bson.A{deleted, nil, "$at_user_ids"}}}},
+		{"media_msgs", bson.D{{"$cond", bson.A{deleted, nil, "$media_msgs"}}}},
+	}}}
+}
+
+func matchVisible(visible bson.M) bson.D {
+	if visible == nil {
+		return bson.D{{"$match", bson.D{}}}
+	}
+	return bson.D{{"$match", visible}}
+}
+
 func lookupUserInfo() bson.D {
 	return bson.D{
 		{"$lookup", bson.D{
@@ -341,12 +868,18 @@ func unwindUserInfo() bson.D {
 	}
 }
 
-func lookupRelations() bson.D {
+// lookupRelations 只关联操作用户与帖子的关系, 计数直接存储在帖子上
+func lookupRelations(opUserID string) bson.D {
 	return bson.D{
 		{"$lookup", bson.D{
 			{"from", "user_post_relation"},
-			{"localField", "post_id"},
-			{"foreignField", "post_id"},
+			{"let", bson.D{{"postId", "$post_id"}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{
+					{"user_id", opUserID},
+					{"$expr", bson.D{{"$eq", bson.A{"$post_id", "$$postId"}}}},
+				}}},
+			}},
 			{"as", "relations"},
 		}},
 	}
@@ -387,27 +920,16 @@ func lookupAtUserInfo() bson.D {
 func addFields(opUserID string) bson.D {
 	return bson.D{
 		{"$addFields", bson.D{
-			{"like_count", getCountField("is_liked")},
-			{"comment_count", getCountField("is_commented")},
-			{"forward_count", getCountField("is_forwarded")},
 			{"is_liked", getIsField("is_liked", opUserID)},
 			{"is_collected", getIsField("is_collected", opUserID)},
 			{"is_commented", getIsField("is_commented", opUserID)},
 			{"is_forwarded", getIsField("is_forwarded", opUserID)},
-		}},
-	}
-}
-
-func getCountField(fieldName string) bson.D {
-	return bson.D{
-		{"$size", bson.D{
-			{"$filter", bson.D{
-				{"input", "$relations"},
-				{"as", "relation"},
-				{"cond", bson.D{
-					{"$eq", bson.A{fmt.Sprintf("$$relation.%s", fieldName), 1}},
-				}},
-			}},
+			// 自定义可见的用户列表只返回给作者
+			{"visible_user_ids", bson.D{{"$cond", bson.A{
+				bson.D{{"$eq", bson.A{"$user_id", opUserID}}},
+				"$visible_user_ids",
+				nil,
+			}}}},
 		}},
 	}
 }
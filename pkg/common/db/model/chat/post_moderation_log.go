@@ -0,0 +1,68 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PostModerationLog struct {
+	coll *mongo.Collection
+}
+
+func NewPostModerationLog(db *mongo.Database) (chat.PostModerationLogInterface, error) {
+	coll := db.Collection("post_moderation_logs")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "log_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "post_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PostModerationLog{coll: coll}, nil
+}
+
+func (o *PostModerationLog) Create(ctx context.Context, log *chat.PostModerationLog) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.PostModerationLog{log})
+}
+
+func (o *PostModerationLog) FindByPostID(ctx context.Context, postID string) ([]*chat.PostModerationLog, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.Find[*chat.PostModerationLog](ctx, o.coll, bson.M{"post_id": postID}, opts)
+}
+
+func (o *PostModerationLog) FindPage(ctx context.Context, postID string, authorUserID string, operatorUserID string, pagination pagination.Pagination) (int64, []*chat.PostModerationLog, error) {
+	filter := bson.M{}
+	if postID != "" {
+		filter["post_id"] = postID
+	}
+	if authorUserID != "" {
+		filter["author_user_id"] = authorUserID
+	}
+	if operatorUserID != "" {
+		filter["operator_user_id"] = operatorUserID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*chat.PostModerationLog](ctx, o.coll, filter, pagination, opts)
+}
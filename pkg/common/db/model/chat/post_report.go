@@ -0,0 +1,97 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PostReport struct {
+	coll *mongo.Collection
+}
+
+func NewPostReport(db *mongo.Database) (chat.PostReportInterface, error) {
+	coll := db.Collection("post_reports")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "report_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// 同一用户对同一帖子只有一条待处理的举报
+			Keys: bson.D{
+				{Key: "post_id", Value: 1},
+				{Key: "reporter_user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"status": constant.PostReportPending}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "post_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PostReport{coll: coll}, nil
+}
+
+func (o *PostReport) Create(ctx context.Context, report *chat.PostReport) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.PostReport{report})
+}
+
+func (o *PostReport) Search(ctx context.Context, search *chat.PostReportSearch, pagination pagination.Pagination) (int64, []*chat.PostReport, error) {
+	filter := bson.M{}
+	if search.Status != nil {
+		filter["status"] = *search.Status
+	}
+	if search.PostID != "" {
+		filter["post_id"] = search.PostID
+	}
+	if search.ReporterUserID != "" {
+		filter["reporter_user_id"] = search.ReporterUserID
+	}
+	if search.AuthorUserID != "" {
+		filter["author_user_id"] = search.AuthorUserID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*chat.PostReport](ctx, o.coll, filter, pagination, opts)
+}
+
+func (o *PostReport) FindByPostID(ctx context.Context, postID string, limit int64) ([]*chat.PostReport, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}}).SetLimit(limit)
+	return mongoutil.Find[*chat.PostReport](ctx, o.coll, bson.M{"post_id": postID}, opts)
+}
+
+func (o *PostReport) Handle(ctx context.Context, postID string, status int32, action int32, operatorUserID string, handleTime time.Time) (int64, error) {
+	filter := bson.M{"post_id": postID, "status": constant.PostReportPending}
+	update := bson.M{"$set": bson.M{
+		"status":           status,
+		"action":           action,
+		"operator_user_id": operatorUserID,
+		"handle_time":      handleTime,
+	}}
+	res, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
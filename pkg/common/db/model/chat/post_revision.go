@@ -0,0 +1,46 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PostRevision struct {
+	coll *mongo.Collection
+}
+
+func NewPostRevision(db *mongo.Database) (chat.PostRevisionInterface, error) {
+	coll := db.Collection("post_revisions")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "post_id", Value: 1},
+			{Key: "version", Value: -1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PostRevision{coll: coll}, nil
+}
+
+func (o *PostRevision) Create(ctx context.Context, revisions []*chat.PostRevision) error {
+	return mongoutil.InsertMany(ctx, o.coll, revisions)
+}
+
+func (o *PostRevision) Find(ctx context.Context, postID string) ([]*chat.PostRevision, error) {
+	return mongoutil.Find[*chat.PostRevision](ctx, o.coll, bson.M{"post_id": postID}, options.Find().SetSort(bson.D{{Key: "version", Value: -1}}))
+}
+
+func (o *PostRevision) Delete(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}})
+}
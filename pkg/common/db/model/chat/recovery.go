@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewRecoveryGuardian(db *mongo.Database) (chat.RecoveryGuardianInterface, error) {
+	coll := db.Collection("recovery_guardians")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RecoveryGuardian{coll: coll}, nil
+}
+
+type RecoveryGuardian struct {
+	coll *mongo.Collection
+}
+
+func (o *RecoveryGuardian) Set(ctx context.Context, guardian *chat.RecoveryGuardian) error {
+	_, err := o.coll.ReplaceOne(ctx, bson.M{"user_id": guardian.UserID}, guardian, options.Replace().SetUpsert(true))
+	return errs.Wrap(err)
+}
+
+func (o *RecoveryGuardian) Take(ctx context.Context, userID string) (*chat.RecoveryGuardian, error) {
+	return mongoutil.FindOne[*chat.RecoveryGuardian](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func NewRecoveryRequest(db *mongo.Database) (chat.RecoveryRequestInterface, error) {
+	coll := db.Collection("recovery_requests")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "recovery_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RecoveryRequest{coll: coll}, nil
+}
+
+type RecoveryRequest struct {
+	coll *mongo.Collection
+}
+
+func (o *RecoveryRequest) Create(ctx context.Context, request *chat.RecoveryRequest) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.RecoveryRequest{request})
+}
+
+func (o *RecoveryRequest) Take(ctx context.Context, recoveryID string) (*chat.RecoveryRequest, error) {
+	return mongoutil.FindOne[*chat.RecoveryRequest](ctx, o.coll, bson.M{"recovery_id": recoveryID})
+}
+
+func (o *RecoveryRequest) AddApproval(ctx context.Context, recoveryID string, approval *chat.RecoveryApproval) (*chat.RecoveryRequest, error) {
+	filter := bson.M{
+		"recovery_id":                recoveryID,
+		"status":                     constant.RecoveryPending,
+		"approvals.guardian_user_id": bson.M{"$ne": approval.GuardianUserID},
+		"guardian_user_ids":          approval.GuardianUserID,
+	}
+	update := bson.M{"$push": bson.M{"approvals": approval}}
+	return mongoutil.FindOneAndUpdate[*chat.RecoveryRequest](ctx, o.coll, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+}
+
+func (o *RecoveryRequest) UpdateStatus(ctx context.Context, recoveryID string, status int32, data map[string]any) error {
+	set := bson.M{"status": status}
+	for k, v := range data {
+		set[k] = v
+	}
+	filter := bson.M{"recovery_id": recoveryID, "status": constant.RecoveryPending}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": set}, true)
+}
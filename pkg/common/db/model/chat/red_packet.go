@@ -0,0 +1,295 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewRedPacket(db *mongo.Database) (chat.RedPacketInterface, error) {
+	coll := db.Collection("red_packets")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "red_packet_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "expire_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "notified", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "send_user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RedPacket{coll: coll}, nil
+}
+
+type RedPacket struct {
+	coll *mongo.Collection
+}
+
+func (o *RedPacket) Create(ctx context.Context, packet *chat.RedPacket) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.RedPacket{packet})
+}
+
+func (o *RedPacket) Take(ctx context.Context, redPacketID string) (*chat.RedPacket, error) {
+	return mongoutil.FindOne[*chat.RedPacket](ctx, o.coll, bson.M{"red_packet_id": redPacketID})
+}
+
+func (o *RedPacket) Claim(ctx context.Context, redPacketID string, userID string, now time.Time) (*chat.RedPacket, error) {
+	filter := bson.M{
+		"red_packet_id":    redPacketID,
+		"status":           constant.RedPacketPending,
+		"unsettled":        bson.M{"$ne": true},
+		"expire_time":      bson.M{"$gt": now},
+		"remain_amounts.0": bson.M{"$exists": true},
+		"claimed_user_ids": bson.M{"$ne": userID},
+	}
+	update := bson.M{
+		"$pop":  bson.M{"remain_amounts": 1},
+		"$push": bson.M{"claimed_user_ids": userID},
+	}
+	return mongoutil.FindOneAndUpdate[*chat.RedPacket](ctx, o.coll, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.Before))
+}
+
+func (o *RedPacket) Refund(ctx context.Context, redPacketID string, now time.Time) (*chat.RedPacket, error) {
+	filter := bson.M{
+		"red_packet_id":    redPacketID,
+		"status":           constant.RedPacketPending,
+		"unsettled":        bson.M{"$ne": true},
+		"remain_amounts.0": bson.M{"$exists": true},
+	}
+	// a pipeline update sums the shares that are left in the same atomic step that clears them
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"status":         constant.RedPacketRefunded,
+			"refund_amount":  bson.M{"$sum": "$remain_amounts"},
+			"refund_time":    now,
+			"remain_amounts": bson.A{},
+		}}},
+	}
+	return mongoutil.FindOneAndUpdate[*chat.RedPacket](ctx, o.coll, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+}
+
+func (o *RedPacket) FindExpired(ctx context.Context, now time.Time, limit int64) ([]*chat.RedPacket, error) {
+	filter := bson.M{
+		"status":           constant.RedPacketPending,
+		"unsettled":        bson.M{"$ne": true},
+		"expire_time":      bson.M{"$lte": now},
+		"remain_amounts.0": bson.M{"$exists": true},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "expire_time", Value: 1}}).SetLimit(limit)
+	return mongoutil.Find[*chat.RedPacket](ctx, o.coll, filter, opts)
+}
+
+func (o *RedPacket) FindUnnotified(ctx context.Context, limit int64) ([]*chat.RedPacket, error) {
+	filter := bson.M{
+		"status":   constant.RedPacketRefunded,
+		"notified": bson.M{"$ne": true},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "refund_time", Value: 1}}).SetLimit(limit)
+	return mongoutil.Find[*chat.RedPacket](ctx, o.coll, filter, opts)
+}
+
+func (o *RedPacket) SetNotified(ctx context.Context, redPacketID string, notified bool) error {
+	filter := bson.M{"red_packet_id": redPacketID, "notified": bson.M{"$ne": notified}}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": bson.M{"notified": notified}}, true)
+}
+
+func (o *RedPacket) SetSettled(ctx context.Context, redPacketID string) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"red_packet_id": redPacketID}, bson.M{"$unset": bson.M{"unsettled": ""}}, false)
+}
+
+func NewRedPacketClaim(db *mongo.Database) (chat.RedPacketClaimInterface, error) {
+	coll := db.Collection("red_packet_claims")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "red_packet_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RedPacketClaim{coll: coll}, nil
+}
+
+type RedPacketClaim struct {
+	coll *mongo.Collection
+}
+
+func (o *RedPacketClaim) Create(ctx context.Context, claim *chat.RedPacketClaim) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.RedPacketClaim{claim})
+}
+
+func (o *RedPacketClaim) Find(ctx context.Context, redPacketID string) ([]*chat.RedPacketClaim, error) {
+	return mongoutil.Find[*chat.RedPacketClaim](ctx, o.coll, bson.M{"red_packet_id": redPacketID}, options.Find().SetSort(bson.D{{Key: "claim_time", Value: 1}}))
+}
+
+func NewRedPacketBalance(db *mongo.Database) (chat.RedPacketBalanceInterface, error) {
+	coll := db.Collection("red_packet_balances")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RedPacketBalance{coll: coll}, nil
+}
+
+type RedPacketBalance struct {
+	coll *mongo.Collection
+}
+
+func (o *RedPacketBalance) Take(ctx context.Context, userID string) (*chat.RedPacketBalance, error) {
+	return mongoutil.FindOne[*chat.RedPacketBalance](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *RedPacketBalance) Incr(ctx context.Context, userID string, amount int64) error {
+	update := bson.M{"$inc": bson.M{"balance": amount}, "$set": bson.M{"update_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (o *RedPacketBalance) Decr(ctx context.Context, userID string, amount int64) error {
+	filter := bson.M{"user_id": userID, "balance": bson.M{"$gte": amount}}
+	update := bson.M{"$inc": bson.M{"balance": -amount}, "$set": bson.M{"update_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, update, true)
+}
+
+func NewRedPacketCallback(db *mongo.Database) (chat.RedPacketCallbackInterface, error) {
+	coll := db.Collection("red_packet_callbacks")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "client_msg_id", Value: 1},
+				{Key: "operation", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "red_packet_id", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RedPacketCallback{coll: coll}, nil
+}
+
+type RedPacketCallback struct {
+	coll *mongo.Collection
+}
+
+func (o *RedPacketCallback) Create(ctx context.Context, callback *chat.RedPacketCallback) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.RedPacketCallback{callback})
+}
+
+func (o *RedPacketCallback) Take(ctx context.Context, clientMsgID string, operation string) (*chat.RedPacketCallback, error) {
+	return mongoutil.FindOne[*chat.RedPacketCallback](ctx, o.coll, bson.M{"client_msg_id": clientMsgID, "operation": operation})
+}
+
+func (o *RedPacketCallback) Find(ctx context.Context, clientMsgID string) ([]*chat.RedPacketCallback, error) {
+	return mongoutil.Find[*chat.RedPacketCallback](ctx, o.coll, bson.M{"client_msg_id": clientMsgID})
+}
+
+func NewRedPacketSettlement(db *mongo.Database) (chat.RedPacketSettlementInterface, error) {
+	coll := db.Collection("red_packet_settlements")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "settlement_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "create_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RedPacketSettlement{coll: coll}, nil
+}
+
+type RedPacketSettlement struct {
+	coll *mongo.Collection
+}
+
+func (o *RedPacketSettlement) Create(ctx context.Context, settlement *chat.RedPacketSettlement) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.RedPacketSettlement{settlement})
+}
+
+func (o *RedPacketSettlement) Take(ctx context.Context, settlementID string) (*chat.RedPacketSettlement, error) {
+	return mongoutil.FindOne[*chat.RedPacketSettlement](ctx, o.coll, bson.M{"settlement_id": settlementID})
+}
+
+func (o *RedPacketSettlement) Complete(ctx context.Context, settlementID string, now time.Time) error {
+	filter := bson.M{"settlement_id": settlementID, "status": constant.RedPacketSettlementPending}
+	update := bson.M{"$set": bson.M{"status": constant.RedPacketSettlementDone, "update_time": now}, "$inc": bson.M{"attempts": 1}}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, update, true)
+}
+
+func (o *RedPacketSettlement) Fail(ctx context.Context, settlementID string, reason string, now time.Time) error {
+	filter := bson.M{"settlement_id": settlementID, "status": constant.RedPacketSettlementPending}
+	update := bson.M{"$set": bson.M{"last_error": reason, "update_time": now}, "$inc": bson.M{"attempts": 1}}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, update, false)
+}
+
+func (o *RedPacketSettlement) FindPending(ctx context.Context, before time.Time, maxAttempts int32, limit int64) ([]*chat.RedPacketSettlement, error) {
+	filter := bson.M{
+		"status": constant.RedPacketSettlementPending,
+		// a send is only retried by its message callback, a rejected message must not be charged
+		"operation":   bson.M{"$ne": constant.RedPacketCallbackSend},
+		"create_time": bson.M{"$lt": before},
+		"attempts":    bson.M{"$lt": maxAttempts},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}}).SetLimit(limit)
+	return mongoutil.Find[*chat.RedPacketSettlement](ctx, o.coll, filter, opts)
+}
@@ -0,0 +1,57 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type Topic struct {
+	coll *mongo.Collection
+}
+
+func NewTopic(db *mongo.Database) (chat.TopicInterface, error) {
+	coll := db.Collection("topics")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "name", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "post_count", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Topic{coll: coll}, nil
+}
+
+func (o *Topic) Incr(ctx context.Context, names []string, delta int64) error {
+	now := time.Now()
+	for _, name := range names {
+		update := bson.M{
+			"$inc":         bson.M{"post_count": delta},
+			"$set":         bson.M{"update_time": now},
+			"$setOnInsert": bson.M{"create_time": now},
+		}
+		if err := mongoutil.UpdateOne(ctx, o.coll, bson.M{"name": name}, update, false, options.Update().SetUpsert(true)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *Topic) Take(ctx context.Context, name string) (*chat.Topic, error) {
+	return mongoutil.FindOne[*chat.Topic](ctx, o.coll, bson.M{"name": name})
+}
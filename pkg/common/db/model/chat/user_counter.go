@@ -0,0 +1,99 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type UserCounter struct {
+	coll *mongo.Collection
+}
+
+func NewUserCounter(db *mongo.Database) (chat.UserCounterInterface, error) {
+	coll := db.Collection("user_counters")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "follower_count", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserCounter{coll: coll}, nil
+}
+
+func (o *UserCounter) Find(ctx context.Context, userIDs []string) ([]*chat.UserCounter, error) {
+	return mongoutil.Find[*chat.UserCounter](ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func (o *UserCounter) Incr(ctx context.Context, userID string, field string, delta int64) error {
+	update := bson.M{
+		"$inc": bson.M{field: delta},
+		"$set": bson.M{"update_time": time.Now()},
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (o *UserCounter) Set(ctx context.Context, counter *chat.UserCounter) error {
+	update := bson.M{"$set": bson.M{
+		"post_count":      counter.PostCount,
+		"follower_count":  counter.FollowerCount,
+		"following_count": counter.FollowingCount,
+		"update_time":     time.Now(),
+	}}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": counter.UserID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (o *UserCounter) FindUserIDsByFollowerCount(ctx context.Context, count int64) ([]string, error) {
+	filter := bson.M{"follower_count": bson.M{"$gt": count}}
+	return mongoutil.Find[string](ctx, o.coll, filter, options.Find().SetProjection(bson.M{"user_id": 1, "_id": 0}))
+}
+
+func (o *UserCounter) CountFollows(ctx context.Context, userIDs []string) (map[string]int64, map[string]int64, error) {
+	// 关注关系与帖子订阅在同一个集合中维护
+	userRelationColl := o.coll.Database().Collection("friend_relation")
+	followers, err := countBy(ctx, userRelationColl, bson.M{"related_user_id": bson.M{"$in": userIDs}, "is_following": 1, "is_blocked": 0}, "related_user_id")
+	if err != nil {
+		return nil, nil, err
+	}
+	following, err := countBy(ctx, userRelationColl, bson.M{"owner_user_id": bson.M{"$in": userIDs}, "is_following": 1, "is_blocked": 0}, "owner_user_id")
+	if err != nil {
+		return nil, nil, err
+	}
+	return followers, following, nil
+}
+
+// countBy 按 field 分组统计满足 filter 的文档数
+func countBy(ctx context.Context, coll *mongo.Collection, filter bson.M, field string) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}}},
+	}
+	results, err := mongoutil.Aggregate[struct {
+		ID    string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}](ctx, coll, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(results))
+	for _, result := range results {
+		counts[result.ID] = result.Count
+	}
+	return counts, nil
+}
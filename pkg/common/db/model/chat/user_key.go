@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewUserKey(db *mongo.Database) (chat.UserKeyInterface, error) {
+	coll := db.Collection("user_keys")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "address", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserKey{coll: coll}, nil
+}
+
+type UserKey struct {
+	coll *mongo.Collection
+}
+
+func (o *UserKey) Save(ctx context.Context, key *chat.UserKey) error {
+	filter := bson.M{"address": key.Address, "status": bson.M{"$ne": constant.UserKeyActive}}
+	_, err := o.coll.ReplaceOne(ctx, filter, key, options.Replace().SetUpsert(true))
+	return errs.Wrap(err)
+}
+
+func (o *UserKey) TakeByAddress(ctx context.Context, address string) (*chat.UserKey, error) {
+	return mongoutil.FindOne[*chat.UserKey](ctx, o.coll, bson.M{"address": address})
+}
+
+func (o *UserKey) FindByUserID(ctx context.Context, userID string) ([]*chat.UserKey, error) {
+	return mongoutil.Find[*chat.UserKey](ctx, o.coll, bson.M{"user_id": userID}, options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}}))
+}
+
+func (o *UserKey) Revoke(ctx context.Context, userID string, address string) error {
+	filter := bson.M{"user_id": userID, "address": address, "status": constant.UserKeyActive}
+	update := bson.M{"$set": bson.M{"status": constant.UserKeyRevoked, "revoke_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, update, true)
+}
+
+func (o *UserKey) RevokeAll(ctx context.Context, userID string) error {
+	filter := bson.M{"user_id": userID, "status": constant.UserKeyActive}
+	update := bson.M{"$set": bson.M{"status": constant.UserKeyRevoked, "revoke_time": time.Now()}}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	return err
+}
+
+func (o *UserKey) UpdateLoginTime(ctx context.Context, address string, loginTime time.Time) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"address": address}, bson.M{"$set": bson.M{"last_login_time": loginTime}}, false)
+}
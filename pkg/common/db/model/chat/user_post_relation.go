@@ -60,6 +60,17 @@ func (o *UserPostRelation) Delete(ctx context.Context, userID, postID string) er
 	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"post_id": postID})
 }
 
+func (o *UserPostRelation) DeleteByPostIDs(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}})
+}
+
+func (o *UserPostRelation) CountByPostIDs(ctx context.Context, postIDs []string, field string) (map[string]int64, error) {
+	return countBy(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}, field: 1}, "post_id")
+}
+
 func (o *UserPostRelation) GetLikeCount(ctx context.Context, postID string) (int64, error) {
 	return mongoutil.Count(ctx, o.coll, bson.M{"post_id": postID, "is_liked": 1})
 }
@@ -25,6 +25,11 @@ type IPForbidden struct {
 	LimitRegister bool      `bson:"limit_register"`
 	LimitLogin    bool      `bson:"limit_login"`
 	CreateTime    time.Time `bson:"create_time"`
+	// Reason and ExpireTime are set on temporary lockouts, a zero ExpireTime never expires.
+	Reason     string    `bson:"reason"`
+	ExpireTime time.Time `bson:"expire_time"`
+	// LoginExpireTime is a temporary login lockout on top of a permanent rule that does not limit login.
+	LoginExpireTime time.Time `bson:"login_expire_time"`
 }
 
 func (IPForbidden) IPForbidden() string {
@@ -36,5 +41,6 @@ type IPForbiddenInterface interface {
 	Find(ctx context.Context, ips []string) ([]*IPForbidden, error)
 	Search(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*IPForbidden, error)
 	Create(ctx context.Context, ms []*IPForbidden) error
+	Upsert(ctx context.Context, m *IPForbidden) error
 	Delete(ctx context.Context, ips []string) error
 }
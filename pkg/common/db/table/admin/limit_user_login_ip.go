@@ -20,10 +20,17 @@ import (
 	"time"
 )
 
+// LimitUserLoginIP allows a user to log in only from the listed IPs. A Locked entry instead
+// blocks the user from that IP until ExpireTime, it is not part of the allow list.
 type LimitUserLoginIP struct {
 	UserID     string    `bson:"user_id"`
 	IP         string    `bson:"ip"`
 	CreateTime time.Time `bson:"create_time"`
+	Locked     bool      `bson:"locked"`
+	Reason     string    `bson:"reason"`
+	ExpireTime time.Time `bson:"expire_time"`
+	// LockExpireTime is a temporary lockout on top of an allow list entry.
+	LockExpireTime time.Time `bson:"lock_expire_time"`
 }
 
 func (LimitUserLoginIP) TableName() string {
@@ -32,6 +39,7 @@ func (LimitUserLoginIP) TableName() string {
 
 type LimitUserLoginIPInterface interface {
 	Create(ctx context.Context, ms []*LimitUserLoginIP) error
+	Upsert(ctx context.Context, m *LimitUserLoginIP) error
 	Delete(ctx context.Context, ms []*LimitUserLoginIP) error
 	Count(ctx context.Context, userID string) (uint32, error)
 	Take(ctx context.Context, userID string, ip string) (*LimitUserLoginIP, error)
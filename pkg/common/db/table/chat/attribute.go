@@ -44,6 +44,7 @@ type Attribute struct {
 	AllowAddFriend   int32 `bson:"allow_add_friend"`
 	GlobalRecvMsgOpt int32 `bson:"global_recv_msg_opt"`
 	RegisterType     int32 `bson:"register_type"`
+	PostInteraction  int32 `bson:"post_interaction"`
 }
 
 func (Attribute) TableName() string {
@@ -30,7 +30,24 @@ type AppFakeUserConfig struct {
 	Config FakeUserConfig `bson:"config"`
 }
 
+// DiscoverConfig weights the engagement counters of a post in its discover score,
+// the score of a post drops by one order of magnitude every DecayHours.
+type DiscoverConfig struct {
+	LikeWeight    float64 `json:"likeWeight"`
+	CommentWeight float64 `json:"commentWeight"`
+	ForwardWeight float64 `json:"forwardWeight"`
+	ViewWeight    float64 `json:"viewWeight"`
+	DecayHours    float64 `json:"decayHours"`
+}
+
+type AppDiscoverConfig struct {
+	Name   string         `bson:"name"`
+	Config DiscoverConfig `bson:"config"`
+}
+
 type AppConfigInterface interface {
 	GetVersionConfig(ctx context.Context) (*AppVersionConfig, error)
 	GetFakeUserConfig(ctx context.Context) (*AppFakeUserConfig, error)
+	GetDiscoverConfig(ctx context.Context) (*AppDiscoverConfig, error)
+	SetDiscoverConfig(ctx context.Context, config *DiscoverConfig) error
 }
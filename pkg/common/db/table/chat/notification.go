@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// Notification 帖子互动通知, 同一帖子的同类未读通知聚合为一条
+type Notification struct {
+	NotificationID string `bson:"notification_id"`
+	UserID         string `bson:"user_id"`
+	Type           int32  `bson:"type"`
+	// 被点赞、评论、转发、引用的帖子, 提及时为提及用户的帖子
+	PostID string `bson:"post_id"`
+	// 最近一次产生通知的评论、转发或引用帖子
+	SourcePostID string `bson:"source_post_id"`
+	// 最近的操作用户, 最新的在前, 最多保留 MaxNotificationActors 个
+	ActorUserIDs []string  `bson:"actor_user_ids"`
+	ActorCount   int64     `bson:"actor_count"`
+	IsRead       int32     `bson:"is_read"`
+	CreateTime   time.Time `bson:"create_time"`
+	UpdateTime   time.Time `bson:"update_time"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+type NotificationInterface interface {
+	// 合并到同一帖子的同类未读通知, 不存在时创建, 返回合并后的通知
+	Add(ctx context.Context, notification *Notification) (*Notification, error)
+	// 按更新时间倒序获取用户的通知
+	FindPage(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*Notification, error)
+	// 标记已读, notificationIDs 为空时标记全部
+	MarkRead(ctx context.Context, userID string, notificationIDs []string) error
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	// 删除帖子相关的通知
+	DeleteByPostIDs(ctx context.Context, postIDs []string) error
+}
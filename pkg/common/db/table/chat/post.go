@@ -3,6 +3,8 @@ package chat
 import (
 	"context"
 	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
 )
 
 type PostDB struct {
@@ -10,14 +12,45 @@ type PostDB struct {
 	UserID        string       `bson:"user_id"`
 	ForwardPostID string       `bson:"forward_post_id"`
 	CommentPostID string       `bson:"comment_post_id"`
+	RootPostID    string       `bson:"root_post_id"` // 评论所属的帖子, CommentPostID 为回复的评论
 	RefPostID     string       `bson:"ref_post_id"`
 	Content       string       `bson:"content"`
 	AllowComment  int32        `bson:"allow_comment"`
 	AllowForward  int32        `bson:"allow_forward"`
 	AtUserIds     []string     `bson:"at_user_ids"`
+	Hashtags      []string     `bson:"hashtags"` // 从内容中提取的话题标签, 已转为小写
 	MediaMsgs     []*PostMedia `bson:"media_msgs"`
-	CreateTime    time.Time    `bson:"create_time"`
-	UpdateTime    time.Time    `bson:"update_time"`
+	// 可见范围, 自定义可见时仅 VisibleUserIDs 与作者可见
+	Visibility     int32    `bson:"visibility"`
+	VisibleUserIDs []string `bson:"visible_user_ids"`
+	// 作者置顶的评论
+	PinnedCommentID string `bson:"pinned_comment_id"`
+	// 计数随点赞、评论、转发、收藏与查看原子更新, 偏差由定时任务修复
+	LikeCount    int64 `bson:"like_count"`
+	CommentCount int64 `bson:"comment_count"`
+	ForwardCount int64 `bson:"forward_count"`
+	CollectCount int64 `bson:"collect_count"`
+	ViewCount    int64 `bson:"view_count"`
+	// 发现页排序, 计数变化时标记 DiscoverDirty, 由定时任务重新计算分数, 置顶与加权由管理员设置
+	DiscoverScore  float64 `bson:"discover_score"`
+	DiscoverBoost  float64 `bson:"discover_boost"`
+	DiscoverPinned int32   `bson:"discover_pinned"`
+	DiscoverDirty  bool    `bson:"discover_dirty"`
+	// 编辑次数与最后编辑时间, 未编辑过时为零值
+	EditCount int32     `bson:"edit_count"`
+	EditTime  time.Time `bson:"edit_time"`
+	// 软删除, 删除帖子时其评论一并删除, DeleteRootID 为用户删除的帖子ID, 恢复时整体恢复
+	Deleted      int32     `bson:"deleted"`
+	DeleteTime   time.Time `bson:"delete_time"`
+	DeleteRootID string    `bson:"delete_root_id"`
+	// 删除超过回收期后内容被清除, 只保留ID、作者与删除标记
+	Purged bool `bson:"purged"`
+	// 被管理员移除, 不进入作者的回收站, 不能恢复也不会被清除
+	Removed bool `bson:"removed"`
+	// 被管理员隐藏, 与删除相互独立
+	Hidden     int32     `bson:"hidden"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
 }
 
 type Post struct {
@@ -26,6 +59,7 @@ type Post struct {
 	ForwardPost    *Post        `bson:"forward_post"`
 	CommentPostID  string       `bson:"comment_post_id"`
 	CommentPost    *Post        `bson:"comment_post"`
+	RootPostID     string       `bson:"root_post_id"`
 	RefPostID      string       `bson:"ref_post_id"`
 	RefPost        *Post        `bson:"ref_post"`
 	UserID         string       `bson:"user_id"`
@@ -33,7 +67,16 @@ type Post struct {
 	AllowComment   int32        `bson:"allow_comment"`
 	AllowForward   int32        `bson:"allow_forward"`
 	AtUserIds      []string     `bson:"at_user_ids"`
+	Hashtags       []string     `bson:"hashtags"`
 	MediaMsgs      []*PostMedia `bson:"media_msgs"`
+	Visibility     int32        `bson:"visibility"`
+	VisibleUserIDs []string     `bson:"visible_user_ids"`
+	EditCount      int32        `bson:"edit_count"`
+	EditTime       time.Time    `bson:"edit_time"`
+	Deleted        int32        `bson:"deleted"`
+	DeleteTime     time.Time    `bson:"delete_time"`
+	DeleteRootID   string       `bson:"delete_root_id"`
+	Hidden         int32        `bson:"hidden"`
 	CreateTime     time.Time    `bson:"create_time"`
 	UpdateTime     time.Time    `bson:"update_time"`
 	IsLiked        int32        `bson:"is_liked"`
@@ -43,9 +86,34 @@ type Post struct {
 	CommentCount   int64        `bson:"comment_count"`
 	LikeCount      int64        `bson:"like_count"`
 	ForwardCount   int64        `bson:"forward_count"`
+	CollectCount   int64        `bson:"collect_count"`
+	ViewCount      int64        `bson:"view_count"`
 	UserInfo       *Attribute   `bson:"user_info"`
 	AtUserInfoList []*Attribute `bson:"at_user_info_list"`
-	IsPinned       int32        `bson:"is_pinned"`
+	IsPinned       int32        `bson:"is_pined"`
+	// 作者置顶的评论
+	PinnedCommentID string `bson:"pinned_comment_id"`
+	// 发现页排序
+	DiscoverScore  float64 `bson:"discover_score"`
+	DiscoverPinned int32   `bson:"discover_pinned"`
+}
+
+// 帖子计数字段
+const (
+	PostLikeCount    = "like_count"
+	PostCommentCount = "comment_count"
+	PostForwardCount = "forward_count"
+	PostCollectCount = "collect_count"
+	PostViewCount    = "view_count"
+)
+
+// PostSearch 帖子搜索条件, 为空的条件不参与过滤
+type PostSearch struct {
+	Keyword   string
+	Hashtag   string
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
 }
 
 type PostMedia struct {
@@ -95,20 +163,44 @@ type PostInterface interface {
 	Create(ctx context.Context, posts []*PostDB) error
 	// 通过帖子ID获取帖子
 	Take(ctx context.Context, postID string) (*Post, error)
+	// 通过帖子ID获取帖子, 包含已删除与被隐藏的帖子, 仅供管理员审核使用
+	TakeForModeration(ctx context.Context, postID string) (*Post, error)
+	// 通过帖子ID获取帖子原始数据, 不过滤可见范围, 仅供内部使用
+	TakeDB(ctx context.Context, postID string) (*PostDB, error)
+	// 通过帖子IDs获取帖子原始数据, 不过滤可见范围, 仅供内部使用
+	FindDB(ctx context.Context, postIDs []string) ([]*PostDB, error)
 	// 更新帖子
 	UpdateByMap(ctx context.Context, postID string, data map[string]any) error
+	// 修改评论帖子的可见范围
+	UpdateCommentVisibility(ctx context.Context, commentPostID string, visibility int32, visibleUserIDs []string) error
 	// 删除帖子
 	Delete(ctx context.Context, postIDs []string) error
+	// 获取未删除的评论帖子IDs
+	FindCommentPostIDs(ctx context.Context, postIDs []string) ([]string, error)
+	// 软删除帖子, rootID 为用户删除的帖子ID
+	SoftDelete(ctx context.Context, postIDs []string, rootID string, deleteTime time.Time) error
+	// 恢复同一次删除的帖子
+	Restore(ctx context.Context, rootID string) error
+	// 管理员移除帖子, 已删除的帖子移出回收站
+	Remove(ctx context.Context, postIDs []string, removeTime time.Time) error
+	// 获取删除时间早于 before 且内容未清除的帖子IDs
+	FindDeletedPostIDs(ctx context.Context, before time.Time, limit int64) ([]string, error)
+	// 清除已删除帖子的内容, 保留墓碑
+	Purge(ctx context.Context, postIDs []string) error
+	// 通过游标和用户ID获取用户删除的帖子
+	GetDeletedPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*Post, string, error)
 	// 通过转发的帖子ID获取帖子
 	GetPostByForwardPostID(ctx context.Context, userID, forwardPostID string) (*Post, error)
 	// 通过游标和用户IDs获取此ID后Count数的帖子
-	GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, userIDs []string, count int64) ([]*Post, string, error)
+	GetPostsByCursorAndUserIDs(ctx context.Context, cursor string, userIDs []string, count int64) ([]*Post, string, error)
 	// 通过游标和用户ID获取此ID后Count数的帖子
-	GetPostsByCursorAndUser(ctx context.Context, cursor int64, userID string, count int64) ([]*Post, string, error)
+	GetPostsByCursorAndUser(ctx context.Context, cursor string, userID string, count int64) ([]*Post, string, error)
 	// 通过游标和帖子IDs获取此ID后Count数的帖子
-	GetPostsByCursorAndPostIDs(ctx context.Context, cursor int64, postIDs []string, count int64) ([]*Post, string, error)
-	// 通过游标和帖子ID获取评论帖子
-	GetCommentPostsByPostID(ctx context.Context, cursor int64, postID string, count int64) ([]*Post, string, error)
+	GetPostsByCursorAndPostIDs(ctx context.Context, cursor string, postIDs []string, count int64) ([]*Post, string, error)
+	// 通过游标和帖子ID获取评论帖子, sort 为评论排序方式, 不返回 excludePostID
+	GetCommentPostsByPostID(ctx context.Context, cursor string, postID string, sort int32, excludePostID string, count int64) ([]*Post, string, error)
+	// 获取每条评论按 sort 排序的前 count 条回复的IDs
+	FindReplyPostIDs(ctx context.Context, commentPostIDs []string, sort int32, count int64) (map[string][]string, error)
 	// 获取自身评论的帖子，和被评论的帖子 IDs
 	GetCommentPostIDsByUser(ctx context.Context, userID string) ([]string, error)
 	// 获取关注用户的IDs
@@ -117,4 +209,38 @@ type PostInterface interface {
 	GetSubscriberUserIDs(ctx context.Context, userID string) ([]string, error)
 	// 获取置顶帖子
 	GetPinnedPostByUserID(ctx context.Context, userID string) (*Post, error)
+	// 增减帖子计数
+	IncrCount(ctx context.Context, postID string, field string, delta int64) error
+	// 覆盖帖子计数, 不修改更新时间
+	SetCounts(ctx context.Context, postID string, counts map[string]int64) error
+	// 按帖子ID顺序获取 postID 之后的帖子, 用于遍历
+	FindAfter(ctx context.Context, postID string, limit int64) ([]*PostDB, error)
+	// 统计帖子未删除的评论数
+	CountComments(ctx context.Context, postIDs []string) (map[string]int64, error)
+	// 统计用户未删除的帖子数, 不包含评论
+	CountUserPosts(ctx context.Context, userIDs []string) (map[string]int64, error)
+	// 获取用户最新的未删除帖子, 不包含评论, 只返回帖子ID与创建时间
+	FindTimeline(ctx context.Context, userIDs []string, limit int64) ([]*PostDB, error)
+	// 通过游标和话题标签获取帖子, 不包含评论
+	GetPostsByCursorAndHashtag(ctx context.Context, cursor string, hashtag string, count int64) ([]*Post, string, error)
+	// 搜索帖子, 有关键词时按相关度排序, 否则按时间倒序, 不包含评论
+	Search(ctx context.Context, search *PostSearch, pagination pagination.Pagination) (int64, []*Post, error)
+	// 通过游标获取发现页的帖子, 只包含公开且未删除的原创帖子
+	GetDiscoverPostsByCursor(ctx context.Context, cursor string, count int64) ([]*Post, string, error)
+	// 获取需要重新计算发现页分数的帖子
+	FindDiscoverDirty(ctx context.Context, limit int64) ([]*PostDB, error)
+	// 清除重新计算标记, 清除后的计数变化会再次标记
+	ClearDiscoverDirty(ctx context.Context, postIDs []string) error
+	// 标记创建时间晚于 since 的帖子重新计算发现页分数
+	MarkDiscoverDirty(ctx context.Context, since time.Time) error
+	// 更新发现页分数, 不修改更新时间
+	SetDiscoverScore(ctx context.Context, postID string, score float64) error
+	// 设置帖子在发现页的置顶与加权, 并标记重新计算分数
+	SetDiscover(ctx context.Context, postID string, pinned int32, boost float64) error
+	// 获取关注了该用户的用户IDs
+	GetFollowerUserIDs(ctx context.Context, userID string) ([]string, error)
+	// 获取 relatedUserIDs 中被该用户关注的用户IDs
+	FilterFollowedUserIDs(ctx context.Context, userID string, relatedUserIDs []string) ([]string, error)
+	// 获取 ownerUserIDs 中关注了该用户的用户IDs
+	FilterFollowerUserIDs(ctx context.Context, userID string, ownerUserIDs []string) ([]string, error)
 }
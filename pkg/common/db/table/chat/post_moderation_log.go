@@ -0,0 +1,32 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// PostModerationLog 管理员对帖子的审核记录, 只增不改
+type PostModerationLog struct {
+	LogID          string    `bson:"log_id"`
+	PostID         string    `bson:"post_id"`
+	AuthorUserID   string    `bson:"author_user_id"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	Action         int32     `bson:"action"`
+	Reason         string    `bson:"reason"`
+	ReportCount    int64     `bson:"report_count"` // 本次处理的待处理举报数
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+func (PostModerationLog) TableName() string {
+	return "post_moderation_logs"
+}
+
+type PostModerationLogInterface interface {
+	Create(ctx context.Context, log *PostModerationLog) error
+	// 获取帖子的全部审核记录, 按创建时间倒序
+	FindByPostID(ctx context.Context, postID string) ([]*PostModerationLog, error)
+	// 按创建时间倒序获取审核记录, 为空的条件不参与过滤
+	FindPage(ctx context.Context, postID string, authorUserID string, operatorUserID string, pagination pagination.Pagination) (int64, []*PostModerationLog, error)
+}
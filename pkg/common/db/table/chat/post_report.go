@@ -0,0 +1,47 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// PostReport 用户对帖子的举报, 同一用户对同一帖子只有一条待处理的举报
+type PostReport struct {
+	ReportID       string `bson:"report_id"`
+	PostID         string `bson:"post_id"`
+	AuthorUserID   string `bson:"author_user_id"`
+	ReporterUserID string `bson:"reporter_user_id"`
+	Reason         int32  `bson:"reason"`
+	Description    string `bson:"description"`
+	Status         int32  `bson:"status"`
+	// 处理举报的审核操作与管理员, 待处理时为空
+	Action         int32     `bson:"action"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	CreateTime     time.Time `bson:"create_time"`
+	HandleTime     time.Time `bson:"handle_time"`
+}
+
+func (PostReport) TableName() string {
+	return "post_reports"
+}
+
+// PostReportSearch 举报搜索条件, 为空的条件不参与过滤
+type PostReportSearch struct {
+	Status         *int32
+	PostID         string
+	ReporterUserID string
+	AuthorUserID   string
+}
+
+type PostReportInterface interface {
+	// 创建举报, 重复举报待处理的帖子时返回重复键错误
+	Create(ctx context.Context, report *PostReport) error
+	// 搜索举报, 按创建时间倒序
+	Search(ctx context.Context, search *PostReportSearch, pagination pagination.Pagination) (int64, []*PostReport, error)
+	// 获取帖子最新的 limit 条举报
+	FindByPostID(ctx context.Context, postID string, limit int64) ([]*PostReport, error)
+	// 处理帖子所有待处理的举报, 返回处理的举报数
+	Handle(ctx context.Context, postID string, status int32, action int32, operatorUserID string, handleTime time.Time) (int64, error)
+}
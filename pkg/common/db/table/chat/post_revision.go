@@ -0,0 +1,28 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// PostRevision 帖子被编辑前的内容, Version 从 1 开始递增
+type PostRevision struct {
+	PostID     string       `bson:"post_id"`
+	Version    int32        `bson:"version"`
+	Content    string       `bson:"content"`
+	AtUserIds  []string     `bson:"at_user_ids"`
+	MediaMsgs  []*PostMedia `bson:"media_msgs"`
+	CreateTime time.Time    `bson:"create_time"` // 该版本内容的发布或编辑时间
+	EditTime   time.Time    `bson:"edit_time"`   // 该版本内容被替换的时间
+}
+
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
+
+type PostRevisionInterface interface {
+	Create(ctx context.Context, revisions []*PostRevision) error
+	// 获取帖子的历史版本, 按版本倒序
+	Find(ctx context.Context, postID string) ([]*PostRevision, error)
+	Delete(ctx context.Context, postIDs []string) error
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+type RecoveryGuardian struct {
+	UserID          string    `bson:"user_id"`
+	GuardianUserIDs []string  `bson:"guardian_user_ids"`
+	Threshold       int32     `bson:"threshold"`
+	UpdateTime      time.Time `bson:"update_time"`
+}
+
+func (RecoveryGuardian) TableName() string {
+	return "recovery_guardians"
+}
+
+type RecoveryApproval struct {
+	GuardianUserID string    `bson:"guardian_user_id"`
+	Address        string    `bson:"address"`
+	ApproveTime    time.Time `bson:"approve_time"`
+}
+
+type RecoveryRequest struct {
+	RecoveryID      string              `bson:"recovery_id"`
+	UserID          string              `bson:"user_id"`
+	NewAddress      string              `bson:"new_address"`
+	NewPublicKey    string              `bson:"new_public_key"`
+	Mode            int32               `bson:"mode"`
+	Status          int32               `bson:"status"`
+	GuardianUserIDs []string            `bson:"guardian_user_ids"`
+	Threshold       int32               `bson:"threshold"`
+	Approvals       []*RecoveryApproval `bson:"approvals"`
+	OperatorUserID  string              `bson:"operator_user_id"`
+	Reason          string              `bson:"reason"`
+	CreateTime      time.Time           `bson:"create_time"`
+	ExecuteAfter    time.Time           `bson:"execute_after"`
+	ExpireTime      time.Time           `bson:"expire_time"`
+	ExecuteTime     time.Time           `bson:"execute_time"`
+}
+
+func (RecoveryRequest) TableName() string {
+	return "recovery_requests"
+}
+
+type RecoveryGuardianInterface interface {
+	Set(ctx context.Context, guardian *RecoveryGuardian) error
+	Take(ctx context.Context, userID string) (*RecoveryGuardian, error)
+}
+
+type RecoveryRequestInterface interface {
+	Create(ctx context.Context, request *RecoveryRequest) error
+	Take(ctx context.Context, recoveryID string) (*RecoveryRequest, error)
+	// AddApproval records a guardian approval on a pending request, at most once per guardian, and returns the updated request.
+	AddApproval(ctx context.Context, recoveryID string, approval *RecoveryApproval) (*RecoveryRequest, error)
+	// UpdateStatus moves a pending request to status, failing with not found if it is no longer pending.
+	UpdateStatus(ctx context.Context, recoveryID string, status int32, data map[string]any) error
+}
@@ -0,0 +1,155 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// RedPacket is a packet sent in a chat, its shares are drawn up front and popped from RemainAmounts as users claim them.
+type RedPacket struct {
+	RedPacketID    string    `bson:"red_packet_id"`
+	SendUserID     string    `bson:"send_user_id"`
+	Type           string    `bson:"type"`
+	GroupID        string    `bson:"group_id"`
+	ReceiveUserID  string    `bson:"receive_user_id"`
+	TotalAmount    int64     `bson:"total_amount"`
+	TotalCount     int32     `bson:"total_count"`
+	RemainAmounts  []int64   `bson:"remain_amounts"`
+	ClaimedUserIDs []string  `bson:"claimed_user_ids"`
+	Settlement     string    `bson:"settlement"`
+	Status         int32     `bson:"status"`
+	Remark         string    `bson:"remark"`
+	Emoji          string    `bson:"emoji"`
+	CreateTime     time.Time `bson:"create_time"`
+	ExpireTime     time.Time `bson:"expire_time"`
+	RefundAmount   int64     `bson:"refund_amount"`
+	RefundTime     time.Time `bson:"refund_time"`
+	// Notified is set once the sender has been told about the refund.
+	Notified bool `bson:"notified"`
+	// Unsettled is set on a packet settled over HTTP until the payment backend took the money from the sender,
+	// an unsettled packet can not be claimed or refunded.
+	Unsettled bool `bson:"unsettled"`
+}
+
+func (RedPacket) TableName() string {
+	return "red_packets"
+}
+
+type RedPacketClaim struct {
+	RedPacketID string    `bson:"red_packet_id"`
+	UserID      string    `bson:"user_id"`
+	Amount      int64     `bson:"amount"`
+	ClaimTime   time.Time `bson:"claim_time"`
+}
+
+func (RedPacketClaim) TableName() string {
+	return "red_packet_claims"
+}
+
+type RedPacketBalance struct {
+	UserID     string    `bson:"user_id"`
+	Balance    int64     `bson:"balance"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+func (RedPacketBalance) TableName() string {
+	return "red_packet_balances"
+}
+
+// RedPacketCallback records a red packet message callback that took effect, keyed by the message clientMsgID
+// and the operation, so that a retried callback replays its outcome instead of applying it twice.
+type RedPacketCallback struct {
+	ClientMsgID string    `bson:"client_msg_id"`
+	Operation   string    `bson:"operation"`
+	UserID      string    `bson:"user_id"`
+	RedPacketID string    `bson:"red_packet_id"`
+	Amount      int64     `bson:"amount"`
+	CreateTime  time.Time `bson:"create_time"`
+}
+
+func (RedPacketCallback) TableName() string {
+	return "red_packet_callbacks"
+}
+
+// RedPacketSettlement is a money movement of a packet settled over HTTP. It is recorded with the ledger change
+// and sent to the payment backend after the transaction commits, SettlementID is the idempotency key of the backend.
+type RedPacketSettlement struct {
+	SettlementID string `bson:"settlement_id"`
+	Operation    string `bson:"operation"`
+	UserID       string `bson:"user_id"`
+	RedPacketID  string `bson:"red_packet_id"`
+	ClientMsgID  string `bson:"client_msg_id"`
+	Amount       int64  `bson:"amount"`
+	// Params is the send request of the packet.
+	Params     map[string]any `bson:"params"`
+	Status     int32          `bson:"status"`
+	Attempts   int32          `bson:"attempts"`
+	LastError  string         `bson:"last_error"`
+	CreateTime time.Time      `bson:"create_time"`
+	UpdateTime time.Time      `bson:"update_time"`
+}
+
+func (RedPacketSettlement) TableName() string {
+	return "red_packet_settlements"
+}
+
+type RedPacketInterface interface {
+	Create(ctx context.Context, packet *RedPacket) error
+	Take(ctx context.Context, redPacketID string) (*RedPacket, error)
+	// Claim pops one share of a pending, unexpired packet for a user who has not claimed it yet.
+	// It returns the packet as it was before the claim, so the last of its RemainAmounts is the claimed share.
+	Claim(ctx context.Context, redPacketID string, userID string, now time.Time) (*RedPacket, error)
+	// Refund moves a pending packet with unclaimed shares to refunded and returns it with RefundAmount set.
+	Refund(ctx context.Context, redPacketID string, now time.Time) (*RedPacket, error)
+	// FindExpired returns pending packets with unclaimed shares that expired before now.
+	FindExpired(ctx context.Context, now time.Time, limit int64) ([]*RedPacket, error)
+	// FindUnnotified returns refunded packets whose sender has not been notified yet.
+	FindUnnotified(ctx context.Context, limit int64) ([]*RedPacket, error)
+	// SetNotified changes the notified flag, it fails with not found when the flag already has that value.
+	SetNotified(ctx context.Context, redPacketID string, notified bool) error
+	// SetSettled marks a packet settled once the payment backend took the money from the sender.
+	SetSettled(ctx context.Context, redPacketID string) error
+}
+
+type RedPacketClaimInterface interface {
+	Create(ctx context.Context, claim *RedPacketClaim) error
+	Find(ctx context.Context, redPacketID string) ([]*RedPacketClaim, error)
+}
+
+type RedPacketBalanceInterface interface {
+	Take(ctx context.Context, userID string) (*RedPacketBalance, error)
+	Incr(ctx context.Context, userID string, amount int64) error
+	// Decr fails with not found when the balance is lower than amount.
+	Decr(ctx context.Context, userID string, amount int64) error
+}
+
+type RedPacketCallbackInterface interface {
+	Create(ctx context.Context, callback *RedPacketCallback) error
+	Take(ctx context.Context, clientMsgID string, operation string) (*RedPacketCallback, error)
+	Find(ctx context.Context, clientMsgID string) ([]*RedPacketCallback, error)
+}
+
+type RedPacketSettlementInterface interface {
+	Create(ctx context.Context, settlement *RedPacketSettlement) error
+	Take(ctx context.Context, settlementID string) (*RedPacketSettlement, error)
+	// Complete moves a pending settlement to done, it fails with not found when the settlement is not pending.
+	Complete(ctx context.Context, settlementID string, now time.Time) error
+	// Fail records a failed attempt of a pending settlement.
+	Fail(ctx context.Context, settlementID string, reason string, now time.Time) error
+	// FindPending returns the pending receive and refund settlements created before before that have been tried fewer than maxAttempts times.
+	FindPending(ctx context.Context, before time.Time, maxAttempts int32, limit int64) ([]*RedPacketSettlement, error)
+}
@@ -0,0 +1,25 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// Topic 话题, PostCount 为带有该话题标签且未删除的帖子数, 不包含评论
+type Topic struct {
+	Name       string    `bson:"name"`
+	PostCount  int64     `bson:"post_count"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+func (Topic) TableName() string {
+	return "topics"
+}
+
+type TopicInterface interface {
+	// 增减话题的帖子数, 不存在时创建
+	Incr(ctx context.Context, names []string, delta int64) error
+	// 通过话题名获取话题
+	Take(ctx context.Context, name string) (*Topic, error)
+}
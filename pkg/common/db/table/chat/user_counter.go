@@ -0,0 +1,38 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// UserCounter 用户的帖子与关注计数
+type UserCounter struct {
+	UserID         string    `bson:"user_id"`
+	PostCount      int64     `bson:"post_count"`
+	FollowerCount  int64     `bson:"follower_count"`
+	FollowingCount int64     `bson:"following_count"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
+
+func (UserCounter) TableName() string {
+	return "user_counters"
+}
+
+// 用户计数字段
+const (
+	UserPostCount      = "post_count"
+	UserFollowerCount  = "follower_count"
+	UserFollowingCount = "following_count"
+)
+
+type UserCounterInterface interface {
+	Find(ctx context.Context, userIDs []string) ([]*UserCounter, error)
+	// 增减用户计数, 不存在时创建
+	Incr(ctx context.Context, userID string, field string, delta int64) error
+	// 覆盖用户计数, 不存在时创建
+	Set(ctx context.Context, counter *UserCounter) error
+	// 获取粉丝数大于 count 的用户IDs
+	FindUserIDsByFollowerCount(ctx context.Context, count int64) ([]string, error)
+	// 统计用户的粉丝数与关注数
+	CountFollows(ctx context.Context, userIDs []string) (followers map[string]int64, following map[string]int64, err error)
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+type UserKey struct {
+	UserID        string    `bson:"user_id"`
+	Address       string    `bson:"address"`
+	PublicKey     string    `bson:"public_key"`
+	Name          string    `bson:"name"`
+	DeviceID      string    `bson:"device_id"`
+	Platform      string    `bson:"platform"`
+	Status        int32     `bson:"status"`
+	CreateTime    time.Time `bson:"create_time"`
+	RevokeTime    time.Time `bson:"revoke_time"`
+	LastLoginTime time.Time `bson:"last_login_time"`
+}
+
+func (UserKey) TableName() string {
+	return "user_keys"
+}
+
+type UserKeyInterface interface {
+	// Save inserts the key, or replaces a previously revoked key with the same address.
+	Save(ctx context.Context, key *UserKey) error
+	TakeByAddress(ctx context.Context, address string) (*UserKey, error)
+	FindByUserID(ctx context.Context, userID string) ([]*UserKey, error)
+	Revoke(ctx context.Context, userID string, address string) error
+	RevokeAll(ctx context.Context, userID string) error
+	UpdateLoginTime(ctx context.Context, address string, loginTime time.Time) error
+}
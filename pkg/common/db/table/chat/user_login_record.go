@@ -25,6 +25,7 @@ type UserLoginRecord struct {
 	IP        string    `bson:"ip"`
 	DeviceID  string    `bson:"device_id"`
 	Platform  string    `bson:"platform"`
+	Address   string    `bson:"address"`
 }
 
 func (UserLoginRecord) TableName() string {
@@ -25,6 +25,9 @@ type UserPostRelationInterface interface {
 	Take(ctx context.Context, userID, postID string) (*UserPostRelation, error)
 	UpdateByMap(ctx context.Context, userID, postID string, args map[string]any) error
 	Delete(ctx context.Context, userID, postID string) error
+	DeleteByPostIDs(ctx context.Context, postIDs []string) error
+	// 统计帖子关系字段为 1 的用户数
+	CountByPostIDs(ctx context.Context, postIDs []string, field string) (map[string]int64, error)
 	GetLikeCount(ctx context.Context, postID string) (int64, error)
 	GetCollectCount(ctx context.Context, postID string) (int64, error)
 	GetForwardCount(ctx context.Context, postID string) (int64, error)
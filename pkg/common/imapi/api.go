@@ -33,9 +33,12 @@ var (
 	registerUser        = NewApiCaller[user.UserRegisterReq, user.UserRegisterResp]("/user/user_register")
 	forceOffLine        = NewApiCaller[auth.ForceLogoutReq, auth.ForceLogoutResp]("/auth/force_logout")
 	getGroupsInfo       = NewApiCaller[group.GetGroupsInfoReq, group.GetGroupsInfoResp]("/group/get_groups_info")
+	getGroupMembersInfo = NewApiCaller[group.GetGroupMembersInfoReq, group.GetGroupMembersInfoResp]("/group/get_group_members_info")
 	registerUserCount   = NewApiCaller[user.UserRegisterCountReq, user.UserRegisterCountResp]("/statistics/user/register")
 	friendUserIDs       = NewApiCaller[friend.GetFriendIDsReq, friend.GetFriendIDsResp]("/friend/get_friend_id")
 	accountCheck        = NewApiCaller[user.AccountCheckReq, user.AccountCheckResp]("/user/account_check")
 	allUserOnlineStatus = NewApiCaller[msggateway.GetUsersOnlineStatusReq, []msggateway.GetUsersOnlineStatusResp_SuccessResult]("/user/get_users_online_status")
 	usersOnlineTime     = NewApiCaller[chat.GetUsersTimeReq, chat.GetUsersTimeResp]("/user/get_users_time")
+	sendMsg             = NewApiCaller[SendMsgReq, SendMsgResp]("/msg/send_msg")
+	isBlack             = NewApiCaller[friend.IsBlackReq, friend.IsBlackResp]("/friend/is_black")
 )
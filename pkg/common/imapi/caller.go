@@ -41,11 +41,16 @@ type CallerInterface interface {
 	ForceOffLine(ctx context.Context, userID string) error
 	RegisterUser(ctx context.Context, users []*sdkwss.UserInfo) error
 	FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkwss.GroupInfo, error)
+	// FindGroupMemberUserIDs returns which of userIDs are members of the group.
+	FindGroupMemberUserIDs(ctx context.Context, groupID string, userIDs []string) ([]string, error)
 	UserRegisterCount(ctx context.Context, start int64, end int64) (map[string]int64, int64, error)
 	FriendUserIDs(ctx context.Context, userID string) ([]string, error)
 	AccountCheckSingle(ctx context.Context, userID string) (bool, error)
 	UserOlineStatus(ctx context.Context, userIDs []string) ([]msggateway.GetUsersOnlineStatusResp_SuccessResult, error)
 	UserOlineTimes(ctx context.Context, userIDs []string) (*chatpb.GetUsersTimeResp, error)
+	SendMsg(ctx context.Context, req *SendMsgReq) (*SendMsgResp, error)
+	// IsBlack returns whether blackUserID is in the blacklist of ownerUserID.
+	IsBlack(ctx context.Context, ownerUserID string, blackUserID string) (bool, error)
 }
 
 type Caller struct {
@@ -166,6 +171,21 @@ func (c *Caller) FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws
 	return resp.GroupInfos, nil
 }
 
+func (c *Caller) FindGroupMemberUserIDs(ctx context.Context, groupID string, userIDs []string) ([]string, error) {
+	resp, err := getGroupMembersInfo.Call(ctx, c.imApi, &group.GetGroupMembersInfoReq{
+		GroupID: groupID,
+		UserIDs: userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	memberUserIDs := make([]string, 0, len(resp.Members))
+	for _, member := range resp.Members {
+		memberUserIDs = append(memberUserIDs, member.UserID)
+	}
+	return memberUserIDs, nil
+}
+
 func (c *Caller) UserRegisterCount(ctx context.Context, start int64, end int64) (map[string]int64, int64, error) {
 	resp, err := registerUserCount.Call(ctx, c.imApi, &user.UserRegisterCountReq{
 		Start: start,
@@ -212,3 +232,18 @@ func (c *Caller) UserOlineTimes(ctx context.Context, userIDs []string) (*chatpb.
 	}
 	return resp, nil
 }
+
+func (c *Caller) SendMsg(ctx context.Context, req *SendMsgReq) (*SendMsgResp, error) {
+	return sendMsg.Call(ctx, c.imApi, req)
+}
+
+func (c *Caller) IsBlack(ctx context.Context, ownerUserID string, blackUserID string) (bool, error) {
+	resp, err := isBlack.Call(ctx, c.imApi, &friend.IsBlackReq{
+		UserID1: ownerUserID,
+		UserID2: blackUserID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.InUser1Blacks, nil
+}
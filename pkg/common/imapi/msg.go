@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imapi
+
+// SendMsgReq is the body of the OpenIM send_msg api, sent with an admin token.
+type SendMsgReq struct {
+	SendID           string         `json:"sendID"`
+	RecvID           string         `json:"recvID"`
+	GroupID          string         `json:"groupID"`
+	SenderNickname   string         `json:"senderNickname"`
+	SenderFaceURL    string         `json:"senderFaceURL"`
+	SenderPlatformID int32          `json:"senderPlatformID"`
+	Content          map[string]any `json:"content"`
+	ContentType      int32          `json:"contentType"`
+	SessionType      int32          `json:"sessionType"`
+	IsOnlineOnly     bool           `json:"isOnlineOnly"`
+	NotOfflinePush   bool           `json:"notOfflinePush"`
+	SendTime         int64          `json:"sendTime"`
+	Ex               string         `json:"ex"`
+}
+
+type SendMsgResp struct {
+	ServerMsgID string `json:"serverMsgID"`
+	ClientMsgID string `json:"clientMsgID"`
+	SendTime    int64  `json:"sendTime"`
+}
@@ -35,8 +35,9 @@ type claims struct {
 }
 
 type Token struct {
-	Expires time.Duration
-	Secret  string
+	Expires       time.Duration
+	AccessExpires time.Duration
+	Secret        string
 }
 
 func (t *Token) secret() jwt.Keyfunc {
@@ -45,13 +46,13 @@ func (t *Token) secret() jwt.Keyfunc {
 	}
 }
 
-func (t *Token) buildClaims(userID string, userType int32) claims {
+func (t *Token) buildClaims(userID string, userType int32, expires time.Duration) claims {
 	now := time.Now()
 	return claims{
 		UserID:   userID,
 		UserType: userType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(t.Expires)),    // Expiration time
+			ExpiresAt: jwt.NewNumericDate(now.Add(expires)),      // Expiration time
 			IssuedAt:  jwt.NewNumericDate(now),                   // Issuing time
 			NotBefore: jwt.NewNumericDate(now.Add(-time.Minute)), // Begin Effective time
 		},
@@ -87,10 +88,19 @@ func (t *Token) getToken(str string) (string, int32, error) {
 }
 
 func (t *Token) CreateToken(UserID string, userType int32) (string, error) {
+	return t.createToken(UserID, userType, t.Expires)
+}
+
+// CreateAccessToken issues a short-lived token that is renewed with a refresh token.
+func (t *Token) CreateAccessToken(userID string, userType int32) (string, error) {
+	return t.createToken(userID, userType, t.AccessExpires)
+}
+
+func (t *Token) createToken(UserID string, userType int32, expires time.Duration) (string, error) {
 	if !(userType == TokenUser || userType == TokenAdmin) {
 		return "", errs.ErrTokenUnknown.WrapMsg("token type unknown")
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, t.buildClaims(UserID, userType))
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, t.buildClaims(UserID, userType, expires))
 	str, err := token.SignedString([]byte(t.Secret))
 	if err != nil {
 		return "", errs.Wrap(err)
@@ -98,6 +108,16 @@ func (t *Token) CreateToken(UserID string, userType int32) (string, error) {
 	return str, nil
 }
 
+// IsExpired reports whether the token has expired without verifying its signature,
+// it is only meant for cleaning up cached tokens.
+func IsExpired(token string, now time.Time) bool {
+	var c claims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &c); err != nil {
+		return false
+	}
+	return c.ExpiresAt != nil && c.ExpiresAt.Before(now)
+}
+
 func (t *Token) GetToken(token string) (string, int32, error) {
 	userID, userType, err := t.getToken(token)
 	if err != nil {
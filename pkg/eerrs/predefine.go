@@ -34,6 +34,47 @@ var (
 	ErrEmailAlreadyRegister     = errs.NewCodeError(20014, "EmailAlreadyRegister")
 
 	ErrTokenNotExist = errs.NewCodeError(20101, "ErrTokenNotExist")
+	ErrTokenKicked   = errs.NewCodeError(20102, "TokenKicked")
 
 	ErrAccountLockChange = errs.NewCodeError(20015, "No more than 3 days since last modification")
+
+	ErrNonceNotFound  = errs.NewCodeError(20016, "NonceNotFound")
+	ErrNonceExpired   = errs.NewCodeError(20017, "NonceExpired")
+	ErrNonceUsed      = errs.NewCodeError(20018, "NonceUsed")
+	ErrSignerMismatch = errs.NewCodeError(20019, "SignerMismatch")
+
+	ErrUserKeyAlreadyBound = errs.NewCodeError(20020, "UserKeyAlreadyBound")
+	ErrUserKeyNotFound     = errs.NewCodeError(20021, "UserKeyNotFound")
+	ErrUserKeyRevoked      = errs.NewCodeError(20022, "UserKeyRevoked")
+
+	ErrRecoveryNotFound   = errs.NewCodeError(20023, "RecoveryNotFound")
+	ErrRecoveryNotPending = errs.NewCodeError(20024, "RecoveryNotPending")
+	ErrRecoveryExpired    = errs.NewCodeError(20025, "RecoveryExpired")
+	ErrRecoveryTimeLocked = errs.NewCodeError(20026, "RecoveryTimeLocked")
+	ErrGuardianNotSet     = errs.NewCodeError(20027, "GuardianNotSet")
+	ErrGuardianNotAllowed = errs.NewCodeError(20028, "GuardianNotAllowed")
+
+	ErrTooManyRequests = errs.NewCodeError(20029, "TooManyRequests")
+
+	ErrRedPacketExpired    = errs.NewCodeError(20030, "RedPacketExpired")
+	ErrRedPacketEmpty      = errs.NewCodeError(20031, "RedPacketEmpty")
+	ErrRedPacketClaimed    = errs.NewCodeError(20032, "RedPacketClaimed")
+	ErrRedPacketNotAllowed = errs.NewCodeError(20033, "RedPacketNotAllowed")
+	ErrInsufficientBalance = errs.NewCodeError(20034, "InsufficientBalance")
+	ErrRedPacketPayload    = errs.NewCodeError(20035, "RedPacketPayloadInvalid")
+	ErrRedPacketAmount     = errs.NewCodeError(20036, "RedPacketAmountInvalid")
+	ErrRedPacketCount      = errs.NewCodeError(20037, "RedPacketCountInvalid")
+	ErrNotInGroup          = errs.NewCodeError(20038, "NotInGroup")
+
+	ErrCallbackTimeout = errs.NewCodeError(20039, "CallbackTimeout")
+
+	ErrPostEditExpired    = errs.NewCodeError(20040, "PostEditExpired")
+	ErrPostRestoreExpired = errs.NewCodeError(20041, "PostRestoreExpired")
+
+	ErrPostCommentNotAllowed     = errs.NewCodeError(20042, "PostCommentNotAllowed")
+	ErrPostForwardNotAllowed     = errs.NewCodeError(20043, "PostForwardNotAllowed")
+	ErrPostInteractionRestricted = errs.NewCodeError(20044, "PostInteractionRestricted")
+	ErrBlockedByPostAuthor       = errs.NewCodeError(20045, "BlockedByPostAuthor")
+
+	ErrPostAlreadyReported = errs.NewCodeError(20046, "PostAlreadyReported")
 )
@@ -387,3 +387,27 @@ func (x *SearchAdminAccountReq) Check() error {
 	}
 	return nil
 }
+
+func (x *StartUserRecoveryReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if x.NewAddress == "" {
+		return errs.ErrArgs.WrapMsg("newAddress is empty")
+	}
+	return nil
+}
+
+func (x *RefreshTokenReq) Check() error {
+	if x.RefreshToken == "" {
+		return errs.ErrArgs.WrapMsg("refreshToken is empty")
+	}
+	return nil
+}
+
+func (x *RevokeUserSessionReq) Check() error {
+	if x.SessionID == "" {
+		return errs.ErrArgs.WrapMsg("sessionID is empty")
+	}
+	return nil
+}
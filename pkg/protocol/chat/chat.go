@@ -17,10 +17,12 @@ package chat
 import (
 	"regexp"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
 	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 func (x *FindUserPublicInfoReq) Check() error {
@@ -114,6 +116,12 @@ func (x *RegisterUserReq) Check() error {
 }
 
 func (x *LoginReq) Check() error {
+	if x.Address == "" {
+		return errs.ErrArgs.WrapMsg("address is empty")
+	}
+	if x.Signature == "" {
+		return errs.ErrArgs.WrapMsg("signature is empty")
+	}
 	if x.Platform < constantpb.IOSPlatformID || x.Platform > constantpb.AdminPlatformID {
 		return errs.ErrArgs.WrapMsg("platform is invalid")
 	}
@@ -265,6 +273,28 @@ func (x *PublishPostReq) Check() error {
 	if x.AllowForward < 0 || x.AllowForward > 1 {
 		return errs.ErrArgs.WrapMsg("allowForward is invalid")
 	}
+	return checkPostVisibility(x.Visibility, x.VisibleUserIDs)
+}
+
+func checkPostVisibility(visibility int32, visibleUserIDs []string) error {
+	switch visibility {
+	case constant.PostVisibilityPublic, constant.PostVisibilityFollowers, constant.PostVisibilityFriends, constant.PostVisibilityPrivate:
+		if len(visibleUserIDs) > 0 {
+			return errs.ErrArgs.WrapMsg("visibleUserIDs only apply to custom visibility")
+		}
+	case constant.PostVisibilityCustom:
+		if len(visibleUserIDs) == 0 {
+			return errs.ErrArgs.WrapMsg("visibleUserIDs is empty")
+		}
+		if len(visibleUserIDs) > constant.MaxPostVisibleUsers {
+			return errs.ErrArgs.WrapMsg("visibleUserIDs is too long")
+		}
+		if datautil.Duplicate(visibleUserIDs) {
+			return errs.ErrArgs.WrapMsg("visibleUserIDs is duplicate")
+		}
+	default:
+		return errs.ErrArgs.WrapMsg("visibility is invalid")
+	}
 	return nil
 }
 
@@ -289,6 +319,23 @@ func (x *DeletePostReq) Check() error {
 	return nil
 }
 
+func (x *GetUserCountersReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("userIDs is empty")
+	}
+	if len(x.UserIDs) > 100 {
+		return errs.ErrArgs.WrapMsg("userIDs is too long")
+	}
+	return nil
+}
+
+func (x *RestorePostReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	return nil
+}
+
 func (x *ChangeAllowCommentPostReq) Check() error {
 	if x.PostID == "" {
 		return errs.ErrArgs.WrapMsg("postID is empty")
@@ -303,6 +350,30 @@ func (x *ChangeAllowForwardPostReq) Check() error {
 	return nil
 }
 
+func (x *EditPostReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	if x.Content.GetValue() == "" && len(x.MediaMsgs) == 0 {
+		return errs.ErrArgs.WrapMsg("content is empty")
+	}
+	return nil
+}
+
+func (x *GetPostRevisionsReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	return nil
+}
+
+func (x *ChangePostVisibilityReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	return checkPostVisibility(x.Visibility, x.VisibleUserIDs)
+}
+
 func (x *LikePostReq) Check() error {
 	if x.PostID == "" {
 		return errs.ErrArgs.WrapMsg("postID is empty")
@@ -324,6 +395,135 @@ func (x *ForwardPostReq) Check() error {
 	return nil
 }
 
+func (x *GetCommentPostListByPostIDReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	if x.Sort < constant.CommentSortNewest || x.Sort > constant.CommentSortTop {
+		return errs.ErrArgs.WrapMsg("invalid sort")
+	}
+	if x.Depth < 0 || x.Depth > constant.MaxCommentReplyDepth {
+		return errs.ErrArgs.WrapMsg("invalid depth")
+	}
+	if x.ReplyCount < 0 || x.ReplyCount > constant.MaxCommentReplyCount {
+		return errs.ErrArgs.WrapMsg("invalid replyCount")
+	}
+	return nil
+}
+
+func (x *PinCommentReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	if x.CommentPostID == "" {
+		return errs.ErrArgs.WrapMsg("commentPostID is empty")
+	}
+	return nil
+}
+
+func (x *GetNotificationsReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SearchPostsReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.StartTime < 0 || x.EndTime < 0 || (x.EndTime > 0 && x.StartTime >= x.EndTime) {
+		return errs.ErrArgs.WrapMsg("time range is invalid")
+	}
+	return nil
+}
+
+func (x *SetDiscoverConfigReq) Check() error {
+	if x.Config == nil {
+		return errs.ErrArgs.WrapMsg("config is empty")
+	}
+	if x.Config.LikeWeight < 0 || x.Config.CommentWeight < 0 || x.Config.ForwardWeight < 0 || x.Config.ViewWeight < 0 {
+		return errs.ErrArgs.WrapMsg("weight can not be negative")
+	}
+	if x.Config.DecayHours <= 0 {
+		return errs.ErrArgs.WrapMsg("decayHours must be positive")
+	}
+	return nil
+}
+
+func (x *SetPostDiscoverReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	if x.Pinned != constant.UnPinned && x.Pinned != constant.Pinned {
+		return errs.ErrArgs.WrapMsg("pinned is invalid")
+	}
+	return nil
+}
+
+func (x *ReportPostReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	if x.Reason < constant.PostReportSpam || x.Reason > constant.PostReportOther {
+		return errs.ErrArgs.WrapMsg("reason is invalid")
+	}
+	if utf8.RuneCountInString(x.Description) > constant.MaxPostReportDescription {
+		return errs.ErrArgs.WrapMsg("description is too long")
+	}
+	return nil
+}
+
+func (x *SearchPostReportsReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *GetReportedPostReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	return nil
+}
+
+func (x *ModeratePostReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	if x.Action < constant.PostModerationDismiss || x.Action > constant.PostModerationBan {
+		return errs.ErrArgs.WrapMsg("action is invalid")
+	}
+	return nil
+}
+
+func (x *SearchPostModerationLogsReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *GetTopicFeedReq) Check() error {
+	if x.Hashtag == "" {
+		return errs.ErrArgs.WrapMsg("hashtag is empty")
+	}
+	return nil
+}
+
 func (x *CommentPostReq) Check() error {
 	if x.CommentPostID == "" {
 		return errs.ErrArgs.WrapMsg("commentPostID is empty")
@@ -335,7 +535,7 @@ func (x *ReferencePostReq) Check() error {
 	if x.RefPostID == "" {
 		return errs.ErrArgs.WrapMsg("refPostID is empty")
 	}
-	return nil
+	return checkPostVisibility(x.Visibility, x.VisibleUserIDs)
 }
 
 func (x *CheckVersionReq) Check() error {
@@ -344,3 +544,60 @@ func (x *CheckVersionReq) Check() error {
 	}
 	return nil
 }
+
+func (x *InitiateRecoveryReq) Check() error {
+	if x.Address == "" {
+		return errs.ErrArgs.WrapMsg("address is empty")
+	}
+	if x.NewAddress == "" {
+		return errs.ErrArgs.WrapMsg("newAddress is empty")
+	}
+	if x.Signature == "" {
+		return errs.ErrArgs.WrapMsg("signature is empty")
+	}
+	return nil
+}
+
+func (x *ApproveRecoveryReq) Check() error {
+	if x.RecoveryID == "" {
+		return errs.ErrArgs.WrapMsg("recoveryID is empty")
+	}
+	if x.Signature == "" {
+		return errs.ErrArgs.WrapMsg("signature is empty")
+	}
+	return nil
+}
+
+func (x *StartAdminRecoveryReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if x.NewAddress == "" {
+		return errs.ErrArgs.WrapMsg("newAddress is empty")
+	}
+	return nil
+}
+
+func (x *GetRedPacketReq) Check() error {
+	if x.RedPacketID == "" {
+		return errs.ErrArgs.WrapMsg("redPacketID is empty")
+	}
+	return nil
+}
+
+func (x *AdjustRedPacketBalanceReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if x.Amount == "" {
+		return errs.ErrArgs.WrapMsg("amount is empty")
+	}
+	return nil
+}
+
+func (x *FindRedPacketCallbacksReq) Check() error {
+	if x.ClientMsgID == "" {
+		return errs.ErrArgs.WrapMsg("clientMsgID is empty")
+	}
+	return nil
+}
@@ -277,6 +277,7 @@ type UpdateUserInfoReq struct {
 	AllowVibration   *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=allowVibration,proto3" json:"allowVibration"`
 	GlobalRecvMsgOpt *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=globalRecvMsgOpt,proto3" json:"globalRecvMsgOpt"`
 	RegisterType     *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=RegisterType,proto3" json:"RegisterType"`
+	PostInteraction  *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=postInteraction,proto3" json:"postInteraction"`
 }
 
 func (x *UpdateUserInfoReq) Reset() {
@@ -388,6 +389,13 @@ func (x *UpdateUserInfoReq) GetRegisterType() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *UpdateUserInfoReq) GetPostInteraction() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PostInteraction
+	}
+	return nil
+}
+
 type UpdateUserInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1161,6 +1169,8 @@ type ChallengeNonceReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+	Ip        string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
 }
 
 func (x *ChallengeNonceReq) Reset() {
@@ -1202,12 +1212,29 @@ func (x *ChallengeNonceReq) GetPublicKey() string {
 	return ""
 }
 
+func (x *ChallengeNonceReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ChallengeNonceReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
 type ChallengeNonceResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce"`
+	Nonce      string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce"`
+	ExpireTime int64  `protobuf:"varint,2,opt,name=expireTime,proto3" json:"expireTime"`
+	// EIP-4361 message the wallet signs with personal_sign
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
 }
 
 func (x *ChallengeNonceResp) Reset() {
@@ -1249,6 +1276,20 @@ func (x *ChallengeNonceResp) GetNonce() string {
 	return ""
 }
 
+func (x *ChallengeNonceResp) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *ChallengeNonceResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type RegisterUserInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1444,8 +1485,11 @@ type RegisterUserResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	ChatToken string `protobuf:"bytes,3,opt,name=chatToken,proto3" json:"chatToken"`
+	UserID            string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ChatToken         string `protobuf:"bytes,3,opt,name=chatToken,proto3" json:"chatToken"`
+	RefreshToken      string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
+	ExpireTime        int64  `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime"`
+	RefreshExpireTime int64  `protobuf:"varint,6,opt,name=refreshExpireTime,proto3" json:"refreshExpireTime"`
 }
 
 func (x *RegisterUserResp) Reset() {
@@ -1494,6 +1538,27 @@ func (x *RegisterUserResp) GetChatToken() string {
 	return ""
 }
 
+func (x *RegisterUserResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RegisterUserResp) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *RegisterUserResp) GetRefreshExpireTime() int64 {
+	if x != nil {
+		return x.RefreshExpireTime
+	}
+	return 0
+}
+
 type AddUserAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1698,20 +1763,25 @@ func (x *LoginReq) GetPublicKey() string {
 	return ""
 }
 
-type ResetPasswordReq struct {
+type UserKeyInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AreaCode    string `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
-	PhoneNumber string `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	VerifyCode  string `protobuf:"bytes,3,opt,name=verifyCode,proto3" json:"verifyCode"`
-	Password    string `protobuf:"bytes,4,opt,name=password,proto3" json:"password"`
-	Email       string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	PublicKey     string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	DeviceID      string `protobuf:"bytes,4,opt,name=deviceID,proto3" json:"deviceID"`
+	Platform      string `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform"`
+	Status        int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status"`
+	IsPrimary     bool   `protobuf:"varint,7,opt,name=isPrimary,proto3" json:"isPrimary"`
+	CreateTime    int64  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+	RevokeTime    int64  `protobuf:"varint,9,opt,name=revokeTime,proto3" json:"revokeTime"`
+	LastLoginTime int64  `protobuf:"varint,10,opt,name=lastLoginTime,proto3" json:"lastLoginTime"`
 }
 
-func (x *ResetPasswordReq) Reset() {
-	*x = ResetPasswordReq{}
+func (x *UserKeyInfo) Reset() {
+	*x = UserKeyInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1719,13 +1789,13 @@ func (x *ResetPasswordReq) Reset() {
 	}
 }
 
-func (x *ResetPasswordReq) String() string {
+func (x *UserKeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordReq) ProtoMessage() {}
+func (*UserKeyInfo) ProtoMessage() {}
 
-func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+func (x *UserKeyInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1737,54 +1807,100 @@ func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
-func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserKeyInfo.ProtoReflect.Descriptor instead.
+func (*UserKeyInfo) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ResetPasswordReq) GetAreaCode() string {
+func (x *UserKeyInfo) GetAddress() string {
 	if x != nil {
-		return x.AreaCode
+		return x.Address
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetPhoneNumber() string {
+func (x *UserKeyInfo) GetPublicKey() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetVerifyCode() string {
+func (x *UserKeyInfo) GetName() string {
 	if x != nil {
-		return x.VerifyCode
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetPassword() string {
+func (x *UserKeyInfo) GetDeviceID() string {
 	if x != nil {
-		return x.Password
+		return x.DeviceID
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetEmail() string {
+func (x *UserKeyInfo) GetPlatform() string {
 	if x != nil {
-		return x.Email
+		return x.Platform
 	}
 	return ""
 }
 
-type ResetPasswordResp struct {
+func (x *UserKeyInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserKeyInfo) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *UserKeyInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *UserKeyInfo) GetRevokeTime() int64 {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return 0
+}
+
+func (x *UserKeyInfo) GetLastLoginTime() int64 {
+	if x != nil {
+		return x.LastLoginTime
+	}
+	return 0
+}
+
+type AddUserKeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	DeviceID string `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID"`
+	Platform int32  `protobuf:"varint,4,opt,name=platform,proto3" json:"platform"`
+	// challenge nonce and signature of the key being added
+	Nonce     string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce"`
+	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature"`
+	// challenge nonce and signature of a key already linked to the account
+	ApproveNonce     string `protobuf:"bytes,7,opt,name=approveNonce,proto3" json:"approveNonce"`
+	ApproveSignature string `protobuf:"bytes,8,opt,name=approveSignature,proto3" json:"approveSignature"`
 }
 
-func (x *ResetPasswordResp) Reset() {
-	*x = ResetPasswordResp{}
+func (x *AddUserKeyReq) Reset() {
+	*x = AddUserKeyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1792,13 +1908,13 @@ func (x *ResetPasswordResp) Reset() {
 	}
 }
 
-func (x *ResetPasswordResp) String() string {
+func (x *AddUserKeyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordResp) ProtoMessage() {}
+func (*AddUserKeyReq) ProtoMessage() {}
 
-func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
+func (x *AddUserKeyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1810,23 +1926,75 @@ func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
-func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddUserKeyReq.ProtoReflect.Descriptor instead.
+func (*AddUserKeyReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{28}
 }
 
-type ChangePasswordReq struct {
+func (x *AddUserKeyReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddUserKeyReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddUserKeyReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *AddUserKeyReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *AddUserKeyReq) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *AddUserKeyReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *AddUserKeyReq) GetApproveNonce() string {
+	if x != nil {
+		return x.ApproveNonce
+	}
+	return ""
+}
+
+func (x *AddUserKeyReq) GetApproveSignature() string {
+	if x != nil {
+		return x.ApproveSignature
+	}
+	return ""
+}
+
+type AddUserKeyResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword"`
-	NewPassword     string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
 }
 
-func (x *ChangePasswordReq) Reset() {
-	*x = ChangePasswordReq{}
+func (x *AddUserKeyResp) Reset() {
+	*x = AddUserKeyResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1834,13 +2002,13 @@ func (x *ChangePasswordReq) Reset() {
 	}
 }
 
-func (x *ChangePasswordReq) String() string {
+func (x *AddUserKeyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordReq) ProtoMessage() {}
+func (*AddUserKeyResp) ProtoMessage() {}
 
-func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+func (x *AddUserKeyResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1852,40 +2020,21 @@ func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
-func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddUserKeyResp.ProtoReflect.Descriptor instead.
+func (*AddUserKeyResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ChangePasswordReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *ChangePasswordReq) GetCurrentPassword() string {
-	if x != nil {
-		return x.CurrentPassword
-	}
-	return ""
-}
-
-func (x *ChangePasswordReq) GetNewPassword() string {
-	if x != nil {
-		return x.NewPassword
-	}
-	return ""
-}
-
-type ChangePasswordResp struct {
+type ListUserKeysReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *ChangePasswordResp) Reset() {
-	*x = ChangePasswordResp{}
+func (x *ListUserKeysReq) Reset() {
+	*x = ListUserKeysReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1893,13 +2042,13 @@ func (x *ChangePasswordResp) Reset() {
 	}
 }
 
-func (x *ChangePasswordResp) String() string {
+func (x *ListUserKeysReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResp) ProtoMessage() {}
+func (*ListUserKeysReq) ProtoMessage() {}
 
-func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
+func (x *ListUserKeysReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1911,21 +2060,28 @@ func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserKeysReq.ProtoReflect.Descriptor instead.
+func (*ListUserKeysReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{30}
 }
 
-type FindUserAccountReq struct {
+func (x *ListUserKeysReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ListUserKeysResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	Keys []*UserKeyInfo `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
 }
 
-func (x *FindUserAccountReq) Reset() {
-	*x = FindUserAccountReq{}
+func (x *ListUserKeysResp) Reset() {
+	*x = ListUserKeysResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1933,13 +2089,13 @@ func (x *FindUserAccountReq) Reset() {
 	}
 }
 
-func (x *FindUserAccountReq) String() string {
+func (x *ListUserKeysResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserAccountReq) ProtoMessage() {}
+func (*ListUserKeysResp) ProtoMessage() {}
 
-func (x *FindUserAccountReq) ProtoReflect() protoreflect.Message {
+func (x *ListUserKeysResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1951,29 +2107,28 @@ func (x *FindUserAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserAccountReq.ProtoReflect.Descriptor instead.
-func (*FindUserAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserKeysResp.ProtoReflect.Descriptor instead.
+func (*ListUserKeysResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *FindUserAccountReq) GetUserIDs() []string {
+func (x *ListUserKeysResp) GetKeys() []*UserKeyInfo {
 	if x != nil {
-		return x.UserIDs
+		return x.Keys
 	}
 	return nil
 }
 
-type FindUserByAddressOrAccountReq struct {
+type RevokeUserKeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
-	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
 }
 
-func (x *FindUserByAddressOrAccountReq) Reset() {
-	*x = FindUserByAddressOrAccountReq{}
+func (x *RevokeUserKeyReq) Reset() {
+	*x = RevokeUserKeyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1981,13 +2136,13 @@ func (x *FindUserByAddressOrAccountReq) Reset() {
 	}
 }
 
-func (x *FindUserByAddressOrAccountReq) String() string {
+func (x *RevokeUserKeyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserByAddressOrAccountReq) ProtoMessage() {}
+func (*RevokeUserKeyReq) ProtoMessage() {}
 
-func (x *FindUserByAddressOrAccountReq) ProtoReflect() protoreflect.Message {
+func (x *RevokeUserKeyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1999,35 +2154,26 @@ func (x *FindUserByAddressOrAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserByAddressOrAccountReq.ProtoReflect.Descriptor instead.
-func (*FindUserByAddressOrAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeUserKeyReq.ProtoReflect.Descriptor instead.
+func (*RevokeUserKeyReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FindUserByAddressOrAccountReq) GetAddress() string {
+func (x *RevokeUserKeyReq) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *FindUserByAddressOrAccountReq) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-type FindUserAccountResp struct {
+type RevokeUserKeyResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserAccountMap map[string]string `protobuf:"bytes,1,rep,name=userAccountMap,proto3" json:"userAccountMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // userID  account
 }
 
-func (x *FindUserAccountResp) Reset() {
-	*x = FindUserAccountResp{}
+func (x *RevokeUserKeyResp) Reset() {
+	*x = RevokeUserKeyResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2035,13 +2181,13 @@ func (x *FindUserAccountResp) Reset() {
 	}
 }
 
-func (x *FindUserAccountResp) String() string {
+func (x *RevokeUserKeyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserAccountResp) ProtoMessage() {}
+func (*RevokeUserKeyResp) ProtoMessage() {}
 
-func (x *FindUserAccountResp) ProtoReflect() protoreflect.Message {
+func (x *RevokeUserKeyResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2053,28 +2199,23 @@ func (x *FindUserAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserAccountResp.ProtoReflect.Descriptor instead.
-func (*FindUserAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeUserKeyResp.ProtoReflect.Descriptor instead.
+func (*RevokeUserKeyResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *FindUserAccountResp) GetUserAccountMap() map[string]string {
-	if x != nil {
-		return x.UserAccountMap
-	}
-	return nil
-}
-
-type FindAccountUserReq struct {
+type RecoveryApproval struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	GuardianUserID string `protobuf:"bytes,1,opt,name=guardianUserID,proto3" json:"guardianUserID"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+	ApproveTime    int64  `protobuf:"varint,3,opt,name=approveTime,proto3" json:"approveTime"`
 }
 
-func (x *FindAccountUserReq) Reset() {
-	*x = FindAccountUserReq{}
+func (x *RecoveryApproval) Reset() {
+	*x = RecoveryApproval{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2082,13 +2223,13 @@ func (x *FindAccountUserReq) Reset() {
 	}
 }
 
-func (x *FindAccountUserReq) String() string {
+func (x *RecoveryApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAccountUserReq) ProtoMessage() {}
+func (*RecoveryApproval) ProtoMessage() {}
 
-func (x *FindAccountUserReq) ProtoReflect() protoreflect.Message {
+func (x *RecoveryApproval) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2100,103 +2241,71 @@ func (x *FindAccountUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAccountUserReq.ProtoReflect.Descriptor instead.
-func (*FindAccountUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoveryApproval.ProtoReflect.Descriptor instead.
+func (*RecoveryApproval) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *FindAccountUserReq) GetAccounts() []string {
+func (x *RecoveryApproval) GetGuardianUserID() string {
 	if x != nil {
-		return x.Accounts
-	}
-	return nil
-}
-
-type FindAccountUserResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AccountUserMap map[string]string `protobuf:"bytes,1,rep,name=accountUserMap,proto3" json:"accountUserMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // account  userID
-}
-
-func (x *FindAccountUserResp) Reset() {
-	*x = FindAccountUserResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.GuardianUserID
 	}
+	return ""
 }
 
-func (x *FindAccountUserResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FindAccountUserResp) ProtoMessage() {}
-
-func (x *FindAccountUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RecoveryApproval) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FindAccountUserResp.ProtoReflect.Descriptor instead.
-func (*FindAccountUserResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{35}
+	return ""
 }
 
-func (x *FindAccountUserResp) GetAccountUserMap() map[string]string {
+func (x *RecoveryApproval) GetApproveTime() int64 {
 	if x != nil {
-		return x.AccountUserMap
+		return x.ApproveTime
 	}
-	return nil
+	return 0
 }
 
-type SignalRecord struct {
+type RecoveryInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileName        string                   `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName"`
-	MediaType       string                   `protobuf:"bytes,2,opt,name=mediaType,proto3" json:"mediaType"`
-	RoomType        string                   `protobuf:"bytes,3,opt,name=roomType,proto3" json:"roomType"`
-	SenderID        string                   `protobuf:"bytes,4,opt,name=senderID,proto3" json:"senderID"`
-	SenderNickname  string                   `protobuf:"bytes,5,opt,name=senderNickname,proto3" json:"senderNickname"`
-	RecvID          string                   `protobuf:"bytes,6,opt,name=recvID,proto3" json:"recvID"`
-	RecvNickname    string                   `protobuf:"bytes,7,opt,name=recvNickname,proto3" json:"recvNickname"`
-	GroupID         string                   `protobuf:"bytes,8,opt,name=groupID,proto3" json:"groupID"`
-	GroupName       string                   `protobuf:"bytes,9,opt,name=groupName,proto3" json:"groupName"`
-	InviterUserList []*common.UserPublicInfo `protobuf:"bytes,10,rep,name=inviterUserList,proto3" json:"inviterUserList"`
-	Duration        int32                    `protobuf:"varint,11,opt,name=duration,proto3" json:"duration"`
-	CreateTime      int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
-	Size            string                   `protobuf:"bytes,13,opt,name=size,proto3" json:"size"`
-	DownloadURL     string                   `protobuf:"bytes,14,opt,name=downloadURL,proto3" json:"downloadURL"`
-}
-
-func (x *SignalRecord) Reset() {
-	*x = SignalRecord{}
+	RecoveryID      string              `protobuf:"bytes,1,opt,name=recoveryID,proto3" json:"recoveryID"`
+	UserID          string              `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	NewAddress      string              `protobuf:"bytes,3,opt,name=newAddress,proto3" json:"newAddress"`
+	NewPublicKey    string              `protobuf:"bytes,4,opt,name=newPublicKey,proto3" json:"newPublicKey"`
+	Mode            int32               `protobuf:"varint,5,opt,name=mode,proto3" json:"mode"`
+	Status          int32               `protobuf:"varint,6,opt,name=status,proto3" json:"status"`
+	GuardianUserIDs []string            `protobuf:"bytes,7,rep,name=guardianUserIDs,proto3" json:"guardianUserIDs"`
+	Threshold       int32               `protobuf:"varint,8,opt,name=threshold,proto3" json:"threshold"`
+	Approvals       []*RecoveryApproval `protobuf:"bytes,9,rep,name=approvals,proto3" json:"approvals"`
+	OperatorUserID  string              `protobuf:"bytes,10,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	Reason          string              `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason"`
+	CreateTime      int64               `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
+	ExecuteAfter    int64               `protobuf:"varint,13,opt,name=executeAfter,proto3" json:"executeAfter"`
+	ExpireTime      int64               `protobuf:"varint,14,opt,name=expireTime,proto3" json:"expireTime"`
+	ExecuteTime     int64               `protobuf:"varint,15,opt,name=executeTime,proto3" json:"executeTime"`
+}
+
+func (x *RecoveryInfo) Reset() {
+	*x = RecoveryInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[36]
+		mi := &file_chat_chat_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignalRecord) String() string {
+func (x *RecoveryInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignalRecord) ProtoMessage() {}
+func (*RecoveryInfo) ProtoMessage() {}
 
-func (x *SignalRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[36]
+func (x *RecoveryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,135 +2316,145 @@ func (x *SignalRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignalRecord.ProtoReflect.Descriptor instead.
-func (*SignalRecord) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use RecoveryInfo.ProtoReflect.Descriptor instead.
+func (*RecoveryInfo) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SignalRecord) GetFileName() string {
+func (x *RecoveryInfo) GetRecoveryID() string {
 	if x != nil {
-		return x.FileName
+		return x.RecoveryID
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetMediaType() string {
+func (x *RecoveryInfo) GetUserID() string {
 	if x != nil {
-		return x.MediaType
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetRoomType() string {
+func (x *RecoveryInfo) GetNewAddress() string {
 	if x != nil {
-		return x.RoomType
+		return x.NewAddress
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetSenderID() string {
+func (x *RecoveryInfo) GetNewPublicKey() string {
 	if x != nil {
-		return x.SenderID
+		return x.NewPublicKey
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetSenderNickname() string {
+func (x *RecoveryInfo) GetMode() int32 {
 	if x != nil {
-		return x.SenderNickname
+		return x.Mode
 	}
-	return ""
+	return 0
 }
 
-func (x *SignalRecord) GetRecvID() string {
+func (x *RecoveryInfo) GetStatus() int32 {
 	if x != nil {
-		return x.RecvID
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *SignalRecord) GetRecvNickname() string {
+func (x *RecoveryInfo) GetGuardianUserIDs() []string {
 	if x != nil {
-		return x.RecvNickname
+		return x.GuardianUserIDs
 	}
-	return ""
+	return nil
 }
 
-func (x *SignalRecord) GetGroupID() string {
+func (x *RecoveryInfo) GetThreshold() int32 {
 	if x != nil {
-		return x.GroupID
+		return x.Threshold
 	}
-	return ""
+	return 0
 }
 
-func (x *SignalRecord) GetGroupName() string {
+func (x *RecoveryInfo) GetApprovals() []*RecoveryApproval {
 	if x != nil {
-		return x.GroupName
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *RecoveryInfo) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetInviterUserList() []*common.UserPublicInfo {
+func (x *RecoveryInfo) GetReason() string {
 	if x != nil {
-		return x.InviterUserList
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *SignalRecord) GetDuration() int32 {
+func (x *RecoveryInfo) GetCreateTime() int64 {
 	if x != nil {
-		return x.Duration
+		return x.CreateTime
 	}
 	return 0
 }
 
-func (x *SignalRecord) GetCreateTime() int64 {
+func (x *RecoveryInfo) GetExecuteAfter() int64 {
 	if x != nil {
-		return x.CreateTime
+		return x.ExecuteAfter
 	}
 	return 0
 }
 
-func (x *SignalRecord) GetSize() string {
+func (x *RecoveryInfo) GetExpireTime() int64 {
 	if x != nil {
-		return x.Size
+		return x.ExpireTime
 	}
-	return ""
+	return 0
 }
 
-func (x *SignalRecord) GetDownloadURL() string {
+func (x *RecoveryInfo) GetExecuteTime() int64 {
 	if x != nil {
-		return x.DownloadURL
+		return x.ExecuteTime
 	}
-	return ""
+	return 0
 }
 
-type OpenIMCallbackReq struct {
+type SetRecoveryGuardiansReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command"`
-	Body    string `protobuf:"bytes,2,opt,name=body,proto3" json:"body"`
+	GuardianUserIDs []string `protobuf:"bytes,1,rep,name=guardianUserIDs,proto3" json:"guardianUserIDs"`
+	Threshold       int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold"`
+	// challenge nonce and signature of a key linked to the account
+	Nonce     string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce"`
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature"`
 }
 
-func (x *OpenIMCallbackReq) Reset() {
-	*x = OpenIMCallbackReq{}
+func (x *SetRecoveryGuardiansReq) Reset() {
+	*x = SetRecoveryGuardiansReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[37]
+		mi := &file_chat_chat_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenIMCallbackReq) String() string {
+func (x *SetRecoveryGuardiansReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenIMCallbackReq) ProtoMessage() {}
+func (*SetRecoveryGuardiansReq) ProtoMessage() {}
 
-func (x *OpenIMCallbackReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[37]
+func (x *SetRecoveryGuardiansReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,70 +2465,62 @@ func (x *OpenIMCallbackReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenIMCallbackReq.ProtoReflect.Descriptor instead.
-func (*OpenIMCallbackReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use SetRecoveryGuardiansReq.ProtoReflect.Descriptor instead.
+func (*SetRecoveryGuardiansReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *OpenIMCallbackReq) GetCommand() string {
+func (x *SetRecoveryGuardiansReq) GetGuardianUserIDs() []string {
 	if x != nil {
-		return x.Command
+		return x.GuardianUserIDs
+	}
+	return nil
+}
+
+func (x *SetRecoveryGuardiansReq) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SetRecoveryGuardiansReq) GetNonce() string {
+	if x != nil {
+		return x.Nonce
 	}
 	return ""
 }
 
-func (x *OpenIMCallbackReq) GetBody() string {
+func (x *SetRecoveryGuardiansReq) GetSignature() string {
 	if x != nil {
-		return x.Body
+		return x.Signature
 	}
 	return ""
 }
 
-type OpenIMCallbackResp struct {
+type SetRecoveryGuardiansResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ActionCode       int32    `protobuf:"varint,1,opt,name=actionCode,proto3" json:"actionCode"`
-	ErrCode          int32    `protobuf:"varint,2,opt,name=errCode,proto3" json:"errCode"`
-	NextCode         int32    `protobuf:"varint,3,opt,name=nextCode,proto3" json:"nextCode"`
-	ErrMsg           string   `protobuf:"bytes,4,opt,name=errMsg,proto3" json:"errMsg"`
-	ErrDlt           string   `protobuf:"bytes,5,opt,name=errDlt,proto3" json:"errDlt"`
-	SendID           string   `protobuf:"bytes,6,opt,name=sendID,proto3" json:"sendID"`
-	CallbackCommand  string   `protobuf:"bytes,7,opt,name=callbackCommand,proto3" json:"callbackCommand"`
-	ServerMsgID      string   `protobuf:"bytes,8,opt,name=serverMsgID,proto3" json:"serverMsgID"`
-	ClientMsgID      string   `protobuf:"bytes,9,opt,name=clientMsgID,proto3" json:"clientMsgID"`
-	SenderPlatformID int32    `protobuf:"varint,10,opt,name=senderPlatformID,proto3" json:"senderPlatformID"`
-	SenderNickname   string   `protobuf:"bytes,11,opt,name=senderNickname,proto3" json:"senderNickname"`
-	SessionType      int32    `protobuf:"varint,12,opt,name=sessionType,proto3" json:"sessionType"`
-	MsgFrom          int32    `protobuf:"varint,13,opt,name=msgFrom,proto3" json:"msgFrom"`
-	ContentType      int32    `protobuf:"varint,14,opt,name=contentType,proto3" json:"contentType"`
-	Status           int32    `protobuf:"varint,15,opt,name=status,proto3" json:"status"`
-	CreateTime       int64    `protobuf:"varint,16,opt,name=createTime,proto3" json:"createTime"`
-	Content          string   `protobuf:"bytes,17,opt,name=content,proto3" json:"content"`
-	Seq              int32    `protobuf:"varint,18,opt,name=seq,proto3" json:"seq"`
-	AtUserList       []string `protobuf:"bytes,19,rep,name=atUserList,proto3" json:"atUserList"`
-	FaceURL          string   `protobuf:"bytes,20,opt,name=faceURL,proto3" json:"faceURL"`
-	Ex               string   `protobuf:"bytes,21,opt,name=ex,proto3" json:"ex"`
 }
 
-func (x *OpenIMCallbackResp) Reset() {
-	*x = OpenIMCallbackResp{}
+func (x *SetRecoveryGuardiansResp) Reset() {
+	*x = SetRecoveryGuardiansResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[38]
+		mi := &file_chat_chat_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenIMCallbackResp) String() string {
+func (x *SetRecoveryGuardiansResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenIMCallbackResp) ProtoMessage() {}
+func (*SetRecoveryGuardiansResp) ProtoMessage() {}
 
-func (x *OpenIMCallbackResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[38]
+func (x *SetRecoveryGuardiansResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,186 +2531,143 @@ func (x *OpenIMCallbackResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenIMCallbackResp.ProtoReflect.Descriptor instead.
-func (*OpenIMCallbackResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use SetRecoveryGuardiansResp.ProtoReflect.Descriptor instead.
+func (*SetRecoveryGuardiansResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *OpenIMCallbackResp) GetActionCode() int32 {
-	if x != nil {
-		return x.ActionCode
-	}
-	return 0
-}
+type GetRecoveryGuardiansReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *OpenIMCallbackResp) GetErrCode() int32 {
-	if x != nil {
-		return x.ErrCode
-	}
-	return 0
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *OpenIMCallbackResp) GetNextCode() int32 {
-	if x != nil {
-		return x.NextCode
+func (x *GetRecoveryGuardiansReq) Reset() {
+	*x = GetRecoveryGuardiansReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *OpenIMCallbackResp) GetErrMsg() string {
-	if x != nil {
-		return x.ErrMsg
-	}
-	return ""
+func (x *GetRecoveryGuardiansReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OpenIMCallbackResp) GetErrDlt() string {
-	if x != nil {
-		return x.ErrDlt
+func (*GetRecoveryGuardiansReq) ProtoMessage() {}
+
+func (x *GetRecoveryGuardiansReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OpenIMCallbackResp) GetSendID() string {
-	if x != nil {
-		return x.SendID
-	}
-	return ""
+// Deprecated: Use GetRecoveryGuardiansReq.ProtoReflect.Descriptor instead.
+func (*GetRecoveryGuardiansReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *OpenIMCallbackResp) GetCallbackCommand() string {
+func (x *GetRecoveryGuardiansReq) GetUserID() string {
 	if x != nil {
-		return x.CallbackCommand
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *OpenIMCallbackResp) GetServerMsgID() string {
-	if x != nil {
-		return x.ServerMsgID
-	}
-	return ""
+type GetRecoveryGuardiansResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuardianUserIDs []string `protobuf:"bytes,1,rep,name=guardianUserIDs,proto3" json:"guardianUserIDs"`
+	Threshold       int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold"`
 }
 
-func (x *OpenIMCallbackResp) GetClientMsgID() string {
-	if x != nil {
-		return x.ClientMsgID
-	}
-	return ""
-}
-
-func (x *OpenIMCallbackResp) GetSenderPlatformID() int32 {
-	if x != nil {
-		return x.SenderPlatformID
-	}
-	return 0
-}
-
-func (x *OpenIMCallbackResp) GetSenderNickname() string {
-	if x != nil {
-		return x.SenderNickname
-	}
-	return ""
-}
-
-func (x *OpenIMCallbackResp) GetSessionType() int32 {
-	if x != nil {
-		return x.SessionType
-	}
-	return 0
-}
-
-func (x *OpenIMCallbackResp) GetMsgFrom() int32 {
-	if x != nil {
-		return x.MsgFrom
-	}
-	return 0
-}
-
-func (x *OpenIMCallbackResp) GetContentType() int32 {
-	if x != nil {
-		return x.ContentType
+func (x *GetRecoveryGuardiansResp) Reset() {
+	*x = GetRecoveryGuardiansResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *OpenIMCallbackResp) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+func (x *GetRecoveryGuardiansResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OpenIMCallbackResp) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
+func (*GetRecoveryGuardiansResp) ProtoMessage() {}
 
-func (x *OpenIMCallbackResp) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x *GetRecoveryGuardiansResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OpenIMCallbackResp) GetSeq() int32 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
+// Deprecated: Use GetRecoveryGuardiansResp.ProtoReflect.Descriptor instead.
+func (*GetRecoveryGuardiansResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *OpenIMCallbackResp) GetAtUserList() []string {
+func (x *GetRecoveryGuardiansResp) GetGuardianUserIDs() []string {
 	if x != nil {
-		return x.AtUserList
+		return x.GuardianUserIDs
 	}
 	return nil
 }
 
-func (x *OpenIMCallbackResp) GetFaceURL() string {
-	if x != nil {
-		return x.FaceURL
-	}
-	return ""
-}
-
-func (x *OpenIMCallbackResp) GetEx() string {
+func (x *GetRecoveryGuardiansResp) GetThreshold() int32 {
 	if x != nil {
-		return x.Ex
+		return x.Threshold
 	}
-	return ""
+	return 0
 }
 
-type SearchUserFullInfoReq struct {
+type InitiateRecoveryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
-	Genders    int32                     `protobuf:"varint,3,opt,name=genders,proto3" json:"genders"`
-	Normal     int32                     `protobuf:"varint,4,opt,name=normal,proto3" json:"normal"`
+	// address of the lost key
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	NewAddress string `protobuf:"bytes,2,opt,name=newAddress,proto3" json:"newAddress"`
+	// challenge nonce and signature of the new key
+	Nonce     string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce"`
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature"`
 }
 
-func (x *SearchUserFullInfoReq) Reset() {
-	*x = SearchUserFullInfoReq{}
+func (x *InitiateRecoveryReq) Reset() {
+	*x = InitiateRecoveryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[39]
+		mi := &file_chat_chat_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserFullInfoReq) String() string {
+func (x *InitiateRecoveryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserFullInfoReq) ProtoMessage() {}
+func (*InitiateRecoveryReq) ProtoMessage() {}
 
-func (x *SearchUserFullInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[39]
+func (x *InitiateRecoveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2610,65 +2678,64 @@ func (x *SearchUserFullInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserFullInfoReq.ProtoReflect.Descriptor instead.
-func (*SearchUserFullInfoReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use InitiateRecoveryReq.ProtoReflect.Descriptor instead.
+func (*InitiateRecoveryReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SearchUserFullInfoReq) GetKeyword() string {
+func (x *InitiateRecoveryReq) GetAddress() string {
 	if x != nil {
-		return x.Keyword
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SearchUserFullInfoReq) GetPagination() *sdkwss.RequestPagination {
+func (x *InitiateRecoveryReq) GetNewAddress() string {
 	if x != nil {
-		return x.Pagination
+		return x.NewAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchUserFullInfoReq) GetGenders() int32 {
+func (x *InitiateRecoveryReq) GetNonce() string {
 	if x != nil {
-		return x.Genders
+		return x.Nonce
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchUserFullInfoReq) GetNormal() int32 {
+func (x *InitiateRecoveryReq) GetSignature() string {
 	if x != nil {
-		return x.Normal
+		return x.Signature
 	}
-	return 0
+	return ""
 }
 
-type SearchUserFullInfoResp struct {
+type InitiateRecoveryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	Recovery *RecoveryInfo `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
 }
 
-func (x *SearchUserFullInfoResp) Reset() {
-	*x = SearchUserFullInfoResp{}
+func (x *InitiateRecoveryResp) Reset() {
+	*x = InitiateRecoveryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[40]
+		mi := &file_chat_chat_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserFullInfoResp) String() string {
+func (x *InitiateRecoveryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserFullInfoResp) ProtoMessage() {}
+func (*InitiateRecoveryResp) ProtoMessage() {}
 
-func (x *SearchUserFullInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[40]
+func (x *InitiateRecoveryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2679,51 +2746,46 @@ func (x *SearchUserFullInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserFullInfoResp.ProtoReflect.Descriptor instead.
-func (*SearchUserFullInfoResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *SearchUserFullInfoResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use InitiateRecoveryResp.ProtoReflect.Descriptor instead.
+func (*InitiateRecoveryResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SearchUserFullInfoResp) GetUsers() []*common.UserFullInfo {
+func (x *InitiateRecoveryResp) GetRecovery() *RecoveryInfo {
 	if x != nil {
-		return x.Users
+		return x.Recovery
 	}
 	return nil
 }
 
-type UserLoginCountReq struct {
+type ApproveRecoveryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start"`
-	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end"`
+	RecoveryID string `protobuf:"bytes,1,opt,name=recoveryID,proto3" json:"recoveryID"`
+	// challenge nonce and signature of a key linked to the guardian account
+	Nonce     string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature"`
 }
 
-func (x *UserLoginCountReq) Reset() {
-	*x = UserLoginCountReq{}
+func (x *ApproveRecoveryReq) Reset() {
+	*x = ApproveRecoveryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[41]
+		mi := &file_chat_chat_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserLoginCountReq) String() string {
+func (x *ApproveRecoveryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLoginCountReq) ProtoMessage() {}
+func (*ApproveRecoveryReq) ProtoMessage() {}
 
-func (x *UserLoginCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[41]
+func (x *ApproveRecoveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2734,52 +2796,57 @@ func (x *UserLoginCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLoginCountReq.ProtoReflect.Descriptor instead.
-func (*UserLoginCountReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ApproveRecoveryReq.ProtoReflect.Descriptor instead.
+func (*ApproveRecoveryReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UserLoginCountReq) GetStart() int64 {
+func (x *ApproveRecoveryReq) GetRecoveryID() string {
 	if x != nil {
-		return x.Start
+		return x.RecoveryID
 	}
-	return 0
+	return ""
 }
 
-func (x *UserLoginCountReq) GetEnd() int64 {
+func (x *ApproveRecoveryReq) GetNonce() string {
 	if x != nil {
-		return x.End
+		return x.Nonce
 	}
-	return 0
+	return ""
 }
 
-type UserLoginCountResp struct {
+func (x *ApproveRecoveryReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ApproveRecoveryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LoginCount   int64            `protobuf:"varint,1,opt,name=loginCount,proto3" json:"loginCount"`
-	UnloginCount int64            `protobuf:"varint,2,opt,name=unloginCount,proto3" json:"unloginCount"`
-	Count        map[string]int64 `protobuf:"bytes,3,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Recovery *RecoveryInfo `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
 }
 
-func (x *UserLoginCountResp) Reset() {
-	*x = UserLoginCountResp{}
+func (x *ApproveRecoveryResp) Reset() {
+	*x = ApproveRecoveryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[42]
+		mi := &file_chat_chat_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserLoginCountResp) String() string {
+func (x *ApproveRecoveryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLoginCountResp) ProtoMessage() {}
+func (*ApproveRecoveryResp) ProtoMessage() {}
 
-func (x *UserLoginCountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[42]
+func (x *ApproveRecoveryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,58 +2857,43 @@ func (x *UserLoginCountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLoginCountResp.ProtoReflect.Descriptor instead.
-func (*UserLoginCountResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *UserLoginCountResp) GetLoginCount() int64 {
-	if x != nil {
-		return x.LoginCount
-	}
-	return 0
-}
-
-func (x *UserLoginCountResp) GetUnloginCount() int64 {
-	if x != nil {
-		return x.UnloginCount
-	}
-	return 0
+// Deprecated: Use ApproveRecoveryResp.ProtoReflect.Descriptor instead.
+func (*ApproveRecoveryResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UserLoginCountResp) GetCount() map[string]int64 {
+func (x *ApproveRecoveryResp) GetRecovery() *RecoveryInfo {
 	if x != nil {
-		return x.Count
+		return x.Recovery
 	}
 	return nil
 }
 
-type LoginResp struct {
+type GetRecoveryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChatToken string `protobuf:"bytes,2,opt,name=chatToken,proto3" json:"chatToken"`
-	UserID    string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	RecoveryID string `protobuf:"bytes,1,opt,name=recoveryID,proto3" json:"recoveryID"`
 }
 
-func (x *LoginResp) Reset() {
-	*x = LoginResp{}
+func (x *GetRecoveryReq) Reset() {
+	*x = GetRecoveryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[43]
+		mi := &file_chat_chat_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginResp) String() string {
+func (x *GetRecoveryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResp) ProtoMessage() {}
+func (*GetRecoveryReq) ProtoMessage() {}
 
-func (x *LoginResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[43]
+func (x *GetRecoveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,53 +2904,43 @@ func (x *LoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResp.ProtoReflect.Descriptor instead.
-func (*LoginResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *LoginResp) GetChatToken() string {
-	if x != nil {
-		return x.ChatToken
-	}
-	return ""
+// Deprecated: Use GetRecoveryReq.ProtoReflect.Descriptor instead.
+func (*GetRecoveryReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *LoginResp) GetUserID() string {
+func (x *GetRecoveryReq) GetRecoveryID() string {
 	if x != nil {
-		return x.UserID
+		return x.RecoveryID
 	}
 	return ""
 }
 
-type SearchUserInfoReq struct {
+type GetRecoveryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
-	Genders    []int32                   `protobuf:"varint,3,rep,packed,name=genders,proto3" json:"genders"`
-	UserIDs    []string                  `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"`
+	Recovery *RecoveryInfo `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
 }
 
-func (x *SearchUserInfoReq) Reset() {
-	*x = SearchUserInfoReq{}
+func (x *GetRecoveryResp) Reset() {
+	*x = GetRecoveryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[44]
+		mi := &file_chat_chat_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserInfoReq) String() string {
+func (x *GetRecoveryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserInfoReq) ProtoMessage() {}
+func (*GetRecoveryResp) ProtoMessage() {}
 
-func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[44]
+func (x *GetRecoveryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2909,65 +2951,46 @@ func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserInfoReq.ProtoReflect.Descriptor instead.
-func (*SearchUserInfoReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetRecoveryResp.ProtoReflect.Descriptor instead.
+func (*GetRecoveryResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SearchUserInfoReq) GetKeyword() string {
+func (x *GetRecoveryResp) GetRecovery() *RecoveryInfo {
 	if x != nil {
-		return x.Keyword
+		return x.Recovery
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchUserInfoReq) GetPagination() *sdkwss.RequestPagination {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *SearchUserInfoReq) GetGenders() []int32 {
-	if x != nil {
-		return x.Genders
-	}
-	return nil
-}
-
-func (x *SearchUserInfoReq) GetUserIDs() []string {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
-}
-
-type SearchUserInfoResp struct {
+type StartAdminRecoveryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	NewAddress   string `protobuf:"bytes,2,opt,name=newAddress,proto3" json:"newAddress"`
+	NewPublicKey string `protobuf:"bytes,3,opt,name=newPublicKey,proto3" json:"newPublicKey"`
+	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
 }
 
-func (x *SearchUserInfoResp) Reset() {
-	*x = SearchUserInfoResp{}
+func (x *StartAdminRecoveryReq) Reset() {
+	*x = StartAdminRecoveryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[45]
+		mi := &file_chat_chat_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserInfoResp) String() string {
+func (x *StartAdminRecoveryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserInfoResp) ProtoMessage() {}
+func (*StartAdminRecoveryReq) ProtoMessage() {}
 
-func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[45]
+func (x *StartAdminRecoveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2978,91 +3001,49 @@ func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserInfoResp.ProtoReflect.Descriptor instead.
-func (*SearchUserInfoResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use StartAdminRecoveryReq.ProtoReflect.Descriptor instead.
+func (*StartAdminRecoveryReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SearchUserInfoResp) GetTotal() uint32 {
+func (x *StartAdminRecoveryReq) GetUserID() string {
 	if x != nil {
-		return x.Total
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchUserInfoResp) GetUsers() []*common.UserFullInfo {
+func (x *StartAdminRecoveryReq) GetNewAddress() string {
 	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-type GetTokenForVideoMeetingReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Room     string `protobuf:"bytes,1,opt,name=room,proto3" json:"room"`
-	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity"`
-}
-
-func (x *GetTokenForVideoMeetingReq) Reset() {
-	*x = GetTokenForVideoMeetingReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[46]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetTokenForVideoMeetingReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetTokenForVideoMeetingReq) ProtoMessage() {}
-
-func (x *GetTokenForVideoMeetingReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[46]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NewAddress
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetTokenForVideoMeetingReq.ProtoReflect.Descriptor instead.
-func (*GetTokenForVideoMeetingReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{46}
+	return ""
 }
 
-func (x *GetTokenForVideoMeetingReq) GetRoom() string {
+func (x *StartAdminRecoveryReq) GetNewPublicKey() string {
 	if x != nil {
-		return x.Room
+		return x.NewPublicKey
 	}
 	return ""
 }
 
-func (x *GetTokenForVideoMeetingReq) GetIdentity() string {
+func (x *StartAdminRecoveryReq) GetReason() string {
 	if x != nil {
-		return x.Identity
+		return x.Reason
 	}
 	return ""
 }
 
-type GetTokenForVideoMeetingResp struct {
+type StartAdminRecoveryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerUrl string `protobuf:"bytes,1,opt,name=serverUrl,proto3" json:"serverUrl"`
-	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	Recovery *RecoveryInfo `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
 }
 
-func (x *GetTokenForVideoMeetingResp) Reset() {
-	*x = GetTokenForVideoMeetingResp{}
+func (x *StartAdminRecoveryResp) Reset() {
+	*x = StartAdminRecoveryResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3070,13 +3051,13 @@ func (x *GetTokenForVideoMeetingResp) Reset() {
 	}
 }
 
-func (x *GetTokenForVideoMeetingResp) String() string {
+func (x *StartAdminRecoveryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenForVideoMeetingResp) ProtoMessage() {}
+func (*StartAdminRecoveryResp) ProtoMessage() {}
 
-func (x *GetTokenForVideoMeetingResp) ProtoReflect() protoreflect.Message {
+func (x *StartAdminRecoveryResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3088,35 +3069,28 @@ func (x *GetTokenForVideoMeetingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenForVideoMeetingResp.ProtoReflect.Descriptor instead.
-func (*GetTokenForVideoMeetingResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartAdminRecoveryResp.ProtoReflect.Descriptor instead.
+func (*StartAdminRecoveryResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetTokenForVideoMeetingResp) GetServerUrl() string {
-	if x != nil {
-		return x.ServerUrl
-	}
-	return ""
-}
-
-func (x *GetTokenForVideoMeetingResp) GetToken() string {
+func (x *StartAdminRecoveryResp) GetRecovery() *RecoveryInfo {
 	if x != nil {
-		return x.Token
+		return x.Recovery
 	}
-	return ""
+	return nil
 }
 
-type CheckUserExistReq struct {
+type ExecuteRecoveryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *RegisterUserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	RecoveryID string `protobuf:"bytes,1,opt,name=recoveryID,proto3" json:"recoveryID"`
 }
 
-func (x *CheckUserExistReq) Reset() {
-	*x = CheckUserExistReq{}
+func (x *ExecuteRecoveryReq) Reset() {
+	*x = ExecuteRecoveryReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3124,13 +3098,13 @@ func (x *CheckUserExistReq) Reset() {
 	}
 }
 
-func (x *CheckUserExistReq) String() string {
+func (x *ExecuteRecoveryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserExistReq) ProtoMessage() {}
+func (*ExecuteRecoveryReq) ProtoMessage() {}
 
-func (x *CheckUserExistReq) ProtoReflect() protoreflect.Message {
+func (x *ExecuteRecoveryReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3142,29 +3116,28 @@ func (x *CheckUserExistReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserExistReq.ProtoReflect.Descriptor instead.
-func (*CheckUserExistReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteRecoveryReq.ProtoReflect.Descriptor instead.
+func (*ExecuteRecoveryReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CheckUserExistReq) GetUser() *RegisterUserInfo {
+func (x *ExecuteRecoveryReq) GetRecoveryID() string {
 	if x != nil {
-		return x.User
+		return x.RecoveryID
 	}
-	return nil
+	return ""
 }
 
-type CheckUserExistResp struct {
+type ExecuteRecoveryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Userid       string `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid"`
-	IsRegistered bool   `protobuf:"varint,2,opt,name=isRegistered,proto3" json:"isRegistered"`
+	Recovery *RecoveryInfo `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
 }
 
-func (x *CheckUserExistResp) Reset() {
-	*x = CheckUserExistResp{}
+func (x *ExecuteRecoveryResp) Reset() {
+	*x = ExecuteRecoveryResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3172,13 +3145,13 @@ func (x *CheckUserExistResp) Reset() {
 	}
 }
 
-func (x *CheckUserExistResp) String() string {
+func (x *ExecuteRecoveryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserExistResp) ProtoMessage() {}
+func (*ExecuteRecoveryResp) ProtoMessage() {}
 
-func (x *CheckUserExistResp) ProtoReflect() protoreflect.Message {
+func (x *ExecuteRecoveryResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3190,35 +3163,28 @@ func (x *CheckUserExistResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserExistResp.ProtoReflect.Descriptor instead.
-func (*CheckUserExistResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteRecoveryResp.ProtoReflect.Descriptor instead.
+func (*ExecuteRecoveryResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CheckUserExistResp) GetUserid() string {
-	if x != nil {
-		return x.Userid
-	}
-	return ""
-}
-
-func (x *CheckUserExistResp) GetIsRegistered() bool {
+func (x *ExecuteRecoveryResp) GetRecovery() *RecoveryInfo {
 	if x != nil {
-		return x.IsRegistered
+		return x.Recovery
 	}
-	return false
+	return nil
 }
 
-type DelUserAccountReq struct {
+type CancelRecoveryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	RecoveryID string `protobuf:"bytes,1,opt,name=recoveryID,proto3" json:"recoveryID"`
 }
 
-func (x *DelUserAccountReq) Reset() {
-	*x = DelUserAccountReq{}
+func (x *CancelRecoveryReq) Reset() {
+	*x = CancelRecoveryReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3226,13 +3192,13 @@ func (x *DelUserAccountReq) Reset() {
 	}
 }
 
-func (x *DelUserAccountReq) String() string {
+func (x *CancelRecoveryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserAccountReq) ProtoMessage() {}
+func (*CancelRecoveryReq) ProtoMessage() {}
 
-func (x *DelUserAccountReq) ProtoReflect() protoreflect.Message {
+func (x *CancelRecoveryReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3244,26 +3210,26 @@ func (x *DelUserAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserAccountReq.ProtoReflect.Descriptor instead.
-func (*DelUserAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelRecoveryReq.ProtoReflect.Descriptor instead.
+func (*CancelRecoveryReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DelUserAccountReq) GetUserIDs() []string {
+func (x *CancelRecoveryReq) GetRecoveryID() string {
 	if x != nil {
-		return x.UserIDs
+		return x.RecoveryID
 	}
-	return nil
+	return ""
 }
 
-type DelUserAccountResp struct {
+type CancelRecoveryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DelUserAccountResp) Reset() {
-	*x = DelUserAccountResp{}
+func (x *CancelRecoveryResp) Reset() {
+	*x = CancelRecoveryResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3271,13 +3237,13 @@ func (x *DelUserAccountResp) Reset() {
 	}
 }
 
-func (x *DelUserAccountResp) String() string {
+func (x *CancelRecoveryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserAccountResp) ProtoMessage() {}
+func (*CancelRecoveryResp) ProtoMessage() {}
 
-func (x *DelUserAccountResp) ProtoReflect() protoreflect.Message {
+func (x *CancelRecoveryResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3289,19 +3255,25 @@ func (x *DelUserAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserAccountResp.ProtoReflect.Descriptor instead.
-func (*DelUserAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelRecoveryResp.ProtoReflect.Descriptor instead.
+func (*CancelRecoveryResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{51}
 }
 
-type GetGroupFromContactReq struct {
+type ResetPasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AreaCode    string `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber string `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	VerifyCode  string `protobuf:"bytes,3,opt,name=verifyCode,proto3" json:"verifyCode"`
+	Password    string `protobuf:"bytes,4,opt,name=password,proto3" json:"password"`
+	Email       string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
 }
 
-func (x *GetGroupFromContactReq) Reset() {
-	*x = GetGroupFromContactReq{}
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3309,13 +3281,13 @@ func (x *GetGroupFromContactReq) Reset() {
 	}
 }
 
-func (x *GetGroupFromContactReq) String() string {
+func (x *ResetPasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupFromContactReq) ProtoMessage() {}
+func (*ResetPasswordReq) ProtoMessage() {}
 
-func (x *GetGroupFromContactReq) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3327,21 +3299,54 @@ func (x *GetGroupFromContactReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupFromContactReq.ProtoReflect.Descriptor instead.
-func (*GetGroupFromContactReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{52}
 }
 
-type GetGroupFromContactResp struct {
+func (x *ResetPasswordReq) GetAreaCode() string {
+	if x != nil {
+		return x.AreaCode
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetVerifyCode() string {
+	if x != nil {
+		return x.VerifyCode
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *GetGroupFromContactResp) Reset() {
-	*x = GetGroupFromContactResp{}
+func (x *ResetPasswordResp) Reset() {
+	*x = ResetPasswordResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3349,13 +3354,13 @@ func (x *GetGroupFromContactResp) Reset() {
 	}
 }
 
-func (x *GetGroupFromContactResp) String() string {
+func (x *ResetPasswordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupFromContactResp) ProtoMessage() {}
+func (*ResetPasswordResp) ProtoMessage() {}
 
-func (x *GetGroupFromContactResp) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3367,28 +3372,23 @@ func (x *GetGroupFromContactResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupFromContactResp.ProtoReflect.Descriptor instead.
-func (*GetGroupFromContactResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetGroupFromContactResp) GetGroupIDs() []string {
-	if x != nil {
-		return x.GroupIDs
-	}
-	return nil
-}
-
-type SaveGroupToContactReq struct {
+type ChangePasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword"`
+	NewPassword     string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
 }
 
-func (x *SaveGroupToContactReq) Reset() {
-	*x = SaveGroupToContactReq{}
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3396,13 +3396,13 @@ func (x *SaveGroupToContactReq) Reset() {
 	}
 }
 
-func (x *SaveGroupToContactReq) String() string {
+func (x *ChangePasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveGroupToContactReq) ProtoMessage() {}
+func (*ChangePasswordReq) ProtoMessage() {}
 
-func (x *SaveGroupToContactReq) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3414,26 +3414,40 @@ func (x *SaveGroupToContactReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveGroupToContactReq.ProtoReflect.Descriptor instead.
-func (*SaveGroupToContactReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *SaveGroupToContactReq) GetGroupIDs() []string {
+func (x *ChangePasswordReq) GetUserID() string {
 	if x != nil {
-		return x.GroupIDs
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type SaveGroupToContactResp struct {
+func (x *ChangePasswordReq) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SaveGroupToContactResp) Reset() {
-	*x = SaveGroupToContactResp{}
+func (x *ChangePasswordResp) Reset() {
+	*x = ChangePasswordResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3441,13 +3455,13 @@ func (x *SaveGroupToContactResp) Reset() {
 	}
 }
 
-func (x *SaveGroupToContactResp) String() string {
+func (x *ChangePasswordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveGroupToContactResp) ProtoMessage() {}
+func (*ChangePasswordResp) ProtoMessage() {}
 
-func (x *SaveGroupToContactResp) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3459,21 +3473,21 @@ func (x *SaveGroupToContactResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveGroupToContactResp.ProtoReflect.Descriptor instead.
-func (*SaveGroupToContactResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{55}
 }
 
-type DeleteGroupFromContactReq struct {
+type FindUserAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *DeleteGroupFromContactReq) Reset() {
-	*x = DeleteGroupFromContactReq{}
+func (x *FindUserAccountReq) Reset() {
+	*x = FindUserAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3481,13 +3495,13 @@ func (x *DeleteGroupFromContactReq) Reset() {
 	}
 }
 
-func (x *DeleteGroupFromContactReq) String() string {
+func (x *FindUserAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupFromContactReq) ProtoMessage() {}
+func (*FindUserAccountReq) ProtoMessage() {}
 
-func (x *DeleteGroupFromContactReq) ProtoReflect() protoreflect.Message {
+func (x *FindUserAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3499,26 +3513,29 @@ func (x *DeleteGroupFromContactReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupFromContactReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupFromContactReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindUserAccountReq.ProtoReflect.Descriptor instead.
+func (*FindUserAccountReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *DeleteGroupFromContactReq) GetGroupIDs() []string {
+func (x *FindUserAccountReq) GetUserIDs() []string {
 	if x != nil {
-		return x.GroupIDs
+		return x.UserIDs
 	}
 	return nil
 }
 
-type DeleteGroupFromContactResp struct {
+type FindUserByAddressOrAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
 }
 
-func (x *DeleteGroupFromContactResp) Reset() {
-	*x = DeleteGroupFromContactResp{}
+func (x *FindUserByAddressOrAccountReq) Reset() {
+	*x = FindUserByAddressOrAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3526,13 +3543,13 @@ func (x *DeleteGroupFromContactResp) Reset() {
 	}
 }
 
-func (x *DeleteGroupFromContactResp) String() string {
+func (x *FindUserByAddressOrAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupFromContactResp) ProtoMessage() {}
+func (*FindUserByAddressOrAccountReq) ProtoMessage() {}
 
-func (x *DeleteGroupFromContactResp) ProtoReflect() protoreflect.Message {
+func (x *FindUserByAddressOrAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3544,19 +3561,35 @@ func (x *DeleteGroupFromContactResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupFromContactResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupFromContactResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindUserByAddressOrAccountReq.ProtoReflect.Descriptor instead.
+func (*FindUserByAddressOrAccountReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{57}
 }
 
-type DeleteGroupApplicationFromRecipientReq struct {
+func (x *FindUserByAddressOrAccountReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FindUserByAddressOrAccountReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type FindUserAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserAccountMap map[string]string `protobuf:"bytes,1,rep,name=userAccountMap,proto3" json:"userAccountMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // userID  account
 }
 
-func (x *DeleteGroupApplicationFromRecipientReq) Reset() {
-	*x = DeleteGroupApplicationFromRecipientReq{}
+func (x *FindUserAccountResp) Reset() {
+	*x = FindUserAccountResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3564,13 +3597,13 @@ func (x *DeleteGroupApplicationFromRecipientReq) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromRecipientReq) String() string {
+func (x *FindUserAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromRecipientReq) ProtoMessage() {}
+func (*FindUserAccountResp) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromRecipientReq) ProtoReflect() protoreflect.Message {
+func (x *FindUserAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3582,19 +3615,28 @@ func (x *DeleteGroupApplicationFromRecipientReq) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromRecipientReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromRecipientReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindUserAccountResp.ProtoReflect.Descriptor instead.
+func (*FindUserAccountResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{58}
 }
 
-type DeleteGroupApplicationFromRecipientResp struct {
+func (x *FindUserAccountResp) GetUserAccountMap() map[string]string {
+	if x != nil {
+		return x.UserAccountMap
+	}
+	return nil
+}
+
+type FindAccountUserReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 }
 
-func (x *DeleteGroupApplicationFromRecipientResp) Reset() {
-	*x = DeleteGroupApplicationFromRecipientResp{}
+func (x *FindAccountUserReq) Reset() {
+	*x = FindAccountUserReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3602,13 +3644,13 @@ func (x *DeleteGroupApplicationFromRecipientResp) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromRecipientResp) String() string {
+func (x *FindAccountUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromRecipientResp) ProtoMessage() {}
+func (*FindAccountUserReq) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromRecipientResp) ProtoReflect() protoreflect.Message {
+func (x *FindAccountUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3620,19 +3662,28 @@ func (x *DeleteGroupApplicationFromRecipientResp) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromRecipientResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromRecipientResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindAccountUserReq.ProtoReflect.Descriptor instead.
+func (*FindAccountUserReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{59}
 }
 
-type DeleteGroupApplicationFromApplicantReq struct {
+func (x *FindAccountUserReq) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type FindAccountUserResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AccountUserMap map[string]string `protobuf:"bytes,1,rep,name=accountUserMap,proto3" json:"accountUserMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // account  userID
 }
 
-func (x *DeleteGroupApplicationFromApplicantReq) Reset() {
-	*x = DeleteGroupApplicationFromApplicantReq{}
+func (x *FindAccountUserResp) Reset() {
+	*x = FindAccountUserResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3640,13 +3691,13 @@ func (x *DeleteGroupApplicationFromApplicantReq) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromApplicantReq) String() string {
+func (x *FindAccountUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromApplicantReq) ProtoMessage() {}
+func (*FindAccountUserResp) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromApplicantReq) ProtoReflect() protoreflect.Message {
+func (x *FindAccountUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3658,19 +3709,41 @@ func (x *DeleteGroupApplicationFromApplicantReq) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromApplicantReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromApplicantReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindAccountUserResp.ProtoReflect.Descriptor instead.
+func (*FindAccountUserResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{60}
 }
 
-type DeleteGroupApplicationFromApplicantResp struct {
+func (x *FindAccountUserResp) GetAccountUserMap() map[string]string {
+	if x != nil {
+		return x.AccountUserMap
+	}
+	return nil
+}
+
+type SignalRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	FileName        string                   `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName"`
+	MediaType       string                   `protobuf:"bytes,2,opt,name=mediaType,proto3" json:"mediaType"`
+	RoomType        string                   `protobuf:"bytes,3,opt,name=roomType,proto3" json:"roomType"`
+	SenderID        string                   `protobuf:"bytes,4,opt,name=senderID,proto3" json:"senderID"`
+	SenderNickname  string                   `protobuf:"bytes,5,opt,name=senderNickname,proto3" json:"senderNickname"`
+	RecvID          string                   `protobuf:"bytes,6,opt,name=recvID,proto3" json:"recvID"`
+	RecvNickname    string                   `protobuf:"bytes,7,opt,name=recvNickname,proto3" json:"recvNickname"`
+	GroupID         string                   `protobuf:"bytes,8,opt,name=groupID,proto3" json:"groupID"`
+	GroupName       string                   `protobuf:"bytes,9,opt,name=groupName,proto3" json:"groupName"`
+	InviterUserList []*common.UserPublicInfo `protobuf:"bytes,10,rep,name=inviterUserList,proto3" json:"inviterUserList"`
+	Duration        int32                    `protobuf:"varint,11,opt,name=duration,proto3" json:"duration"`
+	CreateTime      int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
+	Size            string                   `protobuf:"bytes,13,opt,name=size,proto3" json:"size"`
+	DownloadURL     string                   `protobuf:"bytes,14,opt,name=downloadURL,proto3" json:"downloadURL"`
 }
 
-func (x *DeleteGroupApplicationFromApplicantResp) Reset() {
-	*x = DeleteGroupApplicationFromApplicantResp{}
+func (x *SignalRecord) Reset() {
+	*x = SignalRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3678,13 +3751,13 @@ func (x *DeleteGroupApplicationFromApplicantResp) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromApplicantResp) String() string {
+func (x *SignalRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromApplicantResp) ProtoMessage() {}
+func (*SignalRecord) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromApplicantResp) ProtoReflect() protoreflect.Message {
+func (x *SignalRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3696,72 +3769,135 @@ func (x *DeleteGroupApplicationFromApplicantResp) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromApplicantResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromApplicantResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignalRecord.ProtoReflect.Descriptor instead.
+func (*SignalRecord) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{61}
 }
 
-type DeleteGroupApplicationFromAlltReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SignalRecord) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
 }
 
-func (x *DeleteGroupApplicationFromAlltReq) Reset() {
-	*x = DeleteGroupApplicationFromAlltReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[62]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SignalRecord) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
 	}
+	return ""
 }
 
-func (x *DeleteGroupApplicationFromAlltReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SignalRecord) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
 }
 
-func (*DeleteGroupApplicationFromAlltReq) ProtoMessage() {}
+func (x *SignalRecord) GetSenderID() string {
+	if x != nil {
+		return x.SenderID
+	}
+	return ""
+}
 
-func (x *DeleteGroupApplicationFromAlltReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[62]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SignalRecord) GetSenderNickname() string {
+	if x != nil {
+		return x.SenderNickname
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteGroupApplicationFromAlltReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromAlltReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{62}
+func (x *SignalRecord) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
 }
 
-type DeleteGroupApplicationFromAllResp struct {
+func (x *SignalRecord) GetRecvNickname() string {
+	if x != nil {
+		return x.RecvNickname
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetInviterUserList() []*common.UserPublicInfo {
+	if x != nil {
+		return x.InviterUserList
+	}
+	return nil
+}
+
+func (x *SignalRecord) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *SignalRecord) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *SignalRecord) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetDownloadURL() string {
+	if x != nil {
+		return x.DownloadURL
+	}
+	return ""
+}
+
+type OpenIMCallbackReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command"`
+	Body    string `protobuf:"bytes,2,opt,name=body,proto3" json:"body"`
 }
 
-func (x *DeleteGroupApplicationFromAllResp) Reset() {
-	*x = DeleteGroupApplicationFromAllResp{}
+func (x *OpenIMCallbackReq) Reset() {
+	*x = OpenIMCallbackReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[63]
+		mi := &file_chat_chat_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteGroupApplicationFromAllResp) String() string {
+func (x *OpenIMCallbackReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromAllResp) ProtoMessage() {}
+func (*OpenIMCallbackReq) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromAllResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[63]
+func (x *OpenIMCallbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3772,60 +3908,70 @@ func (x *DeleteGroupApplicationFromAllResp) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromAllResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromAllResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use OpenIMCallbackReq.ProtoReflect.Descriptor instead.
+func (*OpenIMCallbackReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{62}
 }
 
-type Post struct {
+func (x *OpenIMCallbackReq) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *OpenIMCallbackReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type OpenIMCallbackResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID         string                   `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	UserID         string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
-	Content        string                   `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
-	CommentCount   int64                    `protobuf:"varint,4,opt,name=commentCount,proto3" json:"commentCount"`
-	ForwardCount   int64                    `protobuf:"varint,5,opt,name=forwardCount,proto3" json:"forwardCount"`
-	LikeCount      int64                    `protobuf:"varint,6,opt,name=likeCount,proto3" json:"likeCount"`
-	AllowComment   int32                    `protobuf:"varint,7,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward   int32                    `protobuf:"varint,8,opt,name=allowForward,proto3" json:"allowForward"`
-	IsLiked        int32                    `protobuf:"varint,9,opt,name=isLiked,proto3" json:"isLiked"`
-	IsCollected    int32                    `protobuf:"varint,10,opt,name=isCollected,proto3" json:"isCollected"`
-	IsForwarded    int32                    `protobuf:"varint,11,opt,name=isForwarded,proto3" json:"isForwarded"`
-	CreateTime     int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
-	UpdateTime     int64                    `protobuf:"varint,13,opt,name=updateTime,proto3" json:"updateTime"`
-	AtUserIds      []string                 `protobuf:"bytes,14,rep,name=atUserIds,proto3" json:"atUserIds"`
-	UserInfo       *common.UserPublicInfo   `protobuf:"bytes,15,opt,name=userInfo,proto3" json:"userInfo"`
-	MediaMsgs      []*common.PostMedia      `protobuf:"bytes,16,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
-	AtUserInfoList []*common.UserPublicInfo `protobuf:"bytes,17,rep,name=atUserInfoList,proto3" json:"atUserInfoList"`
-	ForwardPostID  string                   `protobuf:"bytes,18,opt,name=forwardPostID,proto3" json:"forwardPostID"`
-	CommentPostID  string                   `protobuf:"bytes,19,opt,name=commentPostID,proto3" json:"commentPostID"`
-	RefPostID      string                   `protobuf:"bytes,20,opt,name=refPostID,proto3" json:"refPostID"`
-	ForwardPost    *Post                    `protobuf:"bytes,21,opt,name=forwardPost,proto3" json:"forwardPost"`
-	CommentPost    *Post                    `protobuf:"bytes,22,opt,name=commentPost,proto3" json:"commentPost"`
-	RefPost        *Post                    `protobuf:"bytes,23,opt,name=refPost,proto3" json:"refPost"`
-	IsPinned       int32                    `protobuf:"varint,24,opt,name=isPinned,proto3" json:"isPinned"`
-	IsCommented    int32                    `protobuf:"varint,25,opt,name=isCommented,proto3" json:"isCommented"`
+	ActionCode       int32    `protobuf:"varint,1,opt,name=actionCode,proto3" json:"actionCode"`
+	ErrCode          int32    `protobuf:"varint,2,opt,name=errCode,proto3" json:"errCode"`
+	NextCode         int32    `protobuf:"varint,3,opt,name=nextCode,proto3" json:"nextCode"`
+	ErrMsg           string   `protobuf:"bytes,4,opt,name=errMsg,proto3" json:"errMsg"`
+	ErrDlt           string   `protobuf:"bytes,5,opt,name=errDlt,proto3" json:"errDlt"`
+	SendID           string   `protobuf:"bytes,6,opt,name=sendID,proto3" json:"sendID"`
+	CallbackCommand  string   `protobuf:"bytes,7,opt,name=callbackCommand,proto3" json:"callbackCommand"`
+	ServerMsgID      string   `protobuf:"bytes,8,opt,name=serverMsgID,proto3" json:"serverMsgID"`
+	ClientMsgID      string   `protobuf:"bytes,9,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	SenderPlatformID int32    `protobuf:"varint,10,opt,name=senderPlatformID,proto3" json:"senderPlatformID"`
+	SenderNickname   string   `protobuf:"bytes,11,opt,name=senderNickname,proto3" json:"senderNickname"`
+	SessionType      int32    `protobuf:"varint,12,opt,name=sessionType,proto3" json:"sessionType"`
+	MsgFrom          int32    `protobuf:"varint,13,opt,name=msgFrom,proto3" json:"msgFrom"`
+	ContentType      int32    `protobuf:"varint,14,opt,name=contentType,proto3" json:"contentType"`
+	Status           int32    `protobuf:"varint,15,opt,name=status,proto3" json:"status"`
+	CreateTime       int64    `protobuf:"varint,16,opt,name=createTime,proto3" json:"createTime"`
+	Content          string   `protobuf:"bytes,17,opt,name=content,proto3" json:"content"`
+	Seq              int32    `protobuf:"varint,18,opt,name=seq,proto3" json:"seq"`
+	AtUserList       []string `protobuf:"bytes,19,rep,name=atUserList,proto3" json:"atUserList"`
+	FaceURL          string   `protobuf:"bytes,20,opt,name=faceURL,proto3" json:"faceURL"`
+	Ex               string   `protobuf:"bytes,21,opt,name=ex,proto3" json:"ex"`
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *OpenIMCallbackResp) Reset() {
+	*x = OpenIMCallbackResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[64]
+		mi := &file_chat_chat_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Post) String() string {
+func (x *OpenIMCallbackResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*OpenIMCallbackResp) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[64]
+func (x *OpenIMCallbackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3836,215 +3982,186 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use OpenIMCallbackResp.ProtoReflect.Descriptor instead.
+func (*OpenIMCallbackResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Post) GetPostID() string {
+func (x *OpenIMCallbackResp) GetActionCode() int32 {
 	if x != nil {
-		return x.PostID
+		return x.ActionCode
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetUserID() string {
+func (x *OpenIMCallbackResp) GetErrCode() int32 {
 	if x != nil {
-		return x.UserID
+		return x.ErrCode
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetContent() string {
+func (x *OpenIMCallbackResp) GetNextCode() int32 {
 	if x != nil {
-		return x.Content
+		return x.NextCode
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetCommentCount() int64 {
+func (x *OpenIMCallbackResp) GetErrMsg() string {
 	if x != nil {
-		return x.CommentCount
+		return x.ErrMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetForwardCount() int64 {
+func (x *OpenIMCallbackResp) GetErrDlt() string {
 	if x != nil {
-		return x.ForwardCount
+		return x.ErrDlt
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetLikeCount() int64 {
+func (x *OpenIMCallbackResp) GetSendID() string {
 	if x != nil {
-		return x.LikeCount
+		return x.SendID
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetAllowComment() int32 {
+func (x *OpenIMCallbackResp) GetCallbackCommand() string {
 	if x != nil {
-		return x.AllowComment
+		return x.CallbackCommand
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetAllowForward() int32 {
+func (x *OpenIMCallbackResp) GetServerMsgID() string {
 	if x != nil {
-		return x.AllowForward
+		return x.ServerMsgID
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetIsLiked() int32 {
+func (x *OpenIMCallbackResp) GetClientMsgID() string {
 	if x != nil {
-		return x.IsLiked
+		return x.ClientMsgID
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetIsCollected() int32 {
+func (x *OpenIMCallbackResp) GetSenderPlatformID() int32 {
 	if x != nil {
-		return x.IsCollected
+		return x.SenderPlatformID
 	}
 	return 0
 }
 
-func (x *Post) GetIsForwarded() int32 {
+func (x *OpenIMCallbackResp) GetSenderNickname() string {
 	if x != nil {
-		return x.IsForwarded
+		return x.SenderNickname
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetCreateTime() int64 {
+func (x *OpenIMCallbackResp) GetSessionType() int32 {
 	if x != nil {
-		return x.CreateTime
+		return x.SessionType
 	}
 	return 0
 }
 
-func (x *Post) GetUpdateTime() int64 {
+func (x *OpenIMCallbackResp) GetMsgFrom() int32 {
 	if x != nil {
-		return x.UpdateTime
+		return x.MsgFrom
 	}
 	return 0
 }
 
-func (x *Post) GetAtUserIds() []string {
+func (x *OpenIMCallbackResp) GetContentType() int32 {
 	if x != nil {
-		return x.AtUserIds
+		return x.ContentType
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetUserInfo() *common.UserPublicInfo {
+func (x *OpenIMCallbackResp) GetStatus() int32 {
 	if x != nil {
-		return x.UserInfo
+		return x.Status
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetMediaMsgs() []*common.PostMedia {
+func (x *OpenIMCallbackResp) GetCreateTime() int64 {
 	if x != nil {
-		return x.MediaMsgs
-	}
-	return nil
-}
-
-func (x *Post) GetAtUserInfoList() []*common.UserPublicInfo {
-	if x != nil {
-		return x.AtUserInfoList
-	}
-	return nil
-}
-
-func (x *Post) GetForwardPostID() string {
-	if x != nil {
-		return x.ForwardPostID
-	}
-	return ""
-}
-
-func (x *Post) GetCommentPostID() string {
-	if x != nil {
-		return x.CommentPostID
+		return x.CreateTime
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetRefPostID() string {
+func (x *OpenIMCallbackResp) GetContent() string {
 	if x != nil {
-		return x.RefPostID
+		return x.Content
 	}
 	return ""
 }
 
-func (x *Post) GetForwardPost() *Post {
-	if x != nil {
-		return x.ForwardPost
-	}
-	return nil
-}
-
-func (x *Post) GetCommentPost() *Post {
+func (x *OpenIMCallbackResp) GetSeq() int32 {
 	if x != nil {
-		return x.CommentPost
+		return x.Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetRefPost() *Post {
+func (x *OpenIMCallbackResp) GetAtUserList() []string {
 	if x != nil {
-		return x.RefPost
+		return x.AtUserList
 	}
 	return nil
 }
 
-func (x *Post) GetIsPinned() int32 {
+func (x *OpenIMCallbackResp) GetFaceURL() string {
 	if x != nil {
-		return x.IsPinned
+		return x.FaceURL
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetIsCommented() int32 {
+func (x *OpenIMCallbackResp) GetEx() string {
 	if x != nil {
-		return x.IsCommented
+		return x.Ex
 	}
-	return 0
+	return ""
 }
 
-type PublishPostReq struct {
+type SearchUserFullInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=content,proto3" json:"content"`
-	AllowComment int32                   `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward int32                   `protobuf:"varint,3,opt,name=allowForward,proto3" json:"allowForward"`
-	AtUserIds    []string                `protobuf:"bytes,4,rep,name=atUserIds,proto3" json:"atUserIds"`
-	MediaMsgs    []*common.PostMedia     `protobuf:"bytes,5,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Genders    int32                     `protobuf:"varint,3,opt,name=genders,proto3" json:"genders"`
+	Normal     int32                     `protobuf:"varint,4,opt,name=normal,proto3" json:"normal"`
 }
 
-func (x *PublishPostReq) Reset() {
-	*x = PublishPostReq{}
+func (x *SearchUserFullInfoReq) Reset() {
+	*x = SearchUserFullInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[65]
+		mi := &file_chat_chat_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PublishPostReq) String() string {
+func (x *SearchUserFullInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishPostReq) ProtoMessage() {}
+func (*SearchUserFullInfoReq) ProtoMessage() {}
 
-func (x *PublishPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[65]
+func (x *SearchUserFullInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4055,71 +4172,65 @@ func (x *PublishPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishPostReq.ProtoReflect.Descriptor instead.
-func (*PublishPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use SearchUserFullInfoReq.ProtoReflect.Descriptor instead.
+func (*SearchUserFullInfoReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *PublishPostReq) GetContent() *wrapperspb.StringValue {
+func (x *SearchUserFullInfoReq) GetKeyword() string {
 	if x != nil {
-		return x.Content
+		return x.Keyword
 	}
-	return nil
+	return ""
 }
 
-func (x *PublishPostReq) GetAllowComment() int32 {
+func (x *SearchUserFullInfoReq) GetPagination() *sdkwss.RequestPagination {
 	if x != nil {
-		return x.AllowComment
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (x *PublishPostReq) GetAllowForward() int32 {
+func (x *SearchUserFullInfoReq) GetGenders() int32 {
 	if x != nil {
-		return x.AllowForward
+		return x.Genders
 	}
 	return 0
 }
 
-func (x *PublishPostReq) GetAtUserIds() []string {
-	if x != nil {
-		return x.AtUserIds
-	}
-	return nil
-}
-
-func (x *PublishPostReq) GetMediaMsgs() []*common.PostMedia {
+func (x *SearchUserFullInfoReq) GetNormal() int32 {
 	if x != nil {
-		return x.MediaMsgs
+		return x.Normal
 	}
-	return nil
+	return 0
 }
 
-type PublishPostResp struct {
+type SearchUserFullInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
 }
 
-func (x *PublishPostResp) Reset() {
-	*x = PublishPostResp{}
+func (x *SearchUserFullInfoResp) Reset() {
+	*x = SearchUserFullInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[66]
+		mi := &file_chat_chat_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PublishPostResp) String() string {
+func (x *SearchUserFullInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishPostResp) ProtoMessage() {}
+func (*SearchUserFullInfoResp) ProtoMessage() {}
 
-func (x *PublishPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[66]
+func (x *SearchUserFullInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4130,43 +4241,51 @@ func (x *PublishPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishPostResp.ProtoReflect.Descriptor instead.
-func (*PublishPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use SearchUserFullInfoResp.ProtoReflect.Descriptor instead.
+func (*SearchUserFullInfoResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *PublishPostResp) GetPost() *Post {
+func (x *SearchUserFullInfoResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Post
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchUserFullInfoResp) GetUsers() []*common.UserFullInfo {
+	if x != nil {
+		return x.Users
 	}
 	return nil
 }
 
-type GetPostByIDReq struct {
+type UserLoginCountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end"`
 }
 
-func (x *GetPostByIDReq) Reset() {
-	*x = GetPostByIDReq{}
+func (x *UserLoginCountReq) Reset() {
+	*x = UserLoginCountReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[67]
+		mi := &file_chat_chat_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostByIDReq) String() string {
+func (x *UserLoginCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIDReq) ProtoMessage() {}
+func (*UserLoginCountReq) ProtoMessage() {}
 
-func (x *GetPostByIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[67]
+func (x *UserLoginCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4177,43 +4296,52 @@ func (x *GetPostByIDReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIDReq.ProtoReflect.Descriptor instead.
-func (*GetPostByIDReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use UserLoginCountReq.ProtoReflect.Descriptor instead.
+func (*UserLoginCountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetPostByIDReq) GetPostID() string {
+func (x *UserLoginCountReq) GetStart() int64 {
 	if x != nil {
-		return x.PostID
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-type GetPostByIDResp struct {
+func (x *UserLoginCountReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type UserLoginCountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	LoginCount   int64            `protobuf:"varint,1,opt,name=loginCount,proto3" json:"loginCount"`
+	UnloginCount int64            `protobuf:"varint,2,opt,name=unloginCount,proto3" json:"unloginCount"`
+	Count        map[string]int64 `protobuf:"bytes,3,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetPostByIDResp) Reset() {
-	*x = GetPostByIDResp{}
+func (x *UserLoginCountResp) Reset() {
+	*x = UserLoginCountResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[68]
+		mi := &file_chat_chat_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostByIDResp) String() string {
+func (x *UserLoginCountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIDResp) ProtoMessage() {}
+func (*UserLoginCountResp) ProtoMessage() {}
 
-func (x *GetPostByIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[68]
+func (x *UserLoginCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4224,43 +4352,61 @@ func (x *GetPostByIDResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIDResp.ProtoReflect.Descriptor instead.
-func (*GetPostByIDResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use UserLoginCountResp.ProtoReflect.Descriptor instead.
+func (*UserLoginCountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetPostByIDResp) GetPost() *Post {
+func (x *UserLoginCountResp) GetLoginCount() int64 {
 	if x != nil {
-		return x.Post
+		return x.LoginCount
+	}
+	return 0
+}
+
+func (x *UserLoginCountResp) GetUnloginCount() int64 {
+	if x != nil {
+		return x.UnloginCount
+	}
+	return 0
+}
+
+func (x *UserLoginCountResp) GetCount() map[string]int64 {
+	if x != nil {
+		return x.Count
 	}
 	return nil
 }
 
-type GetAllTypePostReq struct {
+type LoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	ChatToken         string `protobuf:"bytes,2,opt,name=chatToken,proto3" json:"chatToken"`
+	UserID            string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	RefreshToken      string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
+	ExpireTime        int64  `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime"`
+	RefreshExpireTime int64  `protobuf:"varint,6,opt,name=refreshExpireTime,proto3" json:"refreshExpireTime"`
 }
 
-func (x *GetAllTypePostReq) Reset() {
-	*x = GetAllTypePostReq{}
+func (x *LoginResp) Reset() {
+	*x = LoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[69]
+		mi := &file_chat_chat_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllTypePostReq) String() string {
+func (x *LoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTypePostReq) ProtoMessage() {}
+func (*LoginResp) ProtoMessage() {}
 
-func (x *GetAllTypePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[69]
+func (x *LoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4271,92 +4417,74 @@ func (x *GetAllTypePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTypePostReq.ProtoReflect.Descriptor instead.
-func (*GetAllTypePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use LoginResp.ProtoReflect.Descriptor instead.
+func (*LoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetAllTypePostReq) GetCount() int32 {
+func (x *LoginResp) GetChatToken() string {
 	if x != nil {
-		return x.Count
+		return x.ChatToken
 	}
-	return 0
-}
-
-type GetAllTypePostResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AllPosts []*TypePosts `protobuf:"bytes,1,rep,name=allPosts,proto3" json:"allPosts"`
+	return ""
 }
 
-func (x *GetAllTypePostResp) Reset() {
-	*x = GetAllTypePostResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[70]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LoginResp) GetUserID() string {
+	if x != nil {
+		return x.UserID
 	}
+	return ""
 }
 
-func (x *GetAllTypePostResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetAllTypePostResp) ProtoMessage() {}
-
-func (x *GetAllTypePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[70]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LoginResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAllTypePostResp.ProtoReflect.Descriptor instead.
-func (*GetAllTypePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{70}
+func (x *LoginResp) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
 }
 
-func (x *GetAllTypePostResp) GetAllPosts() []*TypePosts {
+func (x *LoginResp) GetRefreshExpireTime() int64 {
 	if x != nil {
-		return x.AllPosts
+		return x.RefreshExpireTime
 	}
-	return nil
+	return 0
 }
 
-type TypePosts struct {
+type SearchUserInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type       int32   `protobuf:"varint,1,opt,name=type,proto3" json:"type"`
-	NextCursor int64   `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts"`
+	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Genders    []int32                   `protobuf:"varint,3,rep,packed,name=genders,proto3" json:"genders"`
+	UserIDs    []string                  `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *TypePosts) Reset() {
-	*x = TypePosts{}
+func (x *SearchUserInfoReq) Reset() {
+	*x = SearchUserInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[71]
+		mi := &file_chat_chat_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TypePosts) String() string {
+func (x *SearchUserInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypePosts) ProtoMessage() {}
+func (*SearchUserInfoReq) ProtoMessage() {}
 
-func (x *TypePosts) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[71]
+func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4367,59 +4495,65 @@ func (x *TypePosts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypePosts.ProtoReflect.Descriptor instead.
-func (*TypePosts) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use SearchUserInfoReq.ProtoReflect.Descriptor instead.
+func (*SearchUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *TypePosts) GetType() int32 {
+func (x *SearchUserInfoReq) GetKeyword() string {
 	if x != nil {
-		return x.Type
+		return x.Keyword
 	}
-	return 0
+	return ""
 }
 
-func (x *TypePosts) GetNextCursor() int64 {
+func (x *SearchUserInfoReq) GetPagination() *sdkwss.RequestPagination {
 	if x != nil {
-		return x.NextCursor
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (x *TypePosts) GetPosts() []*Post {
+func (x *SearchUserInfoReq) GetGenders() []int32 {
 	if x != nil {
-		return x.Posts
+		return x.Genders
 	}
 	return nil
 }
 
-type GetPostListReq struct {
+func (x *SearchUserInfoReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type SearchUserInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextCursor int64 `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Count      int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	Type       int32 `protobuf:"varint,3,opt,name=type,proto3" json:"type"`
+	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
 }
 
-func (x *GetPostListReq) Reset() {
-	*x = GetPostListReq{}
+func (x *SearchUserInfoResp) Reset() {
+	*x = SearchUserInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[72]
+		mi := &file_chat_chat_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListReq) String() string {
+func (x *SearchUserInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListReq) ProtoMessage() {}
+func (*SearchUserInfoResp) ProtoMessage() {}
 
-func (x *GetPostListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[72]
+func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4430,58 +4564,51 @@ func (x *GetPostListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListReq.ProtoReflect.Descriptor instead.
-func (*GetPostListReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *GetPostListReq) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
+// Deprecated: Use SearchUserInfoResp.ProtoReflect.Descriptor instead.
+func (*SearchUserInfoResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetPostListReq) GetCount() int32 {
+func (x *SearchUserInfoResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Count
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GetPostListReq) GetType() int32 {
+func (x *SearchUserInfoResp) GetUsers() []*common.UserFullInfo {
 	if x != nil {
-		return x.Type
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-type GetPostListResp struct {
+type GetTokenForVideoMeetingReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+	Room     string `protobuf:"bytes,1,opt,name=room,proto3" json:"room"`
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity"`
 }
 
-func (x *GetPostListResp) Reset() {
-	*x = GetPostListResp{}
+func (x *GetTokenForVideoMeetingReq) Reset() {
+	*x = GetTokenForVideoMeetingReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[73]
+		mi := &file_chat_chat_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListResp) String() string {
+func (x *GetTokenForVideoMeetingReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListResp) ProtoMessage() {}
+func (*GetTokenForVideoMeetingReq) ProtoMessage() {}
 
-func (x *GetPostListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[73]
+func (x *GetTokenForVideoMeetingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4492,52 +4619,51 @@ func (x *GetPostListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListResp.ProtoReflect.Descriptor instead.
-func (*GetPostListResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use GetTokenForVideoMeetingReq.ProtoReflect.Descriptor instead.
+func (*GetTokenForVideoMeetingReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetPostListResp) GetNextCursor() int64 {
+func (x *GetTokenForVideoMeetingReq) GetRoom() string {
 	if x != nil {
-		return x.NextCursor
+		return x.Room
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPostListResp) GetPosts() []*Post {
+func (x *GetTokenForVideoMeetingReq) GetIdentity() string {
 	if x != nil {
-		return x.Posts
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-type GetPostListByUserReq struct {
+type GetTokenForVideoMeetingResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	NextCursor int64  `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	ServerUrl string `protobuf:"bytes,1,opt,name=serverUrl,proto3" json:"serverUrl"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
 }
 
-func (x *GetPostListByUserReq) Reset() {
-	*x = GetPostListByUserReq{}
+func (x *GetTokenForVideoMeetingResp) Reset() {
+	*x = GetTokenForVideoMeetingResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[74]
+		mi := &file_chat_chat_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListByUserReq) String() string {
+func (x *GetTokenForVideoMeetingResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListByUserReq) ProtoMessage() {}
+func (*GetTokenForVideoMeetingResp) ProtoMessage() {}
 
-func (x *GetPostListByUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[74]
+func (x *GetTokenForVideoMeetingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4548,58 +4674,50 @@ func (x *GetPostListByUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListByUserReq.ProtoReflect.Descriptor instead.
-func (*GetPostListByUserReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetTokenForVideoMeetingResp.ProtoReflect.Descriptor instead.
+func (*GetTokenForVideoMeetingResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetPostListByUserReq) GetUserID() string {
+func (x *GetTokenForVideoMeetingResp) GetServerUrl() string {
 	if x != nil {
-		return x.UserID
+		return x.ServerUrl
 	}
 	return ""
 }
 
-func (x *GetPostListByUserReq) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
-}
-
-func (x *GetPostListByUserReq) GetCount() int32 {
+func (x *GetTokenForVideoMeetingResp) GetToken() string {
 	if x != nil {
-		return x.Count
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type GetPostListByUserResp struct {
+type CheckUserExistReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+	User *RegisterUserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
 }
 
-func (x *GetPostListByUserResp) Reset() {
-	*x = GetPostListByUserResp{}
+func (x *CheckUserExistReq) Reset() {
+	*x = CheckUserExistReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[75]
+		mi := &file_chat_chat_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListByUserResp) String() string {
+func (x *CheckUserExistReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListByUserResp) ProtoMessage() {}
+func (*CheckUserExistReq) ProtoMessage() {}
 
-func (x *GetPostListByUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[75]
+func (x *CheckUserExistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4610,52 +4728,44 @@ func (x *GetPostListByUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListByUserResp.ProtoReflect.Descriptor instead.
-func (*GetPostListByUserResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *GetPostListByUserResp) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
+// Deprecated: Use CheckUserExistReq.ProtoReflect.Descriptor instead.
+func (*CheckUserExistReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetPostListByUserResp) GetPosts() []*Post {
+func (x *CheckUserExistReq) GetUser() *RegisterUserInfo {
 	if x != nil {
-		return x.Posts
+		return x.User
 	}
 	return nil
 }
 
-type GetCommentPostListByPostIDReq struct {
+type CheckUserExistResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID     string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	NextCursor int64  `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
-}
+	Userid       string `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid"`
+	IsRegistered bool   `protobuf:"varint,2,opt,name=isRegistered,proto3" json:"isRegistered"`
+}
 
-func (x *GetCommentPostListByPostIDReq) Reset() {
-	*x = GetCommentPostListByPostIDReq{}
+func (x *CheckUserExistResp) Reset() {
+	*x = CheckUserExistResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[76]
+		mi := &file_chat_chat_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCommentPostListByPostIDReq) String() string {
+func (x *CheckUserExistResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentPostListByPostIDReq) ProtoMessage() {}
+func (*CheckUserExistResp) ProtoMessage() {}
 
-func (x *GetCommentPostListByPostIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[76]
+func (x *CheckUserExistResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4666,58 +4776,50 @@ func (x *GetCommentPostListByPostIDReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentPostListByPostIDReq.ProtoReflect.Descriptor instead.
-func (*GetCommentPostListByPostIDReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use CheckUserExistResp.ProtoReflect.Descriptor instead.
+func (*CheckUserExistResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetCommentPostListByPostIDReq) GetPostID() string {
+func (x *CheckUserExistResp) GetUserid() string {
 	if x != nil {
-		return x.PostID
+		return x.Userid
 	}
 	return ""
 }
 
-func (x *GetCommentPostListByPostIDReq) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
-}
-
-func (x *GetCommentPostListByPostIDReq) GetCount() int32 {
+func (x *CheckUserExistResp) GetIsRegistered() bool {
 	if x != nil {
-		return x.Count
+		return x.IsRegistered
 	}
-	return 0
+	return false
 }
 
-type GetCommentPostListByPostIDResp struct {
+type DelUserAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *GetCommentPostListByPostIDResp) Reset() {
-	*x = GetCommentPostListByPostIDResp{}
+func (x *DelUserAccountReq) Reset() {
+	*x = DelUserAccountReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[77]
+		mi := &file_chat_chat_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCommentPostListByPostIDResp) String() string {
+func (x *DelUserAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentPostListByPostIDResp) ProtoMessage() {}
+func (*DelUserAccountReq) ProtoMessage() {}
 
-func (x *GetCommentPostListByPostIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[77]
+func (x *DelUserAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4728,50 +4830,41 @@ func (x *GetCommentPostListByPostIDResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentPostListByPostIDResp.ProtoReflect.Descriptor instead.
-func (*GetCommentPostListByPostIDResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *GetCommentPostListByPostIDResp) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
+// Deprecated: Use DelUserAccountReq.ProtoReflect.Descriptor instead.
+func (*DelUserAccountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetCommentPostListByPostIDResp) GetPosts() []*Post {
+func (x *DelUserAccountReq) GetUserIDs() []string {
 	if x != nil {
-		return x.Posts
+		return x.UserIDs
 	}
 	return nil
 }
 
-type DeletePostReq struct {
+type DelUserAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *DeletePostReq) Reset() {
-	*x = DeletePostReq{}
+func (x *DelUserAccountResp) Reset() {
+	*x = DelUserAccountResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[78]
+		mi := &file_chat_chat_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeletePostReq) String() string {
+func (x *DelUserAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostReq) ProtoMessage() {}
+func (*DelUserAccountResp) ProtoMessage() {}
 
-func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[78]
+func (x *DelUserAccountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4782,41 +4875,34 @@ func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
-func (*DeletePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *DeletePostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use DelUserAccountResp.ProtoReflect.Descriptor instead.
+func (*DelUserAccountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{76}
 }
 
-type DeletePostResp struct {
+type GetGroupFromContactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeletePostResp) Reset() {
-	*x = DeletePostResp{}
+func (x *GetGroupFromContactReq) Reset() {
+	*x = GetGroupFromContactReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[79]
+		mi := &file_chat_chat_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeletePostResp) String() string {
+func (x *GetGroupFromContactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResp) ProtoMessage() {}
+func (*GetGroupFromContactReq) ProtoMessage() {}
 
-func (x *DeletePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[79]
+func (x *GetGroupFromContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,36 +4913,36 @@ func (x *DeletePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResp.ProtoReflect.Descriptor instead.
-func (*DeletePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use GetGroupFromContactReq.ProtoReflect.Descriptor instead.
+func (*GetGroupFromContactReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{77}
 }
 
-type ChangeAllowCommentPostReq struct {
+type GetGroupFromContactResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *ChangeAllowCommentPostReq) Reset() {
-	*x = ChangeAllowCommentPostReq{}
+func (x *GetGroupFromContactResp) Reset() {
+	*x = GetGroupFromContactResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[80]
+		mi := &file_chat_chat_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowCommentPostReq) String() string {
+func (x *GetGroupFromContactResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowCommentPostReq) ProtoMessage() {}
+func (*GetGroupFromContactResp) ProtoMessage() {}
 
-func (x *ChangeAllowCommentPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[80]
+func (x *GetGroupFromContactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4867,44 +4953,43 @@ func (x *ChangeAllowCommentPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowCommentPostReq.ProtoReflect.Descriptor instead.
-func (*ChangeAllowCommentPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use GetGroupFromContactResp.ProtoReflect.Descriptor instead.
+func (*GetGroupFromContactResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ChangeAllowCommentPostReq) GetPostID() string {
+func (x *GetGroupFromContactResp) GetGroupIDs() []string {
 	if x != nil {
-		return x.PostID
+		return x.GroupIDs
 	}
-	return ""
+	return nil
 }
 
-type ChangeAllowCommentPostResp struct {
+type SaveGroupToContactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	AllowComment int32  `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *ChangeAllowCommentPostResp) Reset() {
-	*x = ChangeAllowCommentPostResp{}
+func (x *SaveGroupToContactReq) Reset() {
+	*x = SaveGroupToContactReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[81]
+		mi := &file_chat_chat_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowCommentPostResp) String() string {
+func (x *SaveGroupToContactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowCommentPostResp) ProtoMessage() {}
+func (*SaveGroupToContactReq) ProtoMessage() {}
 
-func (x *ChangeAllowCommentPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[81]
+func (x *SaveGroupToContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4915,50 +5000,41 @@ func (x *ChangeAllowCommentPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowCommentPostResp.ProtoReflect.Descriptor instead.
-func (*ChangeAllowCommentPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *ChangeAllowCommentPostResp) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use SaveGroupToContactReq.ProtoReflect.Descriptor instead.
+func (*SaveGroupToContactReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ChangeAllowCommentPostResp) GetAllowComment() int32 {
+func (x *SaveGroupToContactReq) GetGroupIDs() []string {
 	if x != nil {
-		return x.AllowComment
+		return x.GroupIDs
 	}
-	return 0
+	return nil
 }
 
-type ChangeAllowForwardPostReq struct {
+type SaveGroupToContactResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *ChangeAllowForwardPostReq) Reset() {
-	*x = ChangeAllowForwardPostReq{}
+func (x *SaveGroupToContactResp) Reset() {
+	*x = SaveGroupToContactResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[82]
+		mi := &file_chat_chat_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowForwardPostReq) String() string {
+func (x *SaveGroupToContactResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowForwardPostReq) ProtoMessage() {}
+func (*SaveGroupToContactResp) ProtoMessage() {}
 
-func (x *ChangeAllowForwardPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[82]
+func (x *SaveGroupToContactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4969,44 +5045,36 @@ func (x *ChangeAllowForwardPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowForwardPostReq.ProtoReflect.Descriptor instead.
-func (*ChangeAllowForwardPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *ChangeAllowForwardPostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use SaveGroupToContactResp.ProtoReflect.Descriptor instead.
+func (*SaveGroupToContactResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{80}
 }
 
-type ChangeAllowForwardPostResp struct {
+type DeleteGroupFromContactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	AllowForward int32  `protobuf:"varint,2,opt,name=allowForward,proto3" json:"allowForward"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *ChangeAllowForwardPostResp) Reset() {
-	*x = ChangeAllowForwardPostResp{}
+func (x *DeleteGroupFromContactReq) Reset() {
+	*x = DeleteGroupFromContactReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[83]
+		mi := &file_chat_chat_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowForwardPostResp) String() string {
+func (x *DeleteGroupFromContactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowForwardPostResp) ProtoMessage() {}
+func (*DeleteGroupFromContactReq) ProtoMessage() {}
 
-func (x *ChangeAllowForwardPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[83]
+func (x *DeleteGroupFromContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5017,51 +5085,41 @@ func (x *ChangeAllowForwardPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowForwardPostResp.ProtoReflect.Descriptor instead.
-func (*ChangeAllowForwardPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use DeleteGroupFromContactReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupFromContactReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ChangeAllowForwardPostResp) GetPostID() string {
+func (x *DeleteGroupFromContactReq) GetGroupIDs() []string {
 	if x != nil {
-		return x.PostID
+		return x.GroupIDs
 	}
-	return ""
+	return nil
 }
 
-func (x *ChangeAllowForwardPostResp) GetAllowForward() int32 {
-	if x != nil {
-		return x.AllowForward
-	}
-	return 0
-}
-
-type LikePostReq struct {
+type DeleteGroupFromContactResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID  string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	IsLiked int32  `protobuf:"varint,2,opt,name=isLiked,proto3" json:"isLiked"`
 }
 
-func (x *LikePostReq) Reset() {
-	*x = LikePostReq{}
+func (x *DeleteGroupFromContactResp) Reset() {
+	*x = DeleteGroupFromContactResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[84]
+		mi := &file_chat_chat_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LikePostReq) String() string {
+func (x *DeleteGroupFromContactResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostReq) ProtoMessage() {}
+func (*DeleteGroupFromContactResp) ProtoMessage() {}
 
-func (x *LikePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[84]
+func (x *DeleteGroupFromContactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5072,50 +5130,34 @@ func (x *LikePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
-func (*LikePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *LikePostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *LikePostReq) GetIsLiked() int32 {
-	if x != nil {
-		return x.IsLiked
-	}
-	return 0
+// Deprecated: Use DeleteGroupFromContactResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupFromContactResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{82}
 }
 
-type LikePostResp struct {
+type DeleteGroupApplicationFromRecipientReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	IsLiked int32 `protobuf:"varint,1,opt,name=isLiked,proto3" json:"isLiked"`
 }
 
-func (x *LikePostResp) Reset() {
-	*x = LikePostResp{}
+func (x *DeleteGroupApplicationFromRecipientReq) Reset() {
+	*x = DeleteGroupApplicationFromRecipientReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[85]
+		mi := &file_chat_chat_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LikePostResp) String() string {
+func (x *DeleteGroupApplicationFromRecipientReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostResp) ProtoMessage() {}
+func (*DeleteGroupApplicationFromRecipientReq) ProtoMessage() {}
 
-func (x *LikePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[85]
+func (x *DeleteGroupApplicationFromRecipientReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5126,44 +5168,34 @@ func (x *LikePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostResp.ProtoReflect.Descriptor instead.
-func (*LikePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *LikePostResp) GetIsLiked() int32 {
-	if x != nil {
-		return x.IsLiked
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromRecipientReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromRecipientReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{83}
 }
 
-type CollectPostReq struct {
+type DeleteGroupApplicationFromRecipientResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID      string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	IsCollected int32  `protobuf:"varint,2,opt,name=isCollected,proto3" json:"isCollected"`
 }
 
-func (x *CollectPostReq) Reset() {
-	*x = CollectPostReq{}
+func (x *DeleteGroupApplicationFromRecipientResp) Reset() {
+	*x = DeleteGroupApplicationFromRecipientResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[86]
+		mi := &file_chat_chat_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CollectPostReq) String() string {
+func (x *DeleteGroupApplicationFromRecipientResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectPostReq) ProtoMessage() {}
+func (*DeleteGroupApplicationFromRecipientResp) ProtoMessage() {}
 
-func (x *CollectPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[86]
+func (x *DeleteGroupApplicationFromRecipientResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5174,50 +5206,34 @@ func (x *CollectPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectPostReq.ProtoReflect.Descriptor instead.
-func (*CollectPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{86}
-}
-
-func (x *CollectPostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *CollectPostReq) GetIsCollected() int32 {
-	if x != nil {
-		return x.IsCollected
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromRecipientResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromRecipientResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{84}
 }
 
-type CollectPostResp struct {
+type DeleteGroupApplicationFromApplicantReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	IsCollected int32 `protobuf:"varint,1,opt,name=isCollected,proto3" json:"isCollected"`
 }
 
-func (x *CollectPostResp) Reset() {
-	*x = CollectPostResp{}
+func (x *DeleteGroupApplicationFromApplicantReq) Reset() {
+	*x = DeleteGroupApplicationFromApplicantReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[87]
+		mi := &file_chat_chat_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CollectPostResp) String() string {
+func (x *DeleteGroupApplicationFromApplicantReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectPostResp) ProtoMessage() {}
+func (*DeleteGroupApplicationFromApplicantReq) ProtoMessage() {}
 
-func (x *CollectPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[87]
+func (x *DeleteGroupApplicationFromApplicantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5228,44 +5244,34 @@ func (x *CollectPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectPostResp.ProtoReflect.Descriptor instead.
-func (*CollectPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *CollectPostResp) GetIsCollected() int32 {
-	if x != nil {
-		return x.IsCollected
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromApplicantReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromApplicantReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{85}
 }
 
-type ForwardPostReq struct {
+type DeleteGroupApplicationFromApplicantResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ForwardPostID string `protobuf:"bytes,1,opt,name=forwardPostID,proto3" json:"forwardPostID"`
-	IsForwarded   int32  `protobuf:"varint,2,opt,name=isForwarded,proto3" json:"isForwarded"`
 }
 
-func (x *ForwardPostReq) Reset() {
-	*x = ForwardPostReq{}
+func (x *DeleteGroupApplicationFromApplicantResp) Reset() {
+	*x = DeleteGroupApplicationFromApplicantResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[88]
+		mi := &file_chat_chat_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ForwardPostReq) String() string {
+func (x *DeleteGroupApplicationFromApplicantResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForwardPostReq) ProtoMessage() {}
+func (*DeleteGroupApplicationFromApplicantResp) ProtoMessage() {}
 
-func (x *ForwardPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[88]
+func (x *DeleteGroupApplicationFromApplicantResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5276,50 +5282,34 @@ func (x *ForwardPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForwardPostReq.ProtoReflect.Descriptor instead.
-func (*ForwardPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{88}
-}
-
-func (x *ForwardPostReq) GetForwardPostID() string {
-	if x != nil {
-		return x.ForwardPostID
-	}
-	return ""
-}
-
-func (x *ForwardPostReq) GetIsForwarded() int32 {
-	if x != nil {
-		return x.IsForwarded
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromApplicantResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromApplicantResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{86}
 }
 
-type ForwardPostResp struct {
+type DeleteGroupApplicationFromAlltReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	IsForwarded int32 `protobuf:"varint,1,opt,name=isForwarded,proto3" json:"isForwarded"`
 }
 
-func (x *ForwardPostResp) Reset() {
-	*x = ForwardPostResp{}
+func (x *DeleteGroupApplicationFromAlltReq) Reset() {
+	*x = DeleteGroupApplicationFromAlltReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[89]
+		mi := &file_chat_chat_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ForwardPostResp) String() string {
+func (x *DeleteGroupApplicationFromAlltReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForwardPostResp) ProtoMessage() {}
+func (*DeleteGroupApplicationFromAlltReq) ProtoMessage() {}
 
-func (x *ForwardPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[89]
+func (x *DeleteGroupApplicationFromAlltReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5330,48 +5320,34 @@ func (x *ForwardPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForwardPostResp.ProtoReflect.Descriptor instead.
-func (*ForwardPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *ForwardPostResp) GetIsForwarded() int32 {
-	if x != nil {
-		return x.IsForwarded
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromAlltReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromAlltReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{87}
 }
 
-type ReferencePostReq struct {
+type DeleteGroupApplicationFromAllResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	RefPostID    string                  `protobuf:"bytes,1,opt,name=refPostID,proto3" json:"refPostID"`
-	Content      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
-	AllowComment int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
-	AtUserIds    []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
-	MediaMsgs    []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
 }
 
-func (x *ReferencePostReq) Reset() {
-	*x = ReferencePostReq{}
+func (x *DeleteGroupApplicationFromAllResp) Reset() {
+	*x = DeleteGroupApplicationFromAllResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[90]
+		mi := &file_chat_chat_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReferencePostReq) String() string {
+func (x *DeleteGroupApplicationFromAllResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReferencePostReq) ProtoMessage() {}
+func (*DeleteGroupApplicationFromAllResp) ProtoMessage() {}
 
-func (x *ReferencePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[90]
+func (x *DeleteGroupApplicationFromAllResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5382,76 +5358,82 @@ func (x *ReferencePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReferencePostReq.ProtoReflect.Descriptor instead.
-func (*ReferencePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use DeleteGroupApplicationFromAllResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromAllResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *ReferencePostReq) GetRefPostID() string {
-	if x != nil {
-		return x.RefPostID
-	}
-	return ""
-}
+type Post struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ReferencePostReq) GetContent() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *ReferencePostReq) GetAllowComment() int32 {
-	if x != nil {
-		return x.AllowComment
-	}
-	return 0
-}
-
-func (x *ReferencePostReq) GetAllowForward() int32 {
-	if x != nil {
-		return x.AllowForward
-	}
-	return 0
-}
-
-func (x *ReferencePostReq) GetAtUserIds() []string {
-	if x != nil {
-		return x.AtUserIds
-	}
-	return nil
-}
-
-func (x *ReferencePostReq) GetMediaMsgs() []*common.PostMedia {
-	if x != nil {
-		return x.MediaMsgs
-	}
-	return nil
-}
-
-type ReferencePostResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	PostID         string                   `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	UserID         string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Content        string                   `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
+	CommentCount   int64                    `protobuf:"varint,4,opt,name=commentCount,proto3" json:"commentCount"`
+	ForwardCount   int64                    `protobuf:"varint,5,opt,name=forwardCount,proto3" json:"forwardCount"`
+	LikeCount      int64                    `protobuf:"varint,6,opt,name=likeCount,proto3" json:"likeCount"`
+	AllowComment   int32                    `protobuf:"varint,7,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward   int32                    `protobuf:"varint,8,opt,name=allowForward,proto3" json:"allowForward"`
+	IsLiked        int32                    `protobuf:"varint,9,opt,name=isLiked,proto3" json:"isLiked"`
+	IsCollected    int32                    `protobuf:"varint,10,opt,name=isCollected,proto3" json:"isCollected"`
+	IsForwarded    int32                    `protobuf:"varint,11,opt,name=isForwarded,proto3" json:"isForwarded"`
+	CreateTime     int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
+	UpdateTime     int64                    `protobuf:"varint,13,opt,name=updateTime,proto3" json:"updateTime"`
+	AtUserIds      []string                 `protobuf:"bytes,14,rep,name=atUserIds,proto3" json:"atUserIds"`
+	UserInfo       *common.UserPublicInfo   `protobuf:"bytes,15,opt,name=userInfo,proto3" json:"userInfo"`
+	MediaMsgs      []*common.PostMedia      `protobuf:"bytes,16,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	AtUserInfoList []*common.UserPublicInfo `protobuf:"bytes,17,rep,name=atUserInfoList,proto3" json:"atUserInfoList"`
+	ForwardPostID  string                   `protobuf:"bytes,18,opt,name=forwardPostID,proto3" json:"forwardPostID"`
+	CommentPostID  string                   `protobuf:"bytes,19,opt,name=commentPostID,proto3" json:"commentPostID"`
+	RefPostID      string                   `protobuf:"bytes,20,opt,name=refPostID,proto3" json:"refPostID"`
+	ForwardPost    *Post                    `protobuf:"bytes,21,opt,name=forwardPost,proto3" json:"forwardPost"`
+	CommentPost    *Post                    `protobuf:"bytes,22,opt,name=commentPost,proto3" json:"commentPost"`
+	RefPost        *Post                    `protobuf:"bytes,23,opt,name=refPost,proto3" json:"refPost"`
+	IsPinned       int32                    `protobuf:"varint,24,opt,name=isPinned,proto3" json:"isPinned"`
+	IsCommented    int32                    `protobuf:"varint,25,opt,name=isCommented,proto3" json:"isCommented"`
+	// 0 public, 1 followers, 2 mutual followers, 3 only the author, 4 visibleUserIDs
+	Visibility int32 `protobuf:"varint,26,opt,name=visibility,proto3" json:"visibility"`
+	// only returned to the author
+	VisibleUserIDs []string `protobuf:"bytes,27,rep,name=visibleUserIDs,proto3" json:"visibleUserIDs"`
+	// 1 once the post has been edited
+	Edited   int32 `protobuf:"varint,28,opt,name=edited,proto3" json:"edited"`
+	EditTime int64 `protobuf:"varint,29,opt,name=editTime,proto3" json:"editTime"`
+	// 1 when a forwarded, commented or referenced post has been deleted, its content is cleared
+	Deleted      int32 `protobuf:"varint,30,opt,name=deleted,proto3" json:"deleted"`
+	DeleteTime   int64 `protobuf:"varint,31,opt,name=deleteTime,proto3" json:"deleteTime"`
+	CollectCount int64 `protobuf:"varint,32,opt,name=collectCount,proto3" json:"collectCount"`
+	ViewCount    int64 `protobuf:"varint,33,opt,name=viewCount,proto3" json:"viewCount"`
+	// the post a comment belongs to, commentPostID is the post or comment it replies to
+	RootPostID string `protobuf:"bytes,34,opt,name=rootPostID,proto3" json:"rootPostID"`
+	// the comment pinned by the author of the post
+	PinnedCommentID string `protobuf:"bytes,35,opt,name=pinnedCommentID,proto3" json:"pinnedCommentID"`
+	// replies of a comment when requested, commentCount of a comment is its reply count
+	Replies []*Post `protobuf:"bytes,36,rep,name=replies,proto3" json:"replies"`
+	// lower case hashtags parsed from the content, without the leading #
+	Hashtags []string `protobuf:"bytes,37,rep,name=hashtags,proto3" json:"hashtags"`
+	// 1 when hidden by an admin, hidden posts are only returned to admins, a forwarded, commented or referenced post that is hidden has its content cleared
+	Hidden int32 `protobuf:"varint,38,opt,name=hidden,proto3" json:"hidden"`
 }
 
-func (x *ReferencePostResp) Reset() {
-	*x = ReferencePostResp{}
+func (x *Post) Reset() {
+	*x = Post{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[91]
+		mi := &file_chat_chat_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReferencePostResp) String() string {
+func (x *Post) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReferencePostResp) ProtoMessage() {}
+func (*Post) ProtoMessage() {}
 
-func (x *ReferencePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[91]
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5462,315 +5444,308 @@ func (x *ReferencePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReferencePostResp.ProtoReflect.Descriptor instead.
-func (*ReferencePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{91}
-}
-
-type CommentPostReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CommentPostID string                  `protobuf:"bytes,1,opt,name=commentPostID,proto3" json:"commentPostID"`
-	Content       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
-	AllowComment  int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward  int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
-	AtUserIds     []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
-	MediaMsgs     []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CommentPostReq) Reset() {
-	*x = CommentPostReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[92]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetPostID() string {
+	if x != nil {
+		return x.PostID
 	}
+	return ""
 }
 
-func (x *CommentPostReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
 }
 
-func (*CommentPostReq) ProtoMessage() {}
-
-func (x *CommentPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[92]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CommentPostReq.ProtoReflect.Descriptor instead.
-func (*CommentPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{92}
+func (x *Post) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
 }
 
-func (x *CommentPostReq) GetCommentPostID() string {
+func (x *Post) GetForwardCount() int64 {
 	if x != nil {
-		return x.CommentPostID
+		return x.ForwardCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CommentPostReq) GetContent() *wrapperspb.StringValue {
+func (x *Post) GetLikeCount() int64 {
 	if x != nil {
-		return x.Content
+		return x.LikeCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CommentPostReq) GetAllowComment() int32 {
+func (x *Post) GetAllowComment() int32 {
 	if x != nil {
 		return x.AllowComment
 	}
 	return 0
 }
 
-func (x *CommentPostReq) GetAllowForward() int32 {
+func (x *Post) GetAllowForward() int32 {
 	if x != nil {
 		return x.AllowForward
 	}
 	return 0
 }
 
-func (x *CommentPostReq) GetAtUserIds() []string {
+func (x *Post) GetIsLiked() int32 {
 	if x != nil {
-		return x.AtUserIds
+		return x.IsLiked
 	}
-	return nil
+	return 0
 }
 
-func (x *CommentPostReq) GetMediaMsgs() []*common.PostMedia {
+func (x *Post) GetIsCollected() int32 {
 	if x != nil {
-		return x.MediaMsgs
+		return x.IsCollected
 	}
-	return nil
+	return 0
 }
 
-type CommentPostResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+func (x *Post) GetIsForwarded() int32 {
+	if x != nil {
+		return x.IsForwarded
+	}
+	return 0
 }
 
-func (x *CommentPostResp) Reset() {
-	*x = CommentPostResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[93]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
 	}
+	return 0
 }
 
-func (x *CommentPostResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
 }
 
-func (*CommentPostResp) ProtoMessage() {}
-
-func (x *CommentPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[93]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CommentPostResp.ProtoReflect.Descriptor instead.
-func (*CommentPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{93}
+func (x *Post) GetUserInfo() *common.UserPublicInfo {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
 }
 
-func (x *CommentPostResp) GetPost() *Post {
+func (x *Post) GetMediaMsgs() []*common.PostMedia {
 	if x != nil {
-		return x.Post
+		return x.MediaMsgs
 	}
 	return nil
 }
 
-type PinPostReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Post) GetAtUserInfoList() []*common.UserPublicInfo {
+	if x != nil {
+		return x.AtUserInfoList
+	}
+	return nil
+}
 
-	PostID   string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	IsPinned int32  `protobuf:"varint,2,opt,name=isPinned,proto3" json:"isPinned"`
+func (x *Post) GetForwardPostID() string {
+	if x != nil {
+		return x.ForwardPostID
+	}
+	return ""
 }
 
-func (x *PinPostReq) Reset() {
-	*x = PinPostReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[94]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetCommentPostID() string {
+	if x != nil {
+		return x.CommentPostID
 	}
+	return ""
 }
 
-func (x *PinPostReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetRefPostID() string {
+	if x != nil {
+		return x.RefPostID
+	}
+	return ""
 }
 
-func (*PinPostReq) ProtoMessage() {}
+func (x *Post) GetForwardPost() *Post {
+	if x != nil {
+		return x.ForwardPost
+	}
+	return nil
+}
 
-func (x *PinPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[94]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetCommentPost() *Post {
+	if x != nil {
+		return x.CommentPost
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PinPostReq.ProtoReflect.Descriptor instead.
-func (*PinPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{94}
+	return nil
 }
 
-func (x *PinPostReq) GetPostID() string {
+func (x *Post) GetRefPost() *Post {
 	if x != nil {
-		return x.PostID
+		return x.RefPost
 	}
-	return ""
+	return nil
 }
 
-func (x *PinPostReq) GetIsPinned() int32 {
+func (x *Post) GetIsPinned() int32 {
 	if x != nil {
 		return x.IsPinned
 	}
 	return 0
 }
 
-type PinPostResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Post) GetIsCommented() int32 {
+	if x != nil {
+		return x.IsCommented
+	}
+	return 0
 }
 
-func (x *PinPostResp) Reset() {
-	*x = PinPostResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[95]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
 	}
+	return 0
 }
 
-func (x *PinPostResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetVisibleUserIDs() []string {
+	if x != nil {
+		return x.VisibleUserIDs
+	}
+	return nil
 }
 
-func (*PinPostResp) ProtoMessage() {}
+func (x *Post) GetEdited() int32 {
+	if x != nil {
+		return x.Edited
+	}
+	return 0
+}
 
-func (x *PinPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[95]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PinPostResp.ProtoReflect.Descriptor instead.
-func (*PinPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{95}
+func (x *Post) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
 }
 
-type CheckVersionReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Post) GetDeleteTime() int64 {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return 0
+}
 
-	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language"`
+func (x *Post) GetCollectCount() int64 {
+	if x != nil {
+		return x.CollectCount
+	}
+	return 0
 }
 
-func (x *CheckVersionReq) Reset() {
-	*x = CheckVersionReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[96]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
 	}
+	return 0
 }
 
-func (x *CheckVersionReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetRootPostID() string {
+	if x != nil {
+		return x.RootPostID
+	}
+	return ""
 }
 
-func (*CheckVersionReq) ProtoMessage() {}
+func (x *Post) GetPinnedCommentID() string {
+	if x != nil {
+		return x.PinnedCommentID
+	}
+	return ""
+}
 
-func (x *CheckVersionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[96]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetReplies() []*Post {
+	if x != nil {
+		return x.Replies
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CheckVersionReq.ProtoReflect.Descriptor instead.
-func (*CheckVersionReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{96}
+func (x *Post) GetHashtags() []string {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
 }
 
-func (x *CheckVersionReq) GetLanguage() string {
+func (x *Post) GetHidden() int32 {
 	if x != nil {
-		return x.Language
+		return x.Hidden
 	}
-	return ""
+	return 0
 }
 
-type CheckVersionResp struct {
+type PublishPostReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BuildVersion                string   `protobuf:"bytes,1,opt,name=buildVersion,proto3" json:"buildVersion"`
-	DownloadURL                 string   `protobuf:"bytes,2,opt,name=downloadURL,proto3" json:"downloadURL"`
-	BuildUpdateTitle            string   `protobuf:"bytes,3,opt,name=buildUpdateTitle,proto3" json:"buildUpdateTitle"`
-	BuildUpdateDescriptionTitle string   `protobuf:"bytes,4,opt,name=buildUpdateDescriptionTitle,proto3" json:"buildUpdateDescriptionTitle"`
-	BuildUpdateDescriptions     []string `protobuf:"bytes,5,rep,name=buildUpdateDescriptions,proto3" json:"buildUpdateDescriptions"`
-	NeedForceUpdate             bool     `protobuf:"varint,6,opt,name=needForceUpdate,proto3" json:"needForceUpdate"`
+	Content        *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=content,proto3" json:"content"`
+	AllowComment   int32                   `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward   int32                   `protobuf:"varint,3,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds      []string                `protobuf:"bytes,4,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs      []*common.PostMedia     `protobuf:"bytes,5,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Visibility     int32                   `protobuf:"varint,6,opt,name=visibility,proto3" json:"visibility"`
+	VisibleUserIDs []string                `protobuf:"bytes,7,rep,name=visibleUserIDs,proto3" json:"visibleUserIDs"`
 }
 
-func (x *CheckVersionResp) Reset() {
-	*x = CheckVersionResp{}
+func (x *PublishPostReq) Reset() {
+	*x = PublishPostReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[97]
+		mi := &file_chat_chat_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckVersionResp) String() string {
+func (x *PublishPostReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckVersionResp) ProtoMessage() {}
+func (*PublishPostReq) ProtoMessage() {}
 
-func (x *CheckVersionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[97]
+func (x *PublishPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5781,76 +5756,85 @@ func (x *CheckVersionResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckVersionResp.ProtoReflect.Descriptor instead.
-func (*CheckVersionResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use PublishPostReq.ProtoReflect.Descriptor instead.
+func (*PublishPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *CheckVersionResp) GetBuildVersion() string {
+func (x *PublishPostReq) GetContent() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BuildVersion
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckVersionResp) GetDownloadURL() string {
+func (x *PublishPostReq) GetAllowComment() int32 {
 	if x != nil {
-		return x.DownloadURL
+		return x.AllowComment
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckVersionResp) GetBuildUpdateTitle() string {
+func (x *PublishPostReq) GetAllowForward() int32 {
 	if x != nil {
-		return x.BuildUpdateTitle
+		return x.AllowForward
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckVersionResp) GetBuildUpdateDescriptionTitle() string {
+func (x *PublishPostReq) GetAtUserIds() []string {
 	if x != nil {
-		return x.BuildUpdateDescriptionTitle
+		return x.AtUserIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckVersionResp) GetBuildUpdateDescriptions() []string {
+func (x *PublishPostReq) GetMediaMsgs() []*common.PostMedia {
 	if x != nil {
-		return x.BuildUpdateDescriptions
+		return x.MediaMsgs
 	}
 	return nil
 }
 
-func (x *CheckVersionResp) GetNeedForceUpdate() bool {
+func (x *PublishPostReq) GetVisibility() int32 {
 	if x != nil {
-		return x.NeedForceUpdate
+		return x.Visibility
 	}
-	return false
+	return 0
 }
 
-type GetFakeUserReq struct {
+func (x *PublishPostReq) GetVisibleUserIDs() []string {
+	if x != nil {
+		return x.VisibleUserIDs
+	}
+	return nil
+}
+
+type PublishPostResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
 }
 
-func (x *GetFakeUserReq) Reset() {
-	*x = GetFakeUserReq{}
+func (x *PublishPostResp) Reset() {
+	*x = PublishPostResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[98]
+		mi := &file_chat_chat_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFakeUserReq) String() string {
+func (x *PublishPostResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFakeUserReq) ProtoMessage() {}
+func (*PublishPostResp) ProtoMessage() {}
 
-func (x *GetFakeUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[98]
+func (x *PublishPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5861,37 +5845,43 @@ func (x *GetFakeUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFakeUserReq.ProtoReflect.Descriptor instead.
-func (*GetFakeUserReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use PublishPostResp.ProtoReflect.Descriptor instead.
+func (*PublishPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{91}
 }
 
-type GetFakeUserResp struct {
+func (x *PublishPostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetPostByIDReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Online int32 `protobuf:"varint,1,opt,name=online,proto3" json:"online"`
-	Total  int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *GetFakeUserResp) Reset() {
-	*x = GetFakeUserResp{}
+func (x *GetPostByIDReq) Reset() {
+	*x = GetPostByIDReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[99]
+		mi := &file_chat_chat_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFakeUserResp) String() string {
+func (x *GetPostByIDReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFakeUserResp) ProtoMessage() {}
+func (*GetPostByIDReq) ProtoMessage() {}
 
-func (x *GetFakeUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[99]
+func (x *GetPostByIDReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5902,1269 +5892,7796 @@ func (x *GetFakeUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFakeUserResp.ProtoReflect.Descriptor instead.
-func (*GetFakeUserResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{99}
-}
-
-func (x *GetFakeUserResp) GetOnline() int32 {
-	if x != nil {
-		return x.Online
-	}
-	return 0
+// Deprecated: Use GetPostByIDReq.ProtoReflect.Descriptor instead.
+func (*GetPostByIDReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetFakeUserResp) GetTotal() int32 {
+func (x *GetPostByIDReq) GetPostID() string {
 	if x != nil {
-		return x.Total
+		return x.PostID
 	}
-	return 0
+	return ""
 }
 
-var File_chat_chat_proto protoreflect.FileDescriptor
-
-var file_chat_chat_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x1a, 0x13,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77,
-	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x0a, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2b, 0x0a, 0x0f, 0x67, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x69,
-	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0x7a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x05, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x38, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x66,
-	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x32, 0x0a,
-	0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x75,
-	0x74, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42,
-	0x65, 0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x65,
-	0x70, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x67,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x12,
-	0x3f, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0xf0, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
-	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61,
-	0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52,
-	0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12,
-	0x32, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62,
-	0x6f, 0x75, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x50, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
-	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x18, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x2f, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x22, 0x49, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x12, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
-	0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
-	0xf1, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12,
-	0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22,
-	0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x31, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x22, 0x2a, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x22, 0xd6, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63,
-	0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xbe, 0x01, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xa2, 0x01, 0x0a,
-	0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x77, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x53, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x46,
-	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70,
-	0x1a, 0x41, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a,
-	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7,
-	0x03, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63,
-	0x76, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49,
-	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63,
-	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
-	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a,
-	0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x22, 0x41, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x80, 0x05, 0x0a, 0x12,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
-	0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x44, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x65, 0x72, 0x72, 0x44, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44,
-	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x2a,
-	0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x73, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x0e,
-	0x0a, 0x02, 0x65, 0x78, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0xa5,
-	0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x22, 0x61, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x40, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a,
-	0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
-	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0xa3, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x22, 0x51, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46,
-	0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x50,
-	0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
-	0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
-	0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x22,
-	0x35, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x73,
-	0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x1c,
-	0x0a, 0x1a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f,
-	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x26,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x29, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x28, 0x0a, 0x26, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x29, 0x0a, 0x27, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x22, 0x23, 0x0a, 0x21, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0xb3, 0x07, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
-	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69,
-	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73,
-	0x12, 0x45, 0x0a, 0x0e, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x33, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x72,
-	0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x69,
-	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69,
-	0x6e, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x4d, 0x73, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x22,
-	0x38, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x29, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32,
-	0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x22, 0x68, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
-	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70,
-	0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70,
-	0x6f, 0x73, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x1d,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
-	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x1e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a,
-	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22,
-	0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0x33, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22,
-	0x3f, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64,
-	0x22, 0x28, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
-	0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0e, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a,
-	0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
-	0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x36, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d,
-	0x73, 0x67, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8c, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
-	0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
-	0x74, 0x22, 0x40, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x22, 0xaa, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x10,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x1b, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x63,
-	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e,
-	0x65, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x10,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x32, 0x8b, 0x20, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a,
-	0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x63, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x72, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a,
-	0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
-	0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x46,
-	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x54, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a,
-	0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x6c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d,
-	0x0a, 0x12, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a,
-	0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
-	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x67, 0x65,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x94, 0x01, 0x0a, 0x27, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x94, 0x01, 0x0a, 0x27, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x33, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x21, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2e,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2e,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e,
-	0x0a, 0x0d, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12,
-	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48,
-	0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
-	0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f,
-	0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x45, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48,
-	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x42,
-	0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type GetPostByIDResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
 }
 
-var (
-	file_chat_chat_proto_rawDescOnce sync.Once
-	file_chat_chat_proto_rawDescData = file_chat_chat_proto_rawDesc
-)
+func (x *GetPostByIDResp) Reset() {
+	*x = GetPostByIDResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_chat_chat_proto_rawDescGZIP() []byte {
-	file_chat_chat_proto_rawDescOnce.Do(func() {
-		file_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_chat_proto_rawDescData)
-	})
-	return file_chat_chat_proto_rawDescData
+func (x *GetPostByIDResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
-var file_chat_chat_proto_goTypes = []any{
-	(*OnlineTime)(nil),                              // 0: openim.chat.onlineTime
-	(*GetUsersTimeReq)(nil),                         // 1: openim.chat.getUsersTimeReq
-	(*GetUsersTimeResp)(nil),                        // 2: openim.chat.getUsersTimeResp
-	(*UserIdentity)(nil),                            // 3: openim.chat.UserIdentity
-	(*UpdateUserInfoReq)(nil),                       // 4: openim.chat.UpdateUserInfoReq
-	(*UpdateUserInfoResp)(nil),                      // 5: openim.chat.UpdateUserInfoResp
-	(*FindUserPublicInfoReq)(nil),                   // 6: openim.chat.FindUserPublicInfoReq
-	(*FindUserPublicInfoRespOfOne)(nil),             // 7: openim.chat.FindUserPublicInfoRespOfOne
-	(*FindUserPublicInfoResp)(nil),                  // 8: openim.chat.FindUserPublicInfoResp
-	(*SearchUserPublicInfoReq)(nil),                 // 9: openim.chat.SearchUserPublicInfoReq
-	(*SearchUserPublicInfoResp)(nil),                // 10: openim.chat.SearchUserPublicInfoResp
-	(*FindUserFullInfoReq)(nil),                     // 11: openim.chat.FindUserFullInfoReq
-	(*FindUserFullInfoResp)(nil),                    // 12: openim.chat.FindUserFullInfoResp
-	(*GetAllUserIDsReq)(nil),                        // 13: openim.chat.GetAllUserIDsReq
-	(*GetAllUserIDsResp)(nil),                       // 14: openim.chat.GetAllUserIDsResp
-	(*SendVerifyCodeReq)(nil),                       // 15: openim.chat.SendVerifyCodeReq
-	(*SendVerifyCodeResp)(nil),                      // 16: openim.chat.SendVerifyCodeResp
-	(*VerifyCodeReq)(nil),                           // 17: openim.chat.VerifyCodeReq
-	(*VerifyCodeResp)(nil),                          // 18: openim.chat.VerifyCodeResp
-	(*ChallengeNonceReq)(nil),                       // 19: openim.chat.ChallengeNonceReq
-	(*ChallengeNonceResp)(nil),                      // 20: openim.chat.ChallengeNonceResp
-	(*RegisterUserInfo)(nil),                        // 21: openim.chat.RegisterUserInfo
-	(*RegisterUserReq)(nil),                         // 22: openim.chat.RegisterUserReq
-	(*RegisterUserResp)(nil),                        // 23: openim.chat.RegisterUserResp
-	(*AddUserAccountReq)(nil),                       // 24: openim.chat.AddUserAccountReq
-	(*AddUserAccountResp)(nil),                      // 25: openim.chat.AddUserAccountResp
-	(*LoginReq)(nil),                                // 26: openim.chat.LoginReq
-	(*ResetPasswordReq)(nil),                        // 27: openim.chat.ResetPasswordReq
-	(*ResetPasswordResp)(nil),                       // 28: openim.chat.ResetPasswordResp
-	(*ChangePasswordReq)(nil),                       // 29: openim.chat.ChangePasswordReq
-	(*ChangePasswordResp)(nil),                      // 30: openim.chat.ChangePasswordResp
-	(*FindUserAccountReq)(nil),                      // 31: openim.chat.FindUserAccountReq
-	(*FindUserByAddressOrAccountReq)(nil),           // 32: openim.chat.FindUserByAddressOrAccountReq
-	(*FindUserAccountResp)(nil),                     // 33: openim.chat.FindUserAccountResp
-	(*FindAccountUserReq)(nil),                      // 34: openim.chat.FindAccountUserReq
-	(*FindAccountUserResp)(nil),                     // 35: openim.chat.FindAccountUserResp
-	(*SignalRecord)(nil),                            // 36: openim.chat.SignalRecord
-	(*OpenIMCallbackReq)(nil),                       // 37: openim.chat.OpenIMCallbackReq
-	(*OpenIMCallbackResp)(nil),                      // 38: openim.chat.OpenIMCallbackResp
-	(*SearchUserFullInfoReq)(nil),                   // 39: openim.chat.SearchUserFullInfoReq
-	(*SearchUserFullInfoResp)(nil),                  // 40: openim.chat.SearchUserFullInfoResp
-	(*UserLoginCountReq)(nil),                       // 41: openim.chat.UserLoginCountReq
-	(*UserLoginCountResp)(nil),                      // 42: openim.chat.UserLoginCountResp
-	(*LoginResp)(nil),                               // 43: openim.chat.LoginResp
-	(*SearchUserInfoReq)(nil),                       // 44: openim.chat.SearchUserInfoReq
-	(*SearchUserInfoResp)(nil),                      // 45: openim.chat.SearchUserInfoResp
-	(*GetTokenForVideoMeetingReq)(nil),              // 46: openim.chat.GetTokenForVideoMeetingReq
-	(*GetTokenForVideoMeetingResp)(nil),             // 47: openim.chat.GetTokenForVideoMeetingResp
-	(*CheckUserExistReq)(nil),                       // 48: openim.chat.CheckUserExistReq
-	(*CheckUserExistResp)(nil),                      // 49: openim.chat.CheckUserExistResp
-	(*DelUserAccountReq)(nil),                       // 50: openim.chat.DelUserAccountReq
-	(*DelUserAccountResp)(nil),                      // 51: openim.chat.DelUserAccountResp
-	(*GetGroupFromContactReq)(nil),                  // 52: openim.chat.getGroupFromContactReq
-	(*GetGroupFromContactResp)(nil),                 // 53: openim.chat.getGroupFromContactResp
-	(*SaveGroupToContactReq)(nil),                   // 54: openim.chat.saveGroupToContactReq
-	(*SaveGroupToContactResp)(nil),                  // 55: openim.chat.saveGroupToContactResp
-	(*DeleteGroupFromContactReq)(nil),               // 56: openim.chat.deleteGroupFromContactReq
-	(*DeleteGroupFromContactResp)(nil),              // 57: openim.chat.deleteGroupFromContactResp
-	(*DeleteGroupApplicationFromRecipientReq)(nil),  // 58: openim.chat.DeleteGroupApplicationFromRecipientReq
-	(*DeleteGroupApplicationFromRecipientResp)(nil), // 59: openim.chat.DeleteGroupApplicationFromRecipientResp
-	(*DeleteGroupApplicationFromApplicantReq)(nil),  // 60: openim.chat.DeleteGroupApplicationFromApplicantReq
-	(*DeleteGroupApplicationFromApplicantResp)(nil), // 61: openim.chat.DeleteGroupApplicationFromApplicantResp
-	(*DeleteGroupApplicationFromAlltReq)(nil),       // 62: openim.chat.DeleteGroupApplicationFromAlltReq
-	(*DeleteGroupApplicationFromAllResp)(nil),       // 63: openim.chat.DeleteGroupApplicationFromAllResp
-	(*Post)(nil),                                    // 64: openim.chat.Post
-	(*PublishPostReq)(nil),                          // 65: openim.chat.PublishPostReq
-	(*PublishPostResp)(nil),                         // 66: openim.chat.PublishPostResp
-	(*GetPostByIDReq)(nil),                          // 67: openim.chat.GetPostByIDReq
-	(*GetPostByIDResp)(nil),                         // 68: openim.chat.GetPostByIDResp
-	(*GetAllTypePostReq)(nil),                       // 69: openim.chat.GetAllTypePostReq
-	(*GetAllTypePostResp)(nil),                      // 70: openim.chat.GetAllTypePostResp
-	(*TypePosts)(nil),                               // 71: openim.chat.TypePosts
-	(*GetPostListReq)(nil),                          // 72: openim.chat.GetPostListReq
-	(*GetPostListResp)(nil),                         // 73: openim.chat.GetPostListResp
-	(*GetPostListByUserReq)(nil),                    // 74: openim.chat.GetPostListByUserReq
-	(*GetPostListByUserResp)(nil),                   // 75: openim.chat.GetPostListByUserResp
-	(*GetCommentPostListByPostIDReq)(nil),           // 76: openim.chat.GetCommentPostListByPostIDReq
-	(*GetCommentPostListByPostIDResp)(nil),          // 77: openim.chat.GetCommentPostListByPostIDResp
-	(*DeletePostReq)(nil),                           // 78: openim.chat.DeletePostReq
-	(*DeletePostResp)(nil),                          // 79: openim.chat.DeletePostResp
-	(*ChangeAllowCommentPostReq)(nil),               // 80: openim.chat.ChangeAllowCommentPostReq
-	(*ChangeAllowCommentPostResp)(nil),              // 81: openim.chat.ChangeAllowCommentPostResp
-	(*ChangeAllowForwardPostReq)(nil),               // 82: openim.chat.ChangeAllowForwardPostReq
-	(*ChangeAllowForwardPostResp)(nil),              // 83: openim.chat.ChangeAllowForwardPostResp
-	(*LikePostReq)(nil),                             // 84: openim.chat.LikePostReq
-	(*LikePostResp)(nil),                            // 85: openim.chat.LikePostResp
-	(*CollectPostReq)(nil),                          // 86: openim.chat.CollectPostReq
-	(*CollectPostResp)(nil),                         // 87: openim.chat.CollectPostResp
-	(*ForwardPostReq)(nil),                          // 88: openim.chat.ForwardPostReq
-	(*ForwardPostResp)(nil),                         // 89: openim.chat.ForwardPostResp
-	(*ReferencePostReq)(nil),                        // 90: openim.chat.ReferencePostReq
-	(*ReferencePostResp)(nil),                       // 91: openim.chat.ReferencePostResp
-	(*CommentPostReq)(nil),                          // 92: openim.chat.CommentPostReq
-	(*CommentPostResp)(nil),                         // 93: openim.chat.CommentPostResp
-	(*PinPostReq)(nil),                              // 94: openim.chat.PinPostReq
-	(*PinPostResp)(nil),                             // 95: openim.chat.PinPostResp
-	(*CheckVersionReq)(nil),                         // 96: openim.chat.CheckVersionReq
-	(*CheckVersionResp)(nil),                        // 97: openim.chat.CheckVersionResp
-	(*GetFakeUserReq)(nil),                          // 98: openim.chat.GetFakeUserReq
-	(*GetFakeUserResp)(nil),                         // 99: openim.chat.GetFakeUserResp
-	nil,                                             // 100: openim.chat.FindUserAccountResp.UserAccountMapEntry
-	nil,                                             // 101: openim.chat.FindAccountUserResp.AccountUserMapEntry
-	nil,                                             // 102: openim.chat.UserLoginCountResp.CountEntry
-	(*wrapperspb.StringValue)(nil),                  // 103: openim.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),                   // 104: openim.protobuf.Int32Value
-	(*common.UserPublicInfo)(nil),                   // 105: openim.common.UserPublicInfo
-	(*sdkwss.RequestPagination)(nil),                // 106: openim.sdkwss.RequestPagination
-	(*common.UserFullInfo)(nil),                     // 107: openim.common.UserFullInfo
-	(*common.PostMedia)(nil),                        // 108: openim.common.PostMedia
+func (*GetPostByIDResp) ProtoMessage() {}
+
+func (x *GetPostByIDResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_chat_chat_proto_depIdxs = []int32{
-	0,   // 0: openim.chat.getUsersTimeResp.timeList:type_name -> openim.chat.onlineTime
-	103, // 1: openim.chat.UpdateUserInfoReq.account:type_name -> openim.protobuf.StringValue
-	103, // 2: openim.chat.UpdateUserInfoReq.nickname:type_name -> openim.protobuf.StringValue
-	103, // 3: openim.chat.UpdateUserInfoReq.faceURL:type_name -> openim.protobuf.StringValue
-	103, // 4: openim.chat.UpdateUserInfoReq.coverURL:type_name -> openim.protobuf.StringValue
-	103, // 5: openim.chat.UpdateUserInfoReq.about:type_name -> openim.protobuf.StringValue
-	104, // 6: openim.chat.UpdateUserInfoReq.allowAddFriend:type_name -> openim.protobuf.Int32Value
-	104, // 7: openim.chat.UpdateUserInfoReq.allowBeep:type_name -> openim.protobuf.Int32Value
-	104, // 8: openim.chat.UpdateUserInfoReq.allowVibration:type_name -> openim.protobuf.Int32Value
-	104, // 9: openim.chat.UpdateUserInfoReq.globalRecvMsgOpt:type_name -> openim.protobuf.Int32Value
-	104, // 10: openim.chat.UpdateUserInfoReq.RegisterType:type_name -> openim.protobuf.Int32Value
-	103, // 11: openim.chat.UpdateUserInfoResp.faceURL:type_name -> openim.protobuf.StringValue
-	103, // 12: openim.chat.UpdateUserInfoResp.coverURL:type_name -> openim.protobuf.StringValue
-	103, // 13: openim.chat.UpdateUserInfoResp.about:type_name -> openim.protobuf.StringValue
-	105, // 14: openim.chat.FindUserPublicInfoRespOfOne.user:type_name -> openim.common.UserPublicInfo
-	105, // 15: openim.chat.FindUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
-	106, // 16: openim.chat.SearchUserPublicInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	105, // 17: openim.chat.SearchUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
-	107, // 18: openim.chat.FindUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
-	21,  // 19: openim.chat.RegisterUserReq.user:type_name -> openim.chat.RegisterUserInfo
-	21,  // 20: openim.chat.AddUserAccountReq.user:type_name -> openim.chat.RegisterUserInfo
-	100, // 21: openim.chat.FindUserAccountResp.userAccountMap:type_name -> openim.chat.FindUserAccountResp.UserAccountMapEntry
-	101, // 22: openim.chat.FindAccountUserResp.accountUserMap:type_name -> openim.chat.FindAccountUserResp.AccountUserMapEntry
-	105, // 23: openim.chat.SignalRecord.inviterUserList:type_name -> openim.common.UserPublicInfo
-	106, // 24: openim.chat.SearchUserFullInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	107, // 25: openim.chat.SearchUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
-	102, // 26: openim.chat.UserLoginCountResp.count:type_name -> openim.chat.UserLoginCountResp.CountEntry
-	106, // 27: openim.chat.SearchUserInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	107, // 28: openim.chat.SearchUserInfoResp.users:type_name -> openim.common.UserFullInfo
-	21,  // 29: openim.chat.CheckUserExistReq.user:type_name -> openim.chat.RegisterUserInfo
-	105, // 30: openim.chat.Post.userInfo:type_name -> openim.common.UserPublicInfo
-	108, // 31: openim.chat.Post.mediaMsgs:type_name -> openim.common.PostMedia
-	105, // 32: openim.chat.Post.atUserInfoList:type_name -> openim.common.UserPublicInfo
-	64,  // 33: openim.chat.Post.forwardPost:type_name -> openim.chat.Post
-	64,  // 34: openim.chat.Post.commentPost:type_name -> openim.chat.Post
-	64,  // 35: openim.chat.Post.refPost:type_name -> openim.chat.Post
-	103, // 36: openim.chat.PublishPostReq.content:type_name -> openim.protobuf.StringValue
-	108, // 37: openim.chat.PublishPostReq.mediaMsgs:type_name -> openim.common.PostMedia
-	64,  // 38: openim.chat.PublishPostResp.post:type_name -> openim.chat.Post
-	64,  // 39: openim.chat.GetPostByIDResp.post:type_name -> openim.chat.Post
-	71,  // 40: openim.chat.GetAllTypePostResp.allPosts:type_name -> openim.chat.TypePosts
-	64,  // 41: openim.chat.TypePosts.posts:type_name -> openim.chat.Post
-	64,  // 42: openim.chat.GetPostListResp.posts:type_name -> openim.chat.Post
-	64,  // 43: openim.chat.GetPostListByUserResp.posts:type_name -> openim.chat.Post
-	64,  // 44: openim.chat.GetCommentPostListByPostIDResp.posts:type_name -> openim.chat.Post
-	103, // 45: openim.chat.ReferencePostReq.content:type_name -> openim.protobuf.StringValue
-	108, // 46: openim.chat.ReferencePostReq.mediaMsgs:type_name -> openim.common.PostMedia
-	103, // 47: openim.chat.CommentPostReq.content:type_name -> openim.protobuf.StringValue
-	108, // 48: openim.chat.CommentPostReq.mediaMsgs:type_name -> openim.common.PostMedia
-	64,  // 49: openim.chat.CommentPostResp.post:type_name -> openim.chat.Post
-	4,   // 50: openim.chat.chat.UpdateUserInfo:input_type -> openim.chat.UpdateUserInfoReq
-	24,  // 51: openim.chat.chat.AddUserAccount:input_type -> openim.chat.AddUserAccountReq
-	9,   // 52: openim.chat.chat.SearchUserPublicInfo:input_type -> openim.chat.SearchUserPublicInfoReq
-	6,   // 53: openim.chat.chat.FindUserPublicInfo:input_type -> openim.chat.FindUserPublicInfoReq
-	32,  // 54: openim.chat.chat.FindUserByAddressOrAccount:input_type -> openim.chat.FindUserByAddressOrAccountReq
-	39,  // 55: openim.chat.chat.SearchUserFullInfo:input_type -> openim.chat.SearchUserFullInfoReq
-	11,  // 56: openim.chat.chat.FindUserFullInfo:input_type -> openim.chat.FindUserFullInfoReq
-	15,  // 57: openim.chat.chat.SendVerifyCode:input_type -> openim.chat.SendVerifyCodeReq
-	17,  // 58: openim.chat.chat.VerifyCode:input_type -> openim.chat.VerifyCodeReq
-	19,  // 59: openim.chat.chat.ChallengeNonce:input_type -> openim.chat.ChallengeNonceReq
-	22,  // 60: openim.chat.chat.RegisterUser:input_type -> openim.chat.RegisterUserReq
-	26,  // 61: openim.chat.chat.Login:input_type -> openim.chat.LoginReq
-	27,  // 62: openim.chat.chat.ResetPassword:input_type -> openim.chat.ResetPasswordReq
-	29,  // 63: openim.chat.chat.ChangePassword:input_type -> openim.chat.ChangePasswordReq
-	48,  // 64: openim.chat.chat.CheckUserExist:input_type -> openim.chat.CheckUserExistReq
-	50,  // 65: openim.chat.chat.DelUserAccount:input_type -> openim.chat.DelUserAccountReq
-	31,  // 66: openim.chat.chat.FindUserAccount:input_type -> openim.chat.FindUserAccountReq
-	34,  // 67: openim.chat.chat.FindAccountUser:input_type -> openim.chat.FindAccountUserReq
-	37,  // 68: openim.chat.chat.OpenIMCallback:input_type -> openim.chat.OpenIMCallbackReq
-	41,  // 69: openim.chat.chat.UserLoginCount:input_type -> openim.chat.UserLoginCountReq
-	44,  // 70: openim.chat.chat.SearchUserInfo:input_type -> openim.chat.SearchUserInfoReq
-	46,  // 71: openim.chat.chat.GetTokenForVideoMeeting:input_type -> openim.chat.GetTokenForVideoMeetingReq
-	54,  // 72: openim.chat.chat.saveGroupToContact:input_type -> openim.chat.saveGroupToContactReq
-	56,  // 73: openim.chat.chat.deleteGroupFromContact:input_type -> openim.chat.deleteGroupFromContactReq
-	52,  // 74: openim.chat.chat.getGroupFromContact:input_type -> openim.chat.getGroupFromContactReq
-	58,  // 75: openim.chat.chat.deleteUserGroupApplicationFromRecipient:input_type -> openim.chat.DeleteGroupApplicationFromRecipientReq
-	60,  // 76: openim.chat.chat.deleteUserGroupApplicationFromApplicant:input_type -> openim.chat.DeleteGroupApplicationFromApplicantReq
-	62,  // 77: openim.chat.chat.deleteUserGroupApplicationFromAll:input_type -> openim.chat.DeleteGroupApplicationFromAlltReq
-	13,  // 78: openim.chat.chat.getAllUserIDs:input_type -> openim.chat.GetAllUserIDsReq
-	65,  // 79: openim.chat.chat.PublishPost:input_type -> openim.chat.PublishPostReq
-	69,  // 80: openim.chat.chat.GetAllTypePost:input_type -> openim.chat.GetAllTypePostReq
-	72,  // 81: openim.chat.chat.GetPostList:input_type -> openim.chat.GetPostListReq
-	74,  // 82: openim.chat.chat.GetPostListByUser:input_type -> openim.chat.GetPostListByUserReq
-	76,  // 83: openim.chat.chat.GetCommentPostListByPostID:input_type -> openim.chat.GetCommentPostListByPostIDReq
-	67,  // 84: openim.chat.chat.GetPostByID:input_type -> openim.chat.GetPostByIDReq
-	78,  // 85: openim.chat.chat.DeletePost:input_type -> openim.chat.DeletePostReq
-	80,  // 86: openim.chat.chat.ChangeAllowCommentPost:input_type -> openim.chat.ChangeAllowCommentPostReq
-	82,  // 87: openim.chat.chat.ChangeAllowForwardPost:input_type -> openim.chat.ChangeAllowForwardPostReq
-	84,  // 88: openim.chat.chat.ChangeLikePost:input_type -> openim.chat.LikePostReq
-	86,  // 89: openim.chat.chat.ChangeCollectPost:input_type -> openim.chat.CollectPostReq
-	94,  // 90: openim.chat.chat.PinPost:input_type -> openim.chat.PinPostReq
-	88,  // 91: openim.chat.chat.ForwardPost:input_type -> openim.chat.ForwardPostReq
-	92,  // 92: openim.chat.chat.CommentPost:input_type -> openim.chat.CommentPostReq
-	90,  // 93: openim.chat.chat.ReferencePost:input_type -> openim.chat.ReferencePostReq
-	96,  // 94: openim.chat.chat.CheckVersion:input_type -> openim.chat.CheckVersionReq
-	98,  // 95: openim.chat.chat.GetFakeUser:input_type -> openim.chat.GetFakeUserReq
-	5,   // 96: openim.chat.chat.UpdateUserInfo:output_type -> openim.chat.UpdateUserInfoResp
-	25,  // 97: openim.chat.chat.AddUserAccount:output_type -> openim.chat.AddUserAccountResp
-	10,  // 98: openim.chat.chat.SearchUserPublicInfo:output_type -> openim.chat.SearchUserPublicInfoResp
-	8,   // 99: openim.chat.chat.FindUserPublicInfo:output_type -> openim.chat.FindUserPublicInfoResp
-	7,   // 100: openim.chat.chat.FindUserByAddressOrAccount:output_type -> openim.chat.FindUserPublicInfoRespOfOne
-	40,  // 101: openim.chat.chat.SearchUserFullInfo:output_type -> openim.chat.SearchUserFullInfoResp
-	12,  // 102: openim.chat.chat.FindUserFullInfo:output_type -> openim.chat.FindUserFullInfoResp
-	16,  // 103: openim.chat.chat.SendVerifyCode:output_type -> openim.chat.SendVerifyCodeResp
-	18,  // 104: openim.chat.chat.VerifyCode:output_type -> openim.chat.VerifyCodeResp
-	20,  // 105: openim.chat.chat.ChallengeNonce:output_type -> openim.chat.ChallengeNonceResp
-	23,  // 106: openim.chat.chat.RegisterUser:output_type -> openim.chat.RegisterUserResp
-	43,  // 107: openim.chat.chat.Login:output_type -> openim.chat.LoginResp
-	28,  // 108: openim.chat.chat.ResetPassword:output_type -> openim.chat.ResetPasswordResp
-	30,  // 109: openim.chat.chat.ChangePassword:output_type -> openim.chat.ChangePasswordResp
-	49,  // 110: openim.chat.chat.CheckUserExist:output_type -> openim.chat.CheckUserExistResp
-	51,  // 111: openim.chat.chat.DelUserAccount:output_type -> openim.chat.DelUserAccountResp
-	33,  // 112: openim.chat.chat.FindUserAccount:output_type -> openim.chat.FindUserAccountResp
-	35,  // 113: openim.chat.chat.FindAccountUser:output_type -> openim.chat.FindAccountUserResp
-	38,  // 114: openim.chat.chat.OpenIMCallback:output_type -> openim.chat.OpenIMCallbackResp
-	42,  // 115: openim.chat.chat.UserLoginCount:output_type -> openim.chat.UserLoginCountResp
-	45,  // 116: openim.chat.chat.SearchUserInfo:output_type -> openim.chat.SearchUserInfoResp
-	47,  // 117: openim.chat.chat.GetTokenForVideoMeeting:output_type -> openim.chat.GetTokenForVideoMeetingResp
-	55,  // 118: openim.chat.chat.saveGroupToContact:output_type -> openim.chat.saveGroupToContactResp
-	57,  // 119: openim.chat.chat.deleteGroupFromContact:output_type -> openim.chat.deleteGroupFromContactResp
-	53,  // 120: openim.chat.chat.getGroupFromContact:output_type -> openim.chat.getGroupFromContactResp
-	59,  // 121: openim.chat.chat.deleteUserGroupApplicationFromRecipient:output_type -> openim.chat.DeleteGroupApplicationFromRecipientResp
-	61,  // 122: openim.chat.chat.deleteUserGroupApplicationFromApplicant:output_type -> openim.chat.DeleteGroupApplicationFromApplicantResp
-	63,  // 123: openim.chat.chat.deleteUserGroupApplicationFromAll:output_type -> openim.chat.DeleteGroupApplicationFromAllResp
-	14,  // 124: openim.chat.chat.getAllUserIDs:output_type -> openim.chat.GetAllUserIDsResp
-	66,  // 125: openim.chat.chat.PublishPost:output_type -> openim.chat.PublishPostResp
-	70,  // 126: openim.chat.chat.GetAllTypePost:output_type -> openim.chat.GetAllTypePostResp
-	73,  // 127: openim.chat.chat.GetPostList:output_type -> openim.chat.GetPostListResp
-	75,  // 128: openim.chat.chat.GetPostListByUser:output_type -> openim.chat.GetPostListByUserResp
-	77,  // 129: openim.chat.chat.GetCommentPostListByPostID:output_type -> openim.chat.GetCommentPostListByPostIDResp
-	68,  // 130: openim.chat.chat.GetPostByID:output_type -> openim.chat.GetPostByIDResp
-	79,  // 131: openim.chat.chat.DeletePost:output_type -> openim.chat.DeletePostResp
-	81,  // 132: openim.chat.chat.ChangeAllowCommentPost:output_type -> openim.chat.ChangeAllowCommentPostResp
-	83,  // 133: openim.chat.chat.ChangeAllowForwardPost:output_type -> openim.chat.ChangeAllowForwardPostResp
-	85,  // 134: openim.chat.chat.ChangeLikePost:output_type -> openim.chat.LikePostResp
-	87,  // 135: openim.chat.chat.ChangeCollectPost:output_type -> openim.chat.CollectPostResp
-	95,  // 136: openim.chat.chat.PinPost:output_type -> openim.chat.PinPostResp
-	89,  // 137: openim.chat.chat.ForwardPost:output_type -> openim.chat.ForwardPostResp
-	93,  // 138: openim.chat.chat.CommentPost:output_type -> openim.chat.CommentPostResp
-	91,  // 139: openim.chat.chat.ReferencePost:output_type -> openim.chat.ReferencePostResp
-	97,  // 140: openim.chat.chat.CheckVersion:output_type -> openim.chat.CheckVersionResp
-	99,  // 141: openim.chat.chat.GetFakeUser:output_type -> openim.chat.GetFakeUserResp
-	96,  // [96:142] is the sub-list for method output_type
-	50,  // [50:96] is the sub-list for method input_type
-	50,  // [50:50] is the sub-list for extension type_name
-	50,  // [50:50] is the sub-list for extension extendee
-	0,   // [0:50] is the sub-list for field type_name
+
+// Deprecated: Use GetPostByIDResp.ProtoReflect.Descriptor instead.
+func (*GetPostByIDResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{93}
 }
 
-func init() { file_chat_chat_proto_init() }
-func file_chat_chat_proto_init() {
-	if File_chat_chat_proto != nil {
-		return
-	}
+func (x *GetPostByIDResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetAllTypePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetAllTypePostReq) Reset() {
+	*x = GetAllTypePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTypePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTypePostReq) ProtoMessage() {}
+
+func (x *GetAllTypePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTypePostReq.ProtoReflect.Descriptor instead.
+func (*GetAllTypePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetAllTypePostReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetAllTypePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllPosts []*TypePosts `protobuf:"bytes,1,rep,name=allPosts,proto3" json:"allPosts"`
+}
+
+func (x *GetAllTypePostResp) Reset() {
+	*x = GetAllTypePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTypePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTypePostResp) ProtoMessage() {}
+
+func (x *GetAllTypePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTypePostResp.ProtoReflect.Descriptor instead.
+func (*GetAllTypePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetAllTypePostResp) GetAllPosts() []*TypePosts {
+	if x != nil {
+		return x.AllPosts
+	}
+	return nil
+}
+
+type TypePosts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       int32   `protobuf:"varint,1,opt,name=type,proto3" json:"type"`
+	NextCursor string  `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *TypePosts) Reset() {
+	*x = TypePosts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypePosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypePosts) ProtoMessage() {}
+
+func (x *TypePosts) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypePosts.ProtoReflect.Descriptor instead.
+func (*TypePosts) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TypePosts) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TypePosts) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *TypePosts) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetPostListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor string `protobuf:"bytes,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	// 0 follow, 1 subscribe, 2 reply, 3 like, 4 collect, 5 discover
+	Type int32 `protobuf:"varint,3,opt,name=type,proto3" json:"type"`
+}
+
+func (x *GetPostListReq) Reset() {
+	*x = GetPostListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostListReq) ProtoMessage() {}
+
+func (x *GetPostListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostListReq.ProtoReflect.Descriptor instead.
+func (*GetPostListReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetPostListReq) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetPostListReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetPostListReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+type GetPostListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor string  `protobuf:"bytes,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *GetPostListResp) Reset() {
+	*x = GetPostListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostListResp) ProtoMessage() {}
+
+func (x *GetPostListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostListResp.ProtoReflect.Descriptor instead.
+func (*GetPostListResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetPostListResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetPostListResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetPostListByUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	NextCursor string `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetPostListByUserReq) Reset() {
+	*x = GetPostListByUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostListByUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostListByUserReq) ProtoMessage() {}
+
+func (x *GetPostListByUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostListByUserReq.ProtoReflect.Descriptor instead.
+func (*GetPostListByUserReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetPostListByUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPostListByUserReq) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetPostListByUserReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetPostListByUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor string  `protobuf:"bytes,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *GetPostListByUserResp) Reset() {
+	*x = GetPostListByUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostListByUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostListByUserResp) ProtoMessage() {}
+
+func (x *GetPostListByUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostListByUserResp.ProtoReflect.Descriptor instead.
+func (*GetPostListByUserResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetPostListByUserResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetPostListByUserResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetCommentPostListByPostIDReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID     string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	NextCursor string `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	// 0 newest first, 1 oldest first, 2 most liked first
+	Sort int32 `protobuf:"varint,4,opt,name=sort,proto3" json:"sort"`
+	// levels of replies returned under each comment, at most 3
+	Depth int32 `protobuf:"varint,5,opt,name=depth,proto3" json:"depth"`
+	// replies returned under each comment, at most 20
+	ReplyCount int32 `protobuf:"varint,6,opt,name=replyCount,proto3" json:"replyCount"`
+}
+
+func (x *GetCommentPostListByPostIDReq) Reset() {
+	*x = GetCommentPostListByPostIDReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentPostListByPostIDReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentPostListByPostIDReq) ProtoMessage() {}
+
+func (x *GetCommentPostListByPostIDReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentPostListByPostIDReq.ProtoReflect.Descriptor instead.
+func (*GetCommentPostListByPostIDReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetCommentPostListByPostIDReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *GetCommentPostListByPostIDReq) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCommentPostListByPostIDReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCommentPostListByPostIDReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *GetCommentPostListByPostIDReq) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetCommentPostListByPostIDReq) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+type GetCommentPostListByPostIDResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor string  `protobuf:"bytes,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *GetCommentPostListByPostIDResp) Reset() {
+	*x = GetCommentPostListByPostIDResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentPostListByPostIDResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentPostListByPostIDResp) ProtoMessage() {}
+
+func (x *GetCommentPostListByPostIDResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentPostListByPostIDResp.ProtoReflect.Descriptor instead.
+func (*GetCommentPostListByPostIDResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetCommentPostListByPostIDResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCommentPostListByPostIDResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type DeletePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *DeletePostReq) Reset() {
+	*x = DeletePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostReq) ProtoMessage() {}
+
+func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
+func (*DeletePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeletePostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type DeletePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePostResp) Reset() {
+	*x = DeletePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResp) ProtoMessage() {}
+
+func (x *DeletePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResp.ProtoReflect.Descriptor instead.
+func (*DeletePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{104}
+}
+
+type UserCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PostCount      int64  `protobuf:"varint,2,opt,name=postCount,proto3" json:"postCount"`
+	FollowerCount  int64  `protobuf:"varint,3,opt,name=followerCount,proto3" json:"followerCount"`
+	FollowingCount int64  `protobuf:"varint,4,opt,name=followingCount,proto3" json:"followingCount"`
+}
+
+func (x *UserCounter) Reset() {
+	*x = UserCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCounter) ProtoMessage() {}
+
+func (x *UserCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCounter.ProtoReflect.Descriptor instead.
+func (*UserCounter) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UserCounter) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserCounter) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *UserCounter) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *UserCounter) GetFollowingCount() int64 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+type GetUserCountersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetUserCountersReq) Reset() {
+	*x = GetUserCountersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserCountersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCountersReq) ProtoMessage() {}
+
+func (x *GetUserCountersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCountersReq.ProtoReflect.Descriptor instead.
+func (*GetUserCountersReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetUserCountersReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetUserCountersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counters []*UserCounter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters"`
+}
+
+func (x *GetUserCountersResp) Reset() {
+	*x = GetUserCountersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserCountersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCountersResp) ProtoMessage() {}
+
+func (x *GetUserCountersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCountersResp.ProtoReflect.Descriptor instead.
+func (*GetUserCountersResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetUserCountersResp) GetCounters() []*UserCounter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type RestorePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *RestorePostReq) Reset() {
+	*x = RestorePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostReq) ProtoMessage() {}
+
+func (x *RestorePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostReq.ProtoReflect.Descriptor instead.
+func (*RestorePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *RestorePostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type RestorePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+}
+
+func (x *RestorePostResp) Reset() {
+	*x = RestorePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostResp) ProtoMessage() {}
+
+func (x *RestorePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostResp.ProtoReflect.Descriptor instead.
+func (*RestorePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RestorePostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetDeletedPostListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor string `protobuf:"bytes,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetDeletedPostListReq) Reset() {
+	*x = GetDeletedPostListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeletedPostListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletedPostListReq) ProtoMessage() {}
+
+func (x *GetDeletedPostListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletedPostListReq.ProtoReflect.Descriptor instead.
+func (*GetDeletedPostListReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetDeletedPostListReq) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetDeletedPostListReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetDeletedPostListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor string  `protobuf:"bytes,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *GetDeletedPostListResp) Reset() {
+	*x = GetDeletedPostListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeletedPostListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletedPostListResp) ProtoMessage() {}
+
+func (x *GetDeletedPostListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletedPostListResp.ProtoReflect.Descriptor instead.
+func (*GetDeletedPostListResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetDeletedPostListResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetDeletedPostListResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type ChangeAllowCommentPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *ChangeAllowCommentPostReq) Reset() {
+	*x = ChangeAllowCommentPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowCommentPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowCommentPostReq) ProtoMessage() {}
+
+func (x *ChangeAllowCommentPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowCommentPostReq.ProtoReflect.Descriptor instead.
+func (*ChangeAllowCommentPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ChangeAllowCommentPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type ChangeAllowCommentPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	AllowComment int32  `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
+}
+
+func (x *ChangeAllowCommentPostResp) Reset() {
+	*x = ChangeAllowCommentPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowCommentPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowCommentPostResp) ProtoMessage() {}
+
+func (x *ChangeAllowCommentPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowCommentPostResp.ProtoReflect.Descriptor instead.
+func (*ChangeAllowCommentPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ChangeAllowCommentPostResp) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ChangeAllowCommentPostResp) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+type ChangeAllowForwardPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *ChangeAllowForwardPostReq) Reset() {
+	*x = ChangeAllowForwardPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowForwardPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowForwardPostReq) ProtoMessage() {}
+
+func (x *ChangeAllowForwardPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowForwardPostReq.ProtoReflect.Descriptor instead.
+func (*ChangeAllowForwardPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ChangeAllowForwardPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type ChangeAllowForwardPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	AllowForward int32  `protobuf:"varint,2,opt,name=allowForward,proto3" json:"allowForward"`
+}
+
+func (x *ChangeAllowForwardPostResp) Reset() {
+	*x = ChangeAllowForwardPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowForwardPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowForwardPostResp) ProtoMessage() {}
+
+func (x *ChangeAllowForwardPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowForwardPostResp.ProtoReflect.Descriptor instead.
+func (*ChangeAllowForwardPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ChangeAllowForwardPostResp) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ChangeAllowForwardPostResp) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+type EditPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID    string                  `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Content   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AtUserIds []string                `protobuf:"bytes,3,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs []*common.PostMedia     `protobuf:"bytes,4,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+}
+
+func (x *EditPostReq) Reset() {
+	*x = EditPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostReq) ProtoMessage() {}
+
+func (x *EditPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostReq.ProtoReflect.Descriptor instead.
+func (*EditPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *EditPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *EditPostReq) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *EditPostReq) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *EditPostReq) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+type EditPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+}
+
+func (x *EditPostResp) Reset() {
+	*x = EditPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostResp) ProtoMessage() {}
+
+func (x *EditPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostResp.ProtoReflect.Descriptor instead.
+func (*EditPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *EditPostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID     string              `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Version    int32               `protobuf:"varint,2,opt,name=version,proto3" json:"version"`
+	Content    string              `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
+	AtUserIds  []string            `protobuf:"bytes,4,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs  []*common.PostMedia `protobuf:"bytes,5,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	CreateTime int64               `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+	EditTime   int64               `protobuf:"varint,7,opt,name=editTime,proto3" json:"editTime"`
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *PostRevision) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PostRevision) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostRevision) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *PostRevision) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+func (x *PostRevision) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *PostRevision) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+type GetPostRevisionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *GetPostRevisionsReq) Reset() {
+	*x = GetPostRevisionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsReq) ProtoMessage() {}
+
+func (x *GetPostRevisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsReq.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetPostRevisionsReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type GetPostRevisionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// newest first
+	Revisions []*PostRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
+}
+
+func (x *GetPostRevisionsResp) Reset() {
+	*x = GetPostRevisionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsResp) ProtoMessage() {}
+
+func (x *GetPostRevisionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsResp.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetPostRevisionsResp) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type ChangePostVisibilityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID         string   `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Visibility     int32    `protobuf:"varint,2,opt,name=visibility,proto3" json:"visibility"`
+	VisibleUserIDs []string `protobuf:"bytes,3,rep,name=visibleUserIDs,proto3" json:"visibleUserIDs"`
+}
+
+func (x *ChangePostVisibilityReq) Reset() {
+	*x = ChangePostVisibilityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePostVisibilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePostVisibilityReq) ProtoMessage() {}
+
+func (x *ChangePostVisibilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePostVisibilityReq.ProtoReflect.Descriptor instead.
+func (*ChangePostVisibilityReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ChangePostVisibilityReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ChangePostVisibilityReq) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *ChangePostVisibilityReq) GetVisibleUserIDs() []string {
+	if x != nil {
+		return x.VisibleUserIDs
+	}
+	return nil
+}
+
+type ChangePostVisibilityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID     string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Visibility int32  `protobuf:"varint,2,opt,name=visibility,proto3" json:"visibility"`
+}
+
+func (x *ChangePostVisibilityResp) Reset() {
+	*x = ChangePostVisibilityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePostVisibilityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePostVisibilityResp) ProtoMessage() {}
+
+func (x *ChangePostVisibilityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePostVisibilityResp.ProtoReflect.Descriptor instead.
+func (*ChangePostVisibilityResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ChangePostVisibilityResp) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ChangePostVisibilityResp) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+type LikePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID  string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	IsLiked int32  `protobuf:"varint,2,opt,name=isLiked,proto3" json:"isLiked"`
+}
+
+func (x *LikePostReq) Reset() {
+	*x = LikePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostReq) ProtoMessage() {}
+
+func (x *LikePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
+func (*LikePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *LikePostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *LikePostReq) GetIsLiked() int32 {
+	if x != nil {
+		return x.IsLiked
+	}
+	return 0
+}
+
+type LikePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsLiked int32 `protobuf:"varint,1,opt,name=isLiked,proto3" json:"isLiked"`
+}
+
+func (x *LikePostResp) Reset() {
+	*x = LikePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResp) ProtoMessage() {}
+
+func (x *LikePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResp.ProtoReflect.Descriptor instead.
+func (*LikePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *LikePostResp) GetIsLiked() int32 {
+	if x != nil {
+		return x.IsLiked
+	}
+	return 0
+}
+
+type CollectPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID      string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	IsCollected int32  `protobuf:"varint,2,opt,name=isCollected,proto3" json:"isCollected"`
+}
+
+func (x *CollectPostReq) Reset() {
+	*x = CollectPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectPostReq) ProtoMessage() {}
+
+func (x *CollectPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectPostReq.ProtoReflect.Descriptor instead.
+func (*CollectPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CollectPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *CollectPostReq) GetIsCollected() int32 {
+	if x != nil {
+		return x.IsCollected
+	}
+	return 0
+}
+
+type CollectPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsCollected int32 `protobuf:"varint,1,opt,name=isCollected,proto3" json:"isCollected"`
+}
+
+func (x *CollectPostResp) Reset() {
+	*x = CollectPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectPostResp) ProtoMessage() {}
+
+func (x *CollectPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectPostResp.ProtoReflect.Descriptor instead.
+func (*CollectPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CollectPostResp) GetIsCollected() int32 {
+	if x != nil {
+		return x.IsCollected
+	}
+	return 0
+}
+
+type ForwardPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForwardPostID string `protobuf:"bytes,1,opt,name=forwardPostID,proto3" json:"forwardPostID"`
+	IsForwarded   int32  `protobuf:"varint,2,opt,name=isForwarded,proto3" json:"isForwarded"`
+}
+
+func (x *ForwardPostReq) Reset() {
+	*x = ForwardPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardPostReq) ProtoMessage() {}
+
+func (x *ForwardPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardPostReq.ProtoReflect.Descriptor instead.
+func (*ForwardPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ForwardPostReq) GetForwardPostID() string {
+	if x != nil {
+		return x.ForwardPostID
+	}
+	return ""
+}
+
+func (x *ForwardPostReq) GetIsForwarded() int32 {
+	if x != nil {
+		return x.IsForwarded
+	}
+	return 0
+}
+
+type ForwardPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsForwarded int32 `protobuf:"varint,1,opt,name=isForwarded,proto3" json:"isForwarded"`
+}
+
+func (x *ForwardPostResp) Reset() {
+	*x = ForwardPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardPostResp) ProtoMessage() {}
+
+func (x *ForwardPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardPostResp.ProtoReflect.Descriptor instead.
+func (*ForwardPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ForwardPostResp) GetIsForwarded() int32 {
+	if x != nil {
+		return x.IsForwarded
+	}
+	return 0
+}
+
+type ReferencePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefPostID      string                  `protobuf:"bytes,1,opt,name=refPostID,proto3" json:"refPostID"`
+	Content        *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AllowComment   int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward   int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds      []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs      []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Visibility     int32                   `protobuf:"varint,7,opt,name=visibility,proto3" json:"visibility"`
+	VisibleUserIDs []string                `protobuf:"bytes,8,rep,name=visibleUserIDs,proto3" json:"visibleUserIDs"`
+}
+
+func (x *ReferencePostReq) Reset() {
+	*x = ReferencePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferencePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencePostReq) ProtoMessage() {}
+
+func (x *ReferencePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencePostReq.ProtoReflect.Descriptor instead.
+func (*ReferencePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ReferencePostReq) GetRefPostID() string {
+	if x != nil {
+		return x.RefPostID
+	}
+	return ""
+}
+
+func (x *ReferencePostReq) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ReferencePostReq) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+func (x *ReferencePostReq) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+func (x *ReferencePostReq) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *ReferencePostReq) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+func (x *ReferencePostReq) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *ReferencePostReq) GetVisibleUserIDs() []string {
+	if x != nil {
+		return x.VisibleUserIDs
+	}
+	return nil
+}
+
+type ReferencePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReferencePostResp) Reset() {
+	*x = ReferencePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferencePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencePostResp) ProtoMessage() {}
+
+func (x *ReferencePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencePostResp.ProtoReflect.Descriptor instead.
+func (*ReferencePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{130}
+}
+
+type CommentPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentPostID string                  `protobuf:"bytes,1,opt,name=commentPostID,proto3" json:"commentPostID"`
+	Content       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AllowComment  int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward  int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds     []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs     []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+}
+
+func (x *CommentPostReq) Reset() {
+	*x = CommentPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostReq) ProtoMessage() {}
+
+func (x *CommentPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostReq.ProtoReflect.Descriptor instead.
+func (*CommentPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CommentPostReq) GetCommentPostID() string {
+	if x != nil {
+		return x.CommentPostID
+	}
+	return ""
+}
+
+func (x *CommentPostReq) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CommentPostReq) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+func (x *CommentPostReq) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+func (x *CommentPostReq) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *CommentPostReq) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+type CommentPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+}
+
+func (x *CommentPostResp) Reset() {
+	*x = CommentPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostResp) ProtoMessage() {}
+
+func (x *CommentPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostResp.ProtoReflect.Descriptor instead.
+func (*CommentPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *CommentPostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PinPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID   string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	IsPinned int32  `protobuf:"varint,2,opt,name=isPinned,proto3" json:"isPinned"`
+}
+
+func (x *PinPostReq) Reset() {
+	*x = PinPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostReq) ProtoMessage() {}
+
+func (x *PinPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostReq.ProtoReflect.Descriptor instead.
+func (*PinPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *PinPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PinPostReq) GetIsPinned() int32 {
+	if x != nil {
+		return x.IsPinned
+	}
+	return 0
+}
+
+type PinPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinPostResp) Reset() {
+	*x = PinPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostResp) ProtoMessage() {}
+
+func (x *PinPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostResp.ProtoReflect.Descriptor instead.
+func (*PinPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{134}
+}
+
+type PinCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID        string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	CommentPostID string `protobuf:"bytes,2,opt,name=commentPostID,proto3" json:"commentPostID"`
+	IsPinned      int32  `protobuf:"varint,3,opt,name=isPinned,proto3" json:"isPinned"`
+}
+
+func (x *PinCommentReq) Reset() {
+	*x = PinCommentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinCommentReq) ProtoMessage() {}
+
+func (x *PinCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinCommentReq.ProtoReflect.Descriptor instead.
+func (*PinCommentReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *PinCommentReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PinCommentReq) GetCommentPostID() string {
+	if x != nil {
+		return x.CommentPostID
+	}
+	return ""
+}
+
+func (x *PinCommentReq) GetIsPinned() int32 {
+	if x != nil {
+		return x.IsPinned
+	}
+	return 0
+}
+
+type PinCommentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinCommentResp) Reset() {
+	*x = PinCommentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinCommentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinCommentResp) ProtoMessage() {}
+
+func (x *PinCommentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinCommentResp.ProtoReflect.Descriptor instead.
+func (*PinCommentResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{136}
+}
+
+// PostNotification aggregates the unread interactions of one type on one post,
+// actors holds the latest actors, newest first, and actorCount counts all of them, as in "A and 12 others liked your post".
+type PostNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationID string `protobuf:"bytes,1,opt,name=notificationID,proto3" json:"notificationID"`
+	// 1 like, 2 comment, 3 forward, 4 reference, 5 mention
+	Type   int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type"`
+	PostID string `protobuf:"bytes,3,opt,name=postID,proto3" json:"postID"`
+	// the latest comment, forward or reference
+	SourcePostID string                   `protobuf:"bytes,4,opt,name=sourcePostID,proto3" json:"sourcePostID"`
+	Actors       []*common.UserPublicInfo `protobuf:"bytes,5,rep,name=actors,proto3" json:"actors"`
+	ActorCount   int64                    `protobuf:"varint,6,opt,name=actorCount,proto3" json:"actorCount"`
+	IsRead       int32                    `protobuf:"varint,7,opt,name=isRead,proto3" json:"isRead"`
+	CreateTime   int64                    `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+	UpdateTime   int64                    `protobuf:"varint,9,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *PostNotification) Reset() {
+	*x = PostNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostNotification) ProtoMessage() {}
+
+func (x *PostNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostNotification.ProtoReflect.Descriptor instead.
+func (*PostNotification) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *PostNotification) GetNotificationID() string {
+	if x != nil {
+		return x.NotificationID
+	}
+	return ""
+}
+
+func (x *PostNotification) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *PostNotification) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PostNotification) GetSourcePostID() string {
+	if x != nil {
+		return x.SourcePostID
+	}
+	return ""
+}
+
+func (x *PostNotification) GetActors() []*common.UserPublicInfo {
+	if x != nil {
+		return x.Actors
+	}
+	return nil
+}
+
+func (x *PostNotification) GetActorCount() int64 {
+	if x != nil {
+		return x.ActorCount
+	}
+	return 0
+}
+
+func (x *PostNotification) GetIsRead() int32 {
+	if x != nil {
+		return x.IsRead
+	}
+	return 0
+}
+
+func (x *PostNotification) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *PostNotification) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GetNotificationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetNotificationsReq) Reset() {
+	*x = GetNotificationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsReq) ProtoMessage() {}
+
+func (x *GetNotificationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetNotificationsReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetNotificationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Notifications []*PostNotification `protobuf:"bytes,2,rep,name=notifications,proto3" json:"notifications"`
+}
+
+func (x *GetNotificationsResp) Reset() {
+	*x = GetNotificationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsResp) ProtoMessage() {}
+
+func (x *GetNotificationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetNotificationsResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetNotificationsResp) GetNotifications() []*PostNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type MarkNotificationsReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// marks every notification read when empty
+	NotificationIDs []string `protobuf:"bytes,1,rep,name=notificationIDs,proto3" json:"notificationIDs"`
+}
+
+func (x *MarkNotificationsReadReq) Reset() {
+	*x = MarkNotificationsReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNotificationsReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadReq) ProtoMessage() {}
+
+func (x *MarkNotificationsReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *MarkNotificationsReadReq) GetNotificationIDs() []string {
+	if x != nil {
+		return x.NotificationIDs
+	}
+	return nil
+}
+
+type MarkNotificationsReadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkNotificationsReadResp) Reset() {
+	*x = MarkNotificationsReadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNotificationsReadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResp) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{141}
+}
+
+type GetUnreadNotificationCountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUnreadNotificationCountReq) Reset() {
+	*x = GetUnreadNotificationCountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadNotificationCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountReq) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountReq.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{142}
+}
+
+type GetUnreadNotificationCountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetUnreadNotificationCountResp) Reset() {
+	*x = GetUnreadNotificationCountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadNotificationCountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountResp) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountResp.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetUnreadNotificationCountResp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SearchPostsReq matches top level posts by all the non-empty conditions,
+// posts are ordered by relevance when keyword is set and newest first otherwise.
+type SearchPostsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Hashtag string `protobuf:"bytes,2,opt,name=hashtag,proto3" json:"hashtag"`
+	UserID  string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	// create time range in milliseconds, [startTime, endTime), 0 is unbounded
+	StartTime  int64                     `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime"`
+	EndTime    int64                     `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchPostsReq) Reset() {
+	*x = SearchPostsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsReq) ProtoMessage() {}
+
+func (x *SearchPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsReq.ProtoReflect.Descriptor instead.
+func (*SearchPostsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SearchPostsReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchPostsReq) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *SearchPostsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchPostsReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchPostsReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchPostsReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchPostsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint32  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Posts []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *SearchPostsResp) Reset() {
+	*x = SearchPostsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResp) ProtoMessage() {}
+
+func (x *SearchPostsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResp.ProtoReflect.Descriptor instead.
+func (*SearchPostsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SearchPostsResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchPostsResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type Topic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	PostCount  int64  `protobuf:"varint,2,opt,name=postCount,proto3" json:"postCount"`
+	CreateTime int64  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+func (x *Topic) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *Topic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Topic) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *Topic) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// DiscoverConfig weights the engagement counters in the discover score of a post,
+// the score drops by one order of magnitude every decayHours.
+type DiscoverConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LikeWeight    float64 `protobuf:"fixed64,1,opt,name=likeWeight,proto3" json:"likeWeight"`
+	CommentWeight float64 `protobuf:"fixed64,2,opt,name=commentWeight,proto3" json:"commentWeight"`
+	ForwardWeight float64 `protobuf:"fixed64,3,opt,name=forwardWeight,proto3" json:"forwardWeight"`
+	ViewWeight    float64 `protobuf:"fixed64,4,opt,name=viewWeight,proto3" json:"viewWeight"`
+	DecayHours    float64 `protobuf:"fixed64,5,opt,name=decayHours,proto3" json:"decayHours"`
+}
+
+func (x *DiscoverConfig) Reset() {
+	*x = DiscoverConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverConfig) ProtoMessage() {}
+
+func (x *DiscoverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverConfig.ProtoReflect.Descriptor instead.
+func (*DiscoverConfig) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *DiscoverConfig) GetLikeWeight() float64 {
+	if x != nil {
+		return x.LikeWeight
+	}
+	return 0
+}
+
+func (x *DiscoverConfig) GetCommentWeight() float64 {
+	if x != nil {
+		return x.CommentWeight
+	}
+	return 0
+}
+
+func (x *DiscoverConfig) GetForwardWeight() float64 {
+	if x != nil {
+		return x.ForwardWeight
+	}
+	return 0
+}
+
+func (x *DiscoverConfig) GetViewWeight() float64 {
+	if x != nil {
+		return x.ViewWeight
+	}
+	return 0
+}
+
+func (x *DiscoverConfig) GetDecayHours() float64 {
+	if x != nil {
+		return x.DecayHours
+	}
+	return 0
+}
+
+type GetDiscoverConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDiscoverConfigReq) Reset() {
+	*x = GetDiscoverConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscoverConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscoverConfigReq) ProtoMessage() {}
+
+func (x *GetDiscoverConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscoverConfigReq.ProtoReflect.Descriptor instead.
+func (*GetDiscoverConfigReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{148}
+}
+
+type GetDiscoverConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *DiscoverConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (x *GetDiscoverConfigResp) Reset() {
+	*x = GetDiscoverConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscoverConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscoverConfigResp) ProtoMessage() {}
+
+func (x *GetDiscoverConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscoverConfigResp.ProtoReflect.Descriptor instead.
+func (*GetDiscoverConfigResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetDiscoverConfigResp) GetConfig() *DiscoverConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetDiscoverConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *DiscoverConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (x *SetDiscoverConfigReq) Reset() {
+	*x = SetDiscoverConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiscoverConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiscoverConfigReq) ProtoMessage() {}
+
+func (x *SetDiscoverConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiscoverConfigReq.ProtoReflect.Descriptor instead.
+func (*SetDiscoverConfigReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SetDiscoverConfigReq) GetConfig() *DiscoverConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetDiscoverConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDiscoverConfigResp) Reset() {
+	*x = SetDiscoverConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiscoverConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiscoverConfigResp) ProtoMessage() {}
+
+func (x *SetDiscoverConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiscoverConfigResp.ProtoReflect.Descriptor instead.
+func (*SetDiscoverConfigResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{151}
+}
+
+// SetPostDiscoverReq pins a post on top of the discover feed or boosts its score,
+// a boost of 1 ranks the post as if it had ten times its engagement.
+type SetPostDiscoverReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string  `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Pinned int32   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned"`
+	Boost  float64 `protobuf:"fixed64,3,opt,name=boost,proto3" json:"boost"`
+}
+
+func (x *SetPostDiscoverReq) Reset() {
+	*x = SetPostDiscoverReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPostDiscoverReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPostDiscoverReq) ProtoMessage() {}
+
+func (x *SetPostDiscoverReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPostDiscoverReq.ProtoReflect.Descriptor instead.
+func (*SetPostDiscoverReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SetPostDiscoverReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *SetPostDiscoverReq) GetPinned() int32 {
+	if x != nil {
+		return x.Pinned
+	}
+	return 0
+}
+
+func (x *SetPostDiscoverReq) GetBoost() float64 {
+	if x != nil {
+		return x.Boost
+	}
+	return 0
+}
+
+type SetPostDiscoverResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPostDiscoverResp) Reset() {
+	*x = SetPostDiscoverResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPostDiscoverResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPostDiscoverResp) ProtoMessage() {}
+
+func (x *SetPostDiscoverResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPostDiscoverResp.ProtoReflect.Descriptor instead.
+func (*SetPostDiscoverResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{153}
+}
+
+type ReportPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	// 1 spam, 2 harassment, 3 sexual content, 4 illegal content, 5 other
+	Reason      int32  `protobuf:"varint,2,opt,name=reason,proto3" json:"reason"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+}
+
+func (x *ReportPostReq) Reset() {
+	*x = ReportPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostReq) ProtoMessage() {}
+
+func (x *ReportPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostReq.ProtoReflect.Descriptor instead.
+func (*ReportPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ReportPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ReportPostReq) GetReason() int32 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *ReportPostReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ReportPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportID string `protobuf:"bytes,1,opt,name=reportID,proto3" json:"reportID"`
+}
+
+func (x *ReportPostResp) Reset() {
+	*x = ReportPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostResp) ProtoMessage() {}
+
+func (x *ReportPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostResp.ProtoReflect.Descriptor instead.
+func (*ReportPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ReportPostResp) GetReportID() string {
+	if x != nil {
+		return x.ReportID
+	}
+	return ""
+}
+
+type PostReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportID       string `protobuf:"bytes,1,opt,name=reportID,proto3" json:"reportID"`
+	PostID         string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID"`
+	AuthorUserID   string `protobuf:"bytes,3,opt,name=authorUserID,proto3" json:"authorUserID"`
+	ReporterUserID string `protobuf:"bytes,4,opt,name=reporterUserID,proto3" json:"reporterUserID"`
+	Reason         int32  `protobuf:"varint,5,opt,name=reason,proto3" json:"reason"`
+	Description    string `protobuf:"bytes,6,opt,name=description,proto3" json:"description"`
+	// 0 pending, 1 resolved, 2 dismissed
+	Status int32 `protobuf:"varint,7,opt,name=status,proto3" json:"status"`
+	// the moderation action and the admin that closed the report
+	Action         int32                  `protobuf:"varint,8,opt,name=action,proto3" json:"action"`
+	OperatorUserID string                 `protobuf:"bytes,9,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	CreateTime     int64                  `protobuf:"varint,10,opt,name=createTime,proto3" json:"createTime"`
+	HandleTime     int64                  `protobuf:"varint,11,opt,name=handleTime,proto3" json:"handleTime"`
+	Reporter       *common.UserPublicInfo `protobuf:"bytes,12,opt,name=reporter,proto3" json:"reporter"`
+	Author         *common.UserPublicInfo `protobuf:"bytes,13,opt,name=author,proto3" json:"author"`
+}
+
+func (x *PostReport) Reset() {
+	*x = PostReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostReport) ProtoMessage() {}
+
+func (x *PostReport) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostReport.ProtoReflect.Descriptor instead.
+func (*PostReport) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *PostReport) GetReportID() string {
+	if x != nil {
+		return x.ReportID
+	}
+	return ""
+}
+
+func (x *PostReport) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PostReport) GetAuthorUserID() string {
+	if x != nil {
+		return x.AuthorUserID
+	}
+	return ""
+}
+
+func (x *PostReport) GetReporterUserID() string {
+	if x != nil {
+		return x.ReporterUserID
+	}
+	return ""
+}
+
+func (x *PostReport) GetReason() int32 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *PostReport) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostReport) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PostReport) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *PostReport) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *PostReport) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *PostReport) GetHandleTime() int64 {
+	if x != nil {
+		return x.HandleTime
+	}
+	return 0
+}
+
+func (x *PostReport) GetReporter() *common.UserPublicInfo {
+	if x != nil {
+		return x.Reporter
+	}
+	return nil
+}
+
+func (x *PostReport) GetAuthor() *common.UserPublicInfo {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+// SearchPostReportsReq matches reports by all the non-empty conditions, newest first.
+type SearchPostReportsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         *wrapperspb.Int32Value    `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	PostID         string                    `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID"`
+	ReporterUserID string                    `protobuf:"bytes,3,opt,name=reporterUserID,proto3" json:"reporterUserID"`
+	AuthorUserID   string                    `protobuf:"bytes,4,opt,name=authorUserID,proto3" json:"authorUserID"`
+	Pagination     *sdkwss.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchPostReportsReq) Reset() {
+	*x = SearchPostReportsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostReportsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostReportsReq) ProtoMessage() {}
+
+func (x *SearchPostReportsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostReportsReq.ProtoReflect.Descriptor instead.
+func (*SearchPostReportsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SearchPostReportsReq) GetStatus() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SearchPostReportsReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *SearchPostReportsReq) GetReporterUserID() string {
+	if x != nil {
+		return x.ReporterUserID
+	}
+	return ""
+}
+
+func (x *SearchPostReportsReq) GetAuthorUserID() string {
+	if x != nil {
+		return x.AuthorUserID
+	}
+	return ""
+}
+
+func (x *SearchPostReportsReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchPostReportsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   uint32        `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Reports []*PostReport `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports"`
+}
+
+func (x *SearchPostReportsResp) Reset() {
+	*x = SearchPostReportsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostReportsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostReportsResp) ProtoMessage() {}
+
+func (x *SearchPostReportsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostReportsResp.ProtoReflect.Descriptor instead.
+func (*SearchPostReportsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SearchPostReportsResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchPostReportsResp) GetReports() []*PostReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// PostModerationLog records a moderation decision on a post.
+type PostModerationLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogID          string `protobuf:"bytes,1,opt,name=logID,proto3" json:"logID"`
+	PostID         string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID"`
+	AuthorUserID   string `protobuf:"bytes,3,opt,name=authorUserID,proto3" json:"authorUserID"`
+	OperatorUserID string `protobuf:"bytes,4,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	Action         int32  `protobuf:"varint,5,opt,name=action,proto3" json:"action"`
+	Reason         string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`
+	// the number of pending reports closed by the decision
+	ReportCount int64 `protobuf:"varint,7,opt,name=reportCount,proto3" json:"reportCount"`
+	CreateTime  int64 `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *PostModerationLog) Reset() {
+	*x = PostModerationLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostModerationLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostModerationLog) ProtoMessage() {}
+
+func (x *PostModerationLog) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostModerationLog.ProtoReflect.Descriptor instead.
+func (*PostModerationLog) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *PostModerationLog) GetLogID() string {
+	if x != nil {
+		return x.LogID
+	}
+	return ""
+}
+
+func (x *PostModerationLog) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PostModerationLog) GetAuthorUserID() string {
+	if x != nil {
+		return x.AuthorUserID
+	}
+	return ""
+}
+
+func (x *PostModerationLog) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *PostModerationLog) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *PostModerationLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PostModerationLog) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *PostModerationLog) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// GetReportedPostReq returns a post for review, including hidden and deleted posts,
+// together with its latest reports and its moderation history.
+type GetReportedPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *GetReportedPostReq) Reset() {
+	*x = GetReportedPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReportedPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportedPostReq) ProtoMessage() {}
+
+func (x *GetReportedPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportedPostReq.ProtoReflect.Descriptor instead.
+func (*GetReportedPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetReportedPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type GetReportedPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post    *Post                `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	Reports []*PostReport        `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports"`
+	Logs    []*PostModerationLog `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs"`
+}
+
+func (x *GetReportedPostResp) Reset() {
+	*x = GetReportedPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReportedPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportedPostResp) ProtoMessage() {}
+
+func (x *GetReportedPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportedPostResp.ProtoReflect.Descriptor instead.
+func (*GetReportedPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetReportedPostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *GetReportedPostResp) GetReports() []*PostReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *GetReportedPostResp) GetLogs() []*PostModerationLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// ModeratePostReq closes the pending reports of a post with an action,
+// 1 dismiss, 2 hide, 3 unhide, 4 remove, 5 warn the author, 6 ban the author.
+type ModeratePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Action int32  `protobuf:"varint,2,opt,name=action,proto3" json:"action"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+}
+
+func (x *ModeratePostReq) Reset() {
+	*x = ModeratePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModeratePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModeratePostReq) ProtoMessage() {}
+
+func (x *ModeratePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModeratePostReq.ProtoReflect.Descriptor instead.
+func (*ModeratePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ModeratePostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ModeratePostReq) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *ModeratePostReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModeratePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ModeratePostResp) Reset() {
+	*x = ModeratePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModeratePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModeratePostResp) ProtoMessage() {}
+
+func (x *ModeratePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModeratePostResp.ProtoReflect.Descriptor instead.
+func (*ModeratePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{163}
+}
+
+type SearchPostModerationLogsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID         string                    `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	AuthorUserID   string                    `protobuf:"bytes,2,opt,name=authorUserID,proto3" json:"authorUserID"`
+	OperatorUserID string                    `protobuf:"bytes,3,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	Pagination     *sdkwss.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchPostModerationLogsReq) Reset() {
+	*x = SearchPostModerationLogsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostModerationLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostModerationLogsReq) ProtoMessage() {}
+
+func (x *SearchPostModerationLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostModerationLogsReq.ProtoReflect.Descriptor instead.
+func (*SearchPostModerationLogsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *SearchPostModerationLogsReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *SearchPostModerationLogsReq) GetAuthorUserID() string {
+	if x != nil {
+		return x.AuthorUserID
+	}
+	return ""
+}
+
+func (x *SearchPostModerationLogsReq) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *SearchPostModerationLogsReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchPostModerationLogsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint32               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Logs  []*PostModerationLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs"`
+}
+
+func (x *SearchPostModerationLogsResp) Reset() {
+	*x = SearchPostModerationLogsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostModerationLogsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostModerationLogsResp) ProtoMessage() {}
+
+func (x *SearchPostModerationLogsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostModerationLogsResp.ProtoReflect.Descriptor instead.
+func (*SearchPostModerationLogsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SearchPostModerationLogsResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchPostModerationLogsResp) GetLogs() []*PostModerationLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type GetTopicFeedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashtag    string `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag"`
+	NextCursor string `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetTopicFeedReq) Reset() {
+	*x = GetTopicFeedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopicFeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicFeedReq) ProtoMessage() {}
+
+func (x *GetTopicFeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicFeedReq.ProtoReflect.Descriptor instead.
+func (*GetTopicFeedReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetTopicFeedReq) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *GetTopicFeedReq) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetTopicFeedReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetTopicFeedResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic      *Topic  `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+	NextCursor string  `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *GetTopicFeedResp) Reset() {
+	*x = GetTopicFeedResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopicFeedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicFeedResp) ProtoMessage() {}
+
+func (x *GetTopicFeedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicFeedResp.ProtoReflect.Descriptor instead.
+func (*GetTopicFeedResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetTopicFeedResp) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *GetTopicFeedResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetTopicFeedResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type CheckVersionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language"`
+}
+
+func (x *CheckVersionReq) Reset() {
+	*x = CheckVersionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckVersionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckVersionReq) ProtoMessage() {}
+
+func (x *CheckVersionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckVersionReq.ProtoReflect.Descriptor instead.
+func (*CheckVersionReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *CheckVersionReq) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type CheckVersionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildVersion                string   `protobuf:"bytes,1,opt,name=buildVersion,proto3" json:"buildVersion"`
+	DownloadURL                 string   `protobuf:"bytes,2,opt,name=downloadURL,proto3" json:"downloadURL"`
+	BuildUpdateTitle            string   `protobuf:"bytes,3,opt,name=buildUpdateTitle,proto3" json:"buildUpdateTitle"`
+	BuildUpdateDescriptionTitle string   `protobuf:"bytes,4,opt,name=buildUpdateDescriptionTitle,proto3" json:"buildUpdateDescriptionTitle"`
+	BuildUpdateDescriptions     []string `protobuf:"bytes,5,rep,name=buildUpdateDescriptions,proto3" json:"buildUpdateDescriptions"`
+	NeedForceUpdate             bool     `protobuf:"varint,6,opt,name=needForceUpdate,proto3" json:"needForceUpdate"`
+}
+
+func (x *CheckVersionResp) Reset() {
+	*x = CheckVersionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckVersionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckVersionResp) ProtoMessage() {}
+
+func (x *CheckVersionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckVersionResp.ProtoReflect.Descriptor instead.
+func (*CheckVersionResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *CheckVersionResp) GetBuildVersion() string {
+	if x != nil {
+		return x.BuildVersion
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetDownloadURL() string {
+	if x != nil {
+		return x.DownloadURL
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetBuildUpdateTitle() string {
+	if x != nil {
+		return x.BuildUpdateTitle
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetBuildUpdateDescriptionTitle() string {
+	if x != nil {
+		return x.BuildUpdateDescriptionTitle
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetBuildUpdateDescriptions() []string {
+	if x != nil {
+		return x.BuildUpdateDescriptions
+	}
+	return nil
+}
+
+func (x *CheckVersionResp) GetNeedForceUpdate() bool {
+	if x != nil {
+		return x.NeedForceUpdate
+	}
+	return false
+}
+
+type GetFakeUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetFakeUserReq) Reset() {
+	*x = GetFakeUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFakeUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFakeUserReq) ProtoMessage() {}
+
+func (x *GetFakeUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFakeUserReq.ProtoReflect.Descriptor instead.
+func (*GetFakeUserReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{170}
+}
+
+type GetFakeUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Online int32 `protobuf:"varint,1,opt,name=online,proto3" json:"online"`
+	Total  int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+}
+
+func (x *GetFakeUserResp) Reset() {
+	*x = GetFakeUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFakeUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFakeUserResp) ProtoMessage() {}
+
+func (x *GetFakeUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFakeUserResp.ProtoReflect.Descriptor instead.
+func (*GetFakeUserResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetFakeUserResp) GetOnline() int32 {
+	if x != nil {
+		return x.Online
+	}
+	return 0
+}
+
+func (x *GetFakeUserResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RedPacketClaim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	ClaimTime int64  `protobuf:"varint,3,opt,name=claimTime,proto3" json:"claimTime"`
+}
+
+func (x *RedPacketClaim) Reset() {
+	*x = RedPacketClaim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedPacketClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedPacketClaim) ProtoMessage() {}
+
+func (x *RedPacketClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedPacketClaim.ProtoReflect.Descriptor instead.
+func (*RedPacketClaim) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *RedPacketClaim) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RedPacketClaim) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RedPacketClaim) GetClaimTime() int64 {
+	if x != nil {
+		return x.ClaimTime
+	}
+	return 0
+}
+
+type RedPacketInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedPacketID   string            `protobuf:"bytes,1,opt,name=redPacketID,proto3" json:"redPacketID"`
+	SendUserID    string            `protobuf:"bytes,2,opt,name=sendUserID,proto3" json:"sendUserID"`
+	Type          string            `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	GroupID       string            `protobuf:"bytes,4,opt,name=groupID,proto3" json:"groupID"`
+	ReceiveUserID string            `protobuf:"bytes,5,opt,name=receiveUserID,proto3" json:"receiveUserID"`
+	TotalAmount   string            `protobuf:"bytes,6,opt,name=totalAmount,proto3" json:"totalAmount"`
+	TotalCount    int32             `protobuf:"varint,7,opt,name=totalCount,proto3" json:"totalCount"`
+	RemainAmount  string            `protobuf:"bytes,8,opt,name=remainAmount,proto3" json:"remainAmount"`
+	RemainCount   int32             `protobuf:"varint,9,opt,name=remainCount,proto3" json:"remainCount"`
+	Status        int32             `protobuf:"varint,10,opt,name=status,proto3" json:"status"`
+	Remark        string            `protobuf:"bytes,11,opt,name=remark,proto3" json:"remark"`
+	Emoji         string            `protobuf:"bytes,12,opt,name=emoji,proto3" json:"emoji"`
+	CreateTime    int64             `protobuf:"varint,13,opt,name=createTime,proto3" json:"createTime"`
+	ExpireTime    int64             `protobuf:"varint,14,opt,name=expireTime,proto3" json:"expireTime"`
+	RefundAmount  string            `protobuf:"bytes,15,opt,name=refundAmount,proto3" json:"refundAmount"`
+	RefundTime    int64             `protobuf:"varint,16,opt,name=refundTime,proto3" json:"refundTime"`
+	Claims        []*RedPacketClaim `protobuf:"bytes,17,rep,name=claims,proto3" json:"claims"`
+}
+
+func (x *RedPacketInfo) Reset() {
+	*x = RedPacketInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedPacketInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedPacketInfo) ProtoMessage() {}
+
+func (x *RedPacketInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedPacketInfo.ProtoReflect.Descriptor instead.
+func (*RedPacketInfo) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *RedPacketInfo) GetRedPacketID() string {
+	if x != nil {
+		return x.RedPacketID
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetSendUserID() string {
+	if x != nil {
+		return x.SendUserID
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetReceiveUserID() string {
+	if x != nil {
+		return x.ReceiveUserID
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *RedPacketInfo) GetRemainAmount() string {
+	if x != nil {
+		return x.RemainAmount
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetRemainCount() int32 {
+	if x != nil {
+		return x.RemainCount
+	}
+	return 0
+}
+
+func (x *RedPacketInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RedPacketInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *RedPacketInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *RedPacketInfo) GetRefundAmount() string {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return ""
+}
+
+func (x *RedPacketInfo) GetRefundTime() int64 {
+	if x != nil {
+		return x.RefundTime
+	}
+	return 0
+}
+
+func (x *RedPacketInfo) GetClaims() []*RedPacketClaim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+type GetRedPacketReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedPacketID string `protobuf:"bytes,1,opt,name=redPacketID,proto3" json:"redPacketID"`
+}
+
+func (x *GetRedPacketReq) Reset() {
+	*x = GetRedPacketReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRedPacketReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedPacketReq) ProtoMessage() {}
+
+func (x *GetRedPacketReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedPacketReq.ProtoReflect.Descriptor instead.
+func (*GetRedPacketReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *GetRedPacketReq) GetRedPacketID() string {
+	if x != nil {
+		return x.RedPacketID
+	}
+	return ""
+}
+
+type GetRedPacketResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedPacket *RedPacketInfo `protobuf:"bytes,1,opt,name=redPacket,proto3" json:"redPacket"`
+}
+
+func (x *GetRedPacketResp) Reset() {
+	*x = GetRedPacketResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRedPacketResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedPacketResp) ProtoMessage() {}
+
+func (x *GetRedPacketResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedPacketResp.ProtoReflect.Descriptor instead.
+func (*GetRedPacketResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *GetRedPacketResp) GetRedPacket() *RedPacketInfo {
+	if x != nil {
+		return x.RedPacket
+	}
+	return nil
+}
+
+type GetRedPacketBalanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetRedPacketBalanceReq) Reset() {
+	*x = GetRedPacketBalanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRedPacketBalanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedPacketBalanceReq) ProtoMessage() {}
+
+func (x *GetRedPacketBalanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedPacketBalanceReq.ProtoReflect.Descriptor instead.
+func (*GetRedPacketBalanceReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *GetRedPacketBalanceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetRedPacketBalanceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (x *GetRedPacketBalanceResp) Reset() {
+	*x = GetRedPacketBalanceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRedPacketBalanceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedPacketBalanceResp) ProtoMessage() {}
+
+func (x *GetRedPacketBalanceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedPacketBalanceResp.ProtoReflect.Descriptor instead.
+func (*GetRedPacketBalanceResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *GetRedPacketBalanceResp) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type AdjustRedPacketBalanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// signed decimal amount, a negative amount is only applied when the balance covers it
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (x *AdjustRedPacketBalanceReq) Reset() {
+	*x = AdjustRedPacketBalanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustRedPacketBalanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustRedPacketBalanceReq) ProtoMessage() {}
+
+func (x *AdjustRedPacketBalanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustRedPacketBalanceReq.ProtoReflect.Descriptor instead.
+func (*AdjustRedPacketBalanceReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *AdjustRedPacketBalanceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AdjustRedPacketBalanceReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdjustRedPacketBalanceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (x *AdjustRedPacketBalanceResp) Reset() {
+	*x = AdjustRedPacketBalanceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustRedPacketBalanceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustRedPacketBalanceResp) ProtoMessage() {}
+
+func (x *AdjustRedPacketBalanceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustRedPacketBalanceResp.ProtoReflect.Descriptor instead.
+func (*AdjustRedPacketBalanceResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *AdjustRedPacketBalanceResp) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type RedPacketCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientMsgID string `protobuf:"bytes,1,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	Operation   string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation"`
+	UserID      string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	RedPacketID string `protobuf:"bytes,4,opt,name=redPacketID,proto3" json:"redPacketID"`
+	Amount      string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	CreateTime  int64  `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *RedPacketCallback) Reset() {
+	*x = RedPacketCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedPacketCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedPacketCallback) ProtoMessage() {}
+
+func (x *RedPacketCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedPacketCallback.ProtoReflect.Descriptor instead.
+func (*RedPacketCallback) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *RedPacketCallback) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *RedPacketCallback) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *RedPacketCallback) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RedPacketCallback) GetRedPacketID() string {
+	if x != nil {
+		return x.RedPacketID
+	}
+	return ""
+}
+
+func (x *RedPacketCallback) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RedPacketCallback) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type FindRedPacketCallbacksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientMsgID string `protobuf:"bytes,1,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+}
+
+func (x *FindRedPacketCallbacksReq) Reset() {
+	*x = FindRedPacketCallbacksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRedPacketCallbacksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRedPacketCallbacksReq) ProtoMessage() {}
+
+func (x *FindRedPacketCallbacksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRedPacketCallbacksReq.ProtoReflect.Descriptor instead.
+func (*FindRedPacketCallbacksReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *FindRedPacketCallbacksReq) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+type FindRedPacketCallbacksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Callbacks []*RedPacketCallback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+}
+
+func (x *FindRedPacketCallbacksResp) Reset() {
+	*x = FindRedPacketCallbacksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRedPacketCallbacksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRedPacketCallbacksResp) ProtoMessage() {}
+
+func (x *FindRedPacketCallbacksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRedPacketCallbacksResp.ProtoReflect.Descriptor instead.
+func (*FindRedPacketCallbacksResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *FindRedPacketCallbacksResp) GetCallbacks() []*RedPacketCallback {
+	if x != nil {
+		return x.Callbacks
+	}
+	return nil
+}
+
+type CallbackMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// command, or command/customType:<n> for custom messages
+	Route    string `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+	Calls    int64  `protobuf:"varint,2,opt,name=calls,proto3" json:"calls"`
+	Errors   int64  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors"`
+	Rejects  int64  `protobuf:"varint,4,opt,name=rejects,proto3" json:"rejects"`
+	Timeouts int64  `protobuf:"varint,5,opt,name=timeouts,proto3" json:"timeouts"`
+	// milliseconds
+	AvgDuration int64 `protobuf:"varint,6,opt,name=avgDuration,proto3" json:"avgDuration"`
+	MaxDuration int64 `protobuf:"varint,7,opt,name=maxDuration,proto3" json:"maxDuration"`
+}
+
+func (x *CallbackMetric) Reset() {
+	*x = CallbackMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallbackMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackMetric) ProtoMessage() {}
+
+func (x *CallbackMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackMetric.ProtoReflect.Descriptor instead.
+func (*CallbackMetric) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *CallbackMetric) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *CallbackMetric) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *CallbackMetric) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CallbackMetric) GetRejects() int64 {
+	if x != nil {
+		return x.Rejects
+	}
+	return 0
+}
+
+func (x *CallbackMetric) GetTimeouts() int64 {
+	if x != nil {
+		return x.Timeouts
+	}
+	return 0
+}
+
+func (x *CallbackMetric) GetAvgDuration() int64 {
+	if x != nil {
+		return x.AvgDuration
+	}
+	return 0
+}
+
+func (x *CallbackMetric) GetMaxDuration() int64 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+type GetCallbackMetricsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCallbackMetricsReq) Reset() {
+	*x = GetCallbackMetricsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCallbackMetricsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallbackMetricsReq) ProtoMessage() {}
+
+func (x *GetCallbackMetricsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallbackMetricsReq.ProtoReflect.Descriptor instead.
+func (*GetCallbackMetricsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{184}
+}
+
+type GetCallbackMetricsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics []*CallbackMetric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics"`
+}
+
+func (x *GetCallbackMetricsResp) Reset() {
+	*x = GetCallbackMetricsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCallbackMetricsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallbackMetricsResp) ProtoMessage() {}
+
+func (x *GetCallbackMetricsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallbackMetricsResp.ProtoReflect.Descriptor instead.
+func (*GetCallbackMetricsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *GetCallbackMetricsResp) GetMetrics() []*CallbackMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+var File_chat_chat_proto protoreflect.FileDescriptor
+
+var file_chat_chat_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x1a, 0x13,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x0a, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2b, 0x0a, 0x0f, 0x67, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x7a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd9, 0x05, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x66,
+	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x32, 0x0a,
+	0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x75,
+	0x74, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42,
+	0x65, 0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x65,
+	0x70, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x67,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x12,
+	0x3f, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x45, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf0, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x46, 0x69,
+	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x50, 0x0a,
+	0x1b, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x4d, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x8f,
+	0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x65, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x49, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65,
+	0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x83, 0x01, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5b, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x64, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x10,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xbe, 0x01, 0x0a, 0x08, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xad, 0x02, 0x0a, 0x0b, 0x55,
+	0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0d, 0x41,
+	0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x29, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x40, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x76, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x81, 0x04, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6e, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x69, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x3b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x28, 0x0a, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x1a, 0x0a, 0x18,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x62, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69,
+	0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22,
+	0x83, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x22, 0x68, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4c,
+	0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x30, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x22, 0x48,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65,
+	0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65,
+	0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x34, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x22, 0x4c, 0x0a,
+	0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x33, 0x0a, 0x11, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44,
+	0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x77, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a,
+	0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65,
+	0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x2e, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0x53, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0e,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a,
+	0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0xb6, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7, 0x03, 0x0a, 0x0c, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x52, 0x4c, 0x22, 0x41, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x80, 0x05, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65,
+	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x44, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x44,
+	0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73,
+	0x67, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0xa5, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x22, 0x61, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75,
+	0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0xd4, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75,
+	0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x38, 0x0a,
+	0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa3, 0x01,
+	0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x22, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f,
+	0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0x51, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x12, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x2d, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x14, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x18, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72,
+	0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x22, 0x35, 0x0a, 0x17,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x73, 0x61, 0x76, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x37, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x26, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x22, 0x29, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f,
+	0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28,
+	0x0a, 0x26, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x29, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f,
+	0x6d, 0x41, 0x6c, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0xd6, 0x0a,
+	0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6b, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
+	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73,
+	0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x45, 0x0a,
+	0x0e, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x33,
+	0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x50,
+	0x6f, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x6f, 0x6f, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x70,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x23,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73,
+	0x18, 0x24, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x18, 0x25,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x26, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0xae, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4d, 0x73, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x26, 0x0a, 0x0e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25,
+	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x09, 0x54, 0x79,
+	0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x5a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x60, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x69,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x34, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22,
+	0x38, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x22, 0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22,
+	0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4d, 0x73, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x22,
+	0x35, 0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x09,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x22, 0x52, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x22, 0x3f, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69,
+	0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73,
+	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x28, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22,
+	0x4a, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0x58, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
+	0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x22,
+	0xce, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8c, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x36,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4d, 0x73, 0x67, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x40,
+	0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x69, 0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x69,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb9, 0x02, 0x0a,
+	0x10, 0x50, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x71, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x18, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x61,
+	0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x36, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x05, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x6f, 0x73,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6b,
+	0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c,
+	0x69, 0x6b, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x79, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x79,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0x4c, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4b, 0x0a, 0x14, 0x53,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x5a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x22, 0x15, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x61, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x44, 0x22, 0xd0, 0x03, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0xf1, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xff,
+	0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0xa3,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x32, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x22, 0x59, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x12, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0xc3, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x1c, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x32, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x22, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x2d,
+	0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xaa, 0x02,
+	0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x65, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x22, 0x3f, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x5e, 0x0a,
+	0x0e, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xac, 0x04,
+	0x0a, 0x0d, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x22, 0x33, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x44, 0x22, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22,
+	0x30, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4b, 0x0a, 0x19, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x1a, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73,
+	0x67, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67,
+	0x49, 0x44, 0x22, 0x5a, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xce,
+	0x01, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x76, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x61, 0x76, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x32, 0xf7, 0x38, 0x0a, 0x04, 0x63, 0x68,
+	0x61, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a,
+	0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x72, 0x0a, 0x1a,
+	0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x4f, 0x66, 0x4f, 0x6e, 0x65,
+	0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75,
+	0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x57, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e,
+	0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1e,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61,
+	0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x54, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x54, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x41, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x11,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b,
+	0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1c,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6f, 0x0a, 0x18, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x51, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x51, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x12, 0x73, 0x61, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x22, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x73, 0x61, 0x76, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x60, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x94, 0x01, 0x0a, 0x27, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x94, 0x01, 0x0a, 0x27, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x83, 0x01, 0x0a, 0x21, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d,
+	0x41, 0x6c, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x67, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x75, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x69, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x63, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a,
+	0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a,
+	0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x50,
+	0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x15, 0x4d,
+	0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x46, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x45, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x63, 0x68, 0x61, 0x74,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
+	0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chat_chat_proto_rawDescOnce sync.Once
+	file_chat_chat_proto_rawDescData = file_chat_chat_proto_rawDesc
+)
+
+func file_chat_chat_proto_rawDescGZIP() []byte {
+	file_chat_chat_proto_rawDescOnce.Do(func() {
+		file_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_chat_proto_rawDescData)
+	})
+	return file_chat_chat_proto_rawDescData
+}
+
+var file_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 189)
+var file_chat_chat_proto_goTypes = []any{
+	(*OnlineTime)(nil),                              // 0: openim.chat.onlineTime
+	(*GetUsersTimeReq)(nil),                         // 1: openim.chat.getUsersTimeReq
+	(*GetUsersTimeResp)(nil),                        // 2: openim.chat.getUsersTimeResp
+	(*UserIdentity)(nil),                            // 3: openim.chat.UserIdentity
+	(*UpdateUserInfoReq)(nil),                       // 4: openim.chat.UpdateUserInfoReq
+	(*UpdateUserInfoResp)(nil),                      // 5: openim.chat.UpdateUserInfoResp
+	(*FindUserPublicInfoReq)(nil),                   // 6: openim.chat.FindUserPublicInfoReq
+	(*FindUserPublicInfoRespOfOne)(nil),             // 7: openim.chat.FindUserPublicInfoRespOfOne
+	(*FindUserPublicInfoResp)(nil),                  // 8: openim.chat.FindUserPublicInfoResp
+	(*SearchUserPublicInfoReq)(nil),                 // 9: openim.chat.SearchUserPublicInfoReq
+	(*SearchUserPublicInfoResp)(nil),                // 10: openim.chat.SearchUserPublicInfoResp
+	(*FindUserFullInfoReq)(nil),                     // 11: openim.chat.FindUserFullInfoReq
+	(*FindUserFullInfoResp)(nil),                    // 12: openim.chat.FindUserFullInfoResp
+	(*GetAllUserIDsReq)(nil),                        // 13: openim.chat.GetAllUserIDsReq
+	(*GetAllUserIDsResp)(nil),                       // 14: openim.chat.GetAllUserIDsResp
+	(*SendVerifyCodeReq)(nil),                       // 15: openim.chat.SendVerifyCodeReq
+	(*SendVerifyCodeResp)(nil),                      // 16: openim.chat.SendVerifyCodeResp
+	(*VerifyCodeReq)(nil),                           // 17: openim.chat.VerifyCodeReq
+	(*VerifyCodeResp)(nil),                          // 18: openim.chat.VerifyCodeResp
+	(*ChallengeNonceReq)(nil),                       // 19: openim.chat.ChallengeNonceReq
+	(*ChallengeNonceResp)(nil),                      // 20: openim.chat.ChallengeNonceResp
+	(*RegisterUserInfo)(nil),                        // 21: openim.chat.RegisterUserInfo
+	(*RegisterUserReq)(nil),                         // 22: openim.chat.RegisterUserReq
+	(*RegisterUserResp)(nil),                        // 23: openim.chat.RegisterUserResp
+	(*AddUserAccountReq)(nil),                       // 24: openim.chat.AddUserAccountReq
+	(*AddUserAccountResp)(nil),                      // 25: openim.chat.AddUserAccountResp
+	(*LoginReq)(nil),                                // 26: openim.chat.LoginReq
+	(*UserKeyInfo)(nil),                             // 27: openim.chat.UserKeyInfo
+	(*AddUserKeyReq)(nil),                           // 28: openim.chat.AddUserKeyReq
+	(*AddUserKeyResp)(nil),                          // 29: openim.chat.AddUserKeyResp
+	(*ListUserKeysReq)(nil),                         // 30: openim.chat.ListUserKeysReq
+	(*ListUserKeysResp)(nil),                        // 31: openim.chat.ListUserKeysResp
+	(*RevokeUserKeyReq)(nil),                        // 32: openim.chat.RevokeUserKeyReq
+	(*RevokeUserKeyResp)(nil),                       // 33: openim.chat.RevokeUserKeyResp
+	(*RecoveryApproval)(nil),                        // 34: openim.chat.RecoveryApproval
+	(*RecoveryInfo)(nil),                            // 35: openim.chat.RecoveryInfo
+	(*SetRecoveryGuardiansReq)(nil),                 // 36: openim.chat.SetRecoveryGuardiansReq
+	(*SetRecoveryGuardiansResp)(nil),                // 37: openim.chat.SetRecoveryGuardiansResp
+	(*GetRecoveryGuardiansReq)(nil),                 // 38: openim.chat.GetRecoveryGuardiansReq
+	(*GetRecoveryGuardiansResp)(nil),                // 39: openim.chat.GetRecoveryGuardiansResp
+	(*InitiateRecoveryReq)(nil),                     // 40: openim.chat.InitiateRecoveryReq
+	(*InitiateRecoveryResp)(nil),                    // 41: openim.chat.InitiateRecoveryResp
+	(*ApproveRecoveryReq)(nil),                      // 42: openim.chat.ApproveRecoveryReq
+	(*ApproveRecoveryResp)(nil),                     // 43: openim.chat.ApproveRecoveryResp
+	(*GetRecoveryReq)(nil),                          // 44: openim.chat.GetRecoveryReq
+	(*GetRecoveryResp)(nil),                         // 45: openim.chat.GetRecoveryResp
+	(*StartAdminRecoveryReq)(nil),                   // 46: openim.chat.StartAdminRecoveryReq
+	(*StartAdminRecoveryResp)(nil),                  // 47: openim.chat.StartAdminRecoveryResp
+	(*ExecuteRecoveryReq)(nil),                      // 48: openim.chat.ExecuteRecoveryReq
+	(*ExecuteRecoveryResp)(nil),                     // 49: openim.chat.ExecuteRecoveryResp
+	(*CancelRecoveryReq)(nil),                       // 50: openim.chat.CancelRecoveryReq
+	(*CancelRecoveryResp)(nil),                      // 51: openim.chat.CancelRecoveryResp
+	(*ResetPasswordReq)(nil),                        // 52: openim.chat.ResetPasswordReq
+	(*ResetPasswordResp)(nil),                       // 53: openim.chat.ResetPasswordResp
+	(*ChangePasswordReq)(nil),                       // 54: openim.chat.ChangePasswordReq
+	(*ChangePasswordResp)(nil),                      // 55: openim.chat.ChangePasswordResp
+	(*FindUserAccountReq)(nil),                      // 56: openim.chat.FindUserAccountReq
+	(*FindUserByAddressOrAccountReq)(nil),           // 57: openim.chat.FindUserByAddressOrAccountReq
+	(*FindUserAccountResp)(nil),                     // 58: openim.chat.FindUserAccountResp
+	(*FindAccountUserReq)(nil),                      // 59: openim.chat.FindAccountUserReq
+	(*FindAccountUserResp)(nil),                     // 60: openim.chat.FindAccountUserResp
+	(*SignalRecord)(nil),                            // 61: openim.chat.SignalRecord
+	(*OpenIMCallbackReq)(nil),                       // 62: openim.chat.OpenIMCallbackReq
+	(*OpenIMCallbackResp)(nil),                      // 63: openim.chat.OpenIMCallbackResp
+	(*SearchUserFullInfoReq)(nil),                   // 64: openim.chat.SearchUserFullInfoReq
+	(*SearchUserFullInfoResp)(nil),                  // 65: openim.chat.SearchUserFullInfoResp
+	(*UserLoginCountReq)(nil),                       // 66: openim.chat.UserLoginCountReq
+	(*UserLoginCountResp)(nil),                      // 67: openim.chat.UserLoginCountResp
+	(*LoginResp)(nil),                               // 68: openim.chat.LoginResp
+	(*SearchUserInfoReq)(nil),                       // 69: openim.chat.SearchUserInfoReq
+	(*SearchUserInfoResp)(nil),                      // 70: openim.chat.SearchUserInfoResp
+	(*GetTokenForVideoMeetingReq)(nil),              // 71: openim.chat.GetTokenForVideoMeetingReq
+	(*GetTokenForVideoMeetingResp)(nil),             // 72: openim.chat.GetTokenForVideoMeetingResp
+	(*CheckUserExistReq)(nil),                       // 73: openim.chat.CheckUserExistReq
+	(*CheckUserExistResp)(nil),                      // 74: openim.chat.CheckUserExistResp
+	(*DelUserAccountReq)(nil),                       // 75: openim.chat.DelUserAccountReq
+	(*DelUserAccountResp)(nil),                      // 76: openim.chat.DelUserAccountResp
+	(*GetGroupFromContactReq)(nil),                  // 77: openim.chat.getGroupFromContactReq
+	(*GetGroupFromContactResp)(nil),                 // 78: openim.chat.getGroupFromContactResp
+	(*SaveGroupToContactReq)(nil),                   // 79: openim.chat.saveGroupToContactReq
+	(*SaveGroupToContactResp)(nil),                  // 80: openim.chat.saveGroupToContactResp
+	(*DeleteGroupFromContactReq)(nil),               // 81: openim.chat.deleteGroupFromContactReq
+	(*DeleteGroupFromContactResp)(nil),              // 82: openim.chat.deleteGroupFromContactResp
+	(*DeleteGroupApplicationFromRecipientReq)(nil),  // 83: openim.chat.DeleteGroupApplicationFromRecipientReq
+	(*DeleteGroupApplicationFromRecipientResp)(nil), // 84: openim.chat.DeleteGroupApplicationFromRecipientResp
+	(*DeleteGroupApplicationFromApplicantReq)(nil),  // 85: openim.chat.DeleteGroupApplicationFromApplicantReq
+	(*DeleteGroupApplicationFromApplicantResp)(nil), // 86: openim.chat.DeleteGroupApplicationFromApplicantResp
+	(*DeleteGroupApplicationFromAlltReq)(nil),       // 87: openim.chat.DeleteGroupApplicationFromAlltReq
+	(*DeleteGroupApplicationFromAllResp)(nil),       // 88: openim.chat.DeleteGroupApplicationFromAllResp
+	(*Post)(nil),                                    // 89: openim.chat.Post
+	(*PublishPostReq)(nil),                          // 90: openim.chat.PublishPostReq
+	(*PublishPostResp)(nil),                         // 91: openim.chat.PublishPostResp
+	(*GetPostByIDReq)(nil),                          // 92: openim.chat.GetPostByIDReq
+	(*GetPostByIDResp)(nil),                         // 93: openim.chat.GetPostByIDResp
+	(*GetAllTypePostReq)(nil),                       // 94: openim.chat.GetAllTypePostReq
+	(*GetAllTypePostResp)(nil),                      // 95: openim.chat.GetAllTypePostResp
+	(*TypePosts)(nil),                               // 96: openim.chat.TypePosts
+	(*GetPostListReq)(nil),                          // 97: openim.chat.GetPostListReq
+	(*GetPostListResp)(nil),                         // 98: openim.chat.GetPostListResp
+	(*GetPostListByUserReq)(nil),                    // 99: openim.chat.GetPostListByUserReq
+	(*GetPostListByUserResp)(nil),                   // 100: openim.chat.GetPostListByUserResp
+	(*GetCommentPostListByPostIDReq)(nil),           // 101: openim.chat.GetCommentPostListByPostIDReq
+	(*GetCommentPostListByPostIDResp)(nil),          // 102: openim.chat.GetCommentPostListByPostIDResp
+	(*DeletePostReq)(nil),                           // 103: openim.chat.DeletePostReq
+	(*DeletePostResp)(nil),                          // 104: openim.chat.DeletePostResp
+	(*UserCounter)(nil),                             // 105: openim.chat.UserCounter
+	(*GetUserCountersReq)(nil),                      // 106: openim.chat.GetUserCountersReq
+	(*GetUserCountersResp)(nil),                     // 107: openim.chat.GetUserCountersResp
+	(*RestorePostReq)(nil),                          // 108: openim.chat.RestorePostReq
+	(*RestorePostResp)(nil),                         // 109: openim.chat.RestorePostResp
+	(*GetDeletedPostListReq)(nil),                   // 110: openim.chat.GetDeletedPostListReq
+	(*GetDeletedPostListResp)(nil),                  // 111: openim.chat.GetDeletedPostListResp
+	(*ChangeAllowCommentPostReq)(nil),               // 112: openim.chat.ChangeAllowCommentPostReq
+	(*ChangeAllowCommentPostResp)(nil),              // 113: openim.chat.ChangeAllowCommentPostResp
+	(*ChangeAllowForwardPostReq)(nil),               // 114: openim.chat.ChangeAllowForwardPostReq
+	(*ChangeAllowForwardPostResp)(nil),              // 115: openim.chat.ChangeAllowForwardPostResp
+	(*EditPostReq)(nil),                             // 116: openim.chat.EditPostReq
+	(*EditPostResp)(nil),                            // 117: openim.chat.EditPostResp
+	(*PostRevision)(nil),                            // 118: openim.chat.PostRevision
+	(*GetPostRevisionsReq)(nil),                     // 119: openim.chat.GetPostRevisionsReq
+	(*GetPostRevisionsResp)(nil),                    // 120: openim.chat.GetPostRevisionsResp
+	(*ChangePostVisibilityReq)(nil),                 // 121: openim.chat.ChangePostVisibilityReq
+	(*ChangePostVisibilityResp)(nil),                // 122: openim.chat.ChangePostVisibilityResp
+	(*LikePostReq)(nil),                             // 123: openim.chat.LikePostReq
+	(*LikePostResp)(nil),                            // 124: openim.chat.LikePostResp
+	(*CollectPostReq)(nil),                          // 125: openim.chat.CollectPostReq
+	(*CollectPostResp)(nil),                         // 126: openim.chat.CollectPostResp
+	(*ForwardPostReq)(nil),                          // 127: openim.chat.ForwardPostReq
+	(*ForwardPostResp)(nil),                         // 128: openim.chat.ForwardPostResp
+	(*ReferencePostReq)(nil),                        // 129: openim.chat.ReferencePostReq
+	(*ReferencePostResp)(nil),                       // 130: openim.chat.ReferencePostResp
+	(*CommentPostReq)(nil),                          // 131: openim.chat.CommentPostReq
+	(*CommentPostResp)(nil),                         // 132: openim.chat.CommentPostResp
+	(*PinPostReq)(nil),                              // 133: openim.chat.PinPostReq
+	(*PinPostResp)(nil),                             // 134: openim.chat.PinPostResp
+	(*PinCommentReq)(nil),                           // 135: openim.chat.PinCommentReq
+	(*PinCommentResp)(nil),                          // 136: openim.chat.PinCommentResp
+	(*PostNotification)(nil),                        // 137: openim.chat.PostNotification
+	(*GetNotificationsReq)(nil),                     // 138: openim.chat.GetNotificationsReq
+	(*GetNotificationsResp)(nil),                    // 139: openim.chat.GetNotificationsResp
+	(*MarkNotificationsReadReq)(nil),                // 140: openim.chat.MarkNotificationsReadReq
+	(*MarkNotificationsReadResp)(nil),               // 141: openim.chat.MarkNotificationsReadResp
+	(*GetUnreadNotificationCountReq)(nil),           // 142: openim.chat.GetUnreadNotificationCountReq
+	(*GetUnreadNotificationCountResp)(nil),          // 143: openim.chat.GetUnreadNotificationCountResp
+	(*SearchPostsReq)(nil),                          // 144: openim.chat.SearchPostsReq
+	(*SearchPostsResp)(nil),                         // 145: openim.chat.SearchPostsResp
+	(*Topic)(nil),                                   // 146: openim.chat.Topic
+	(*DiscoverConfig)(nil),                          // 147: openim.chat.DiscoverConfig
+	(*GetDiscoverConfigReq)(nil),                    // 148: openim.chat.GetDiscoverConfigReq
+	(*GetDiscoverConfigResp)(nil),                   // 149: openim.chat.GetDiscoverConfigResp
+	(*SetDiscoverConfigReq)(nil),                    // 150: openim.chat.SetDiscoverConfigReq
+	(*SetDiscoverConfigResp)(nil),                   // 151: openim.chat.SetDiscoverConfigResp
+	(*SetPostDiscoverReq)(nil),                      // 152: openim.chat.SetPostDiscoverReq
+	(*SetPostDiscoverResp)(nil),                     // 153: openim.chat.SetPostDiscoverResp
+	(*ReportPostReq)(nil),                           // 154: openim.chat.ReportPostReq
+	(*ReportPostResp)(nil),                          // 155: openim.chat.ReportPostResp
+	(*PostReport)(nil),                              // 156: openim.chat.PostReport
+	(*SearchPostReportsReq)(nil),                    // 157: openim.chat.SearchPostReportsReq
+	(*SearchPostReportsResp)(nil),                   // 158: openim.chat.SearchPostReportsResp
+	(*PostModerationLog)(nil),                       // 159: openim.chat.PostModerationLog
+	(*GetReportedPostReq)(nil),                      // 160: openim.chat.GetReportedPostReq
+	(*GetReportedPostResp)(nil),                     // 161: openim.chat.GetReportedPostResp
+	(*ModeratePostReq)(nil),                         // 162: openim.chat.ModeratePostReq
+	(*ModeratePostResp)(nil),                        // 163: openim.chat.ModeratePostResp
+	(*SearchPostModerationLogsReq)(nil),             // 164: openim.chat.SearchPostModerationLogsReq
+	(*SearchPostModerationLogsResp)(nil),            // 165: openim.chat.SearchPostModerationLogsResp
+	(*GetTopicFeedReq)(nil),                         // 166: openim.chat.GetTopicFeedReq
+	(*GetTopicFeedResp)(nil),                        // 167: openim.chat.GetTopicFeedResp
+	(*CheckVersionReq)(nil),                         // 168: openim.chat.CheckVersionReq
+	(*CheckVersionResp)(nil),                        // 169: openim.chat.CheckVersionResp
+	(*GetFakeUserReq)(nil),                          // 170: openim.chat.GetFakeUserReq
+	(*GetFakeUserResp)(nil),                         // 171: openim.chat.GetFakeUserResp
+	(*RedPacketClaim)(nil),                          // 172: openim.chat.RedPacketClaim
+	(*RedPacketInfo)(nil),                           // 173: openim.chat.RedPacketInfo
+	(*GetRedPacketReq)(nil),                         // 174: openim.chat.GetRedPacketReq
+	(*GetRedPacketResp)(nil),                        // 175: openim.chat.GetRedPacketResp
+	(*GetRedPacketBalanceReq)(nil),                  // 176: openim.chat.GetRedPacketBalanceReq
+	(*GetRedPacketBalanceResp)(nil),                 // 177: openim.chat.GetRedPacketBalanceResp
+	(*AdjustRedPacketBalanceReq)(nil),               // 178: openim.chat.AdjustRedPacketBalanceReq
+	(*AdjustRedPacketBalanceResp)(nil),              // 179: openim.chat.AdjustRedPacketBalanceResp
+	(*RedPacketCallback)(nil),                       // 180: openim.chat.RedPacketCallback
+	(*FindRedPacketCallbacksReq)(nil),               // 181: openim.chat.FindRedPacketCallbacksReq
+	(*FindRedPacketCallbacksResp)(nil),              // 182: openim.chat.FindRedPacketCallbacksResp
+	(*CallbackMetric)(nil),                          // 183: openim.chat.CallbackMetric
+	(*GetCallbackMetricsReq)(nil),                   // 184: openim.chat.GetCallbackMetricsReq
+	(*GetCallbackMetricsResp)(nil),                  // 185: openim.chat.GetCallbackMetricsResp
+	nil,                                             // 186: openim.chat.FindUserAccountResp.UserAccountMapEntry
+	nil,                                             // 187: openim.chat.FindAccountUserResp.AccountUserMapEntry
+	nil,                                             // 188: openim.chat.UserLoginCountResp.CountEntry
+	(*wrapperspb.StringValue)(nil),                  // 189: openim.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),                   // 190: openim.protobuf.Int32Value
+	(*common.UserPublicInfo)(nil),                   // 191: openim.common.UserPublicInfo
+	(*sdkwss.RequestPagination)(nil),                // 192: openim.sdkwss.RequestPagination
+	(*common.UserFullInfo)(nil),                     // 193: openim.common.UserFullInfo
+	(*common.PostMedia)(nil),                        // 194: openim.common.PostMedia
+}
+var file_chat_chat_proto_depIdxs = []int32{
+	0,   // 0: openim.chat.getUsersTimeResp.timeList:type_name -> openim.chat.onlineTime
+	189, // 1: openim.chat.UpdateUserInfoReq.account:type_name -> openim.protobuf.StringValue
+	189, // 2: openim.chat.UpdateUserInfoReq.nickname:type_name -> openim.protobuf.StringValue
+	189, // 3: openim.chat.UpdateUserInfoReq.faceURL:type_name -> openim.protobuf.StringValue
+	189, // 4: openim.chat.UpdateUserInfoReq.coverURL:type_name -> openim.protobuf.StringValue
+	189, // 5: openim.chat.UpdateUserInfoReq.about:type_name -> openim.protobuf.StringValue
+	190, // 6: openim.chat.UpdateUserInfoReq.allowAddFriend:type_name -> openim.protobuf.Int32Value
+	190, // 7: openim.chat.UpdateUserInfoReq.allowBeep:type_name -> openim.protobuf.Int32Value
+	190, // 8: openim.chat.UpdateUserInfoReq.allowVibration:type_name -> openim.protobuf.Int32Value
+	190, // 9: openim.chat.UpdateUserInfoReq.globalRecvMsgOpt:type_name -> openim.protobuf.Int32Value
+	190, // 10: openim.chat.UpdateUserInfoReq.RegisterType:type_name -> openim.protobuf.Int32Value
+	190, // 11: openim.chat.UpdateUserInfoReq.postInteraction:type_name -> openim.protobuf.Int32Value
+	189, // 12: openim.chat.UpdateUserInfoResp.faceURL:type_name -> openim.protobuf.StringValue
+	189, // 13: openim.chat.UpdateUserInfoResp.coverURL:type_name -> openim.protobuf.StringValue
+	189, // 14: openim.chat.UpdateUserInfoResp.about:type_name -> openim.protobuf.StringValue
+	191, // 15: openim.chat.FindUserPublicInfoRespOfOne.user:type_name -> openim.common.UserPublicInfo
+	191, // 16: openim.chat.FindUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
+	192, // 17: openim.chat.SearchUserPublicInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	191, // 18: openim.chat.SearchUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
+	193, // 19: openim.chat.FindUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
+	21,  // 20: openim.chat.RegisterUserReq.user:type_name -> openim.chat.RegisterUserInfo
+	21,  // 21: openim.chat.AddUserAccountReq.user:type_name -> openim.chat.RegisterUserInfo
+	27,  // 22: openim.chat.ListUserKeysResp.keys:type_name -> openim.chat.UserKeyInfo
+	34,  // 23: openim.chat.RecoveryInfo.approvals:type_name -> openim.chat.RecoveryApproval
+	35,  // 24: openim.chat.InitiateRecoveryResp.recovery:type_name -> openim.chat.RecoveryInfo
+	35,  // 25: openim.chat.ApproveRecoveryResp.recovery:type_name -> openim.chat.RecoveryInfo
+	35,  // 26: openim.chat.GetRecoveryResp.recovery:type_name -> openim.chat.RecoveryInfo
+	35,  // 27: openim.chat.StartAdminRecoveryResp.recovery:type_name -> openim.chat.RecoveryInfo
+	35,  // 28: openim.chat.ExecuteRecoveryResp.recovery:type_name -> openim.chat.RecoveryInfo
+	186, // 29: openim.chat.FindUserAccountResp.userAccountMap:type_name -> openim.chat.FindUserAccountResp.UserAccountMapEntry
+	187, // 30: openim.chat.FindAccountUserResp.accountUserMap:type_name -> openim.chat.FindAccountUserResp.AccountUserMapEntry
+	191, // 31: openim.chat.SignalRecord.inviterUserList:type_name -> openim.common.UserPublicInfo
+	192, // 32: openim.chat.SearchUserFullInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	193, // 33: openim.chat.SearchUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
+	188, // 34: openim.chat.UserLoginCountResp.count:type_name -> openim.chat.UserLoginCountResp.CountEntry
+	192, // 35: openim.chat.SearchUserInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	193, // 36: openim.chat.SearchUserInfoResp.users:type_name -> openim.common.UserFullInfo
+	21,  // 37: openim.chat.CheckUserExistReq.user:type_name -> openim.chat.RegisterUserInfo
+	191, // 38: openim.chat.Post.userInfo:type_name -> openim.common.UserPublicInfo
+	194, // 39: openim.chat.Post.mediaMsgs:type_name -> openim.common.PostMedia
+	191, // 40: openim.chat.Post.atUserInfoList:type_name -> openim.common.UserPublicInfo
+	89,  // 41: openim.chat.Post.forwardPost:type_name -> openim.chat.Post
+	89,  // 42: openim.chat.Post.commentPost:type_name -> openim.chat.Post
+	89,  // 43: openim.chat.Post.refPost:type_name -> openim.chat.Post
+	89,  // 44: openim.chat.Post.replies:type_name -> openim.chat.Post
+	189, // 45: openim.chat.PublishPostReq.content:type_name -> openim.protobuf.StringValue
+	194, // 46: openim.chat.PublishPostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	89,  // 47: openim.chat.PublishPostResp.post:type_name -> openim.chat.Post
+	89,  // 48: openim.chat.GetPostByIDResp.post:type_name -> openim.chat.Post
+	96,  // 49: openim.chat.GetAllTypePostResp.allPosts:type_name -> openim.chat.TypePosts
+	89,  // 50: openim.chat.TypePosts.posts:type_name -> openim.chat.Post
+	89,  // 51: openim.chat.GetPostListResp.posts:type_name -> openim.chat.Post
+	89,  // 52: openim.chat.GetPostListByUserResp.posts:type_name -> openim.chat.Post
+	89,  // 53: openim.chat.GetCommentPostListByPostIDResp.posts:type_name -> openim.chat.Post
+	105, // 54: openim.chat.GetUserCountersResp.counters:type_name -> openim.chat.UserCounter
+	89,  // 55: openim.chat.RestorePostResp.post:type_name -> openim.chat.Post
+	89,  // 56: openim.chat.GetDeletedPostListResp.posts:type_name -> openim.chat.Post
+	189, // 57: openim.chat.EditPostReq.content:type_name -> openim.protobuf.StringValue
+	194, // 58: openim.chat.EditPostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	89,  // 59: openim.chat.EditPostResp.post:type_name -> openim.chat.Post
+	194, // 60: openim.chat.PostRevision.mediaMsgs:type_name -> openim.common.PostMedia
+	118, // 61: openim.chat.GetPostRevisionsResp.revisions:type_name -> openim.chat.PostRevision
+	189, // 62: openim.chat.ReferencePostReq.content:type_name -> openim.protobuf.StringValue
+	194, // 63: openim.chat.ReferencePostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	189, // 64: openim.chat.CommentPostReq.content:type_name -> openim.protobuf.StringValue
+	194, // 65: openim.chat.CommentPostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	89,  // 66: openim.chat.CommentPostResp.post:type_name -> openim.chat.Post
+	191, // 67: openim.chat.PostNotification.actors:type_name -> openim.common.UserPublicInfo
+	192, // 68: openim.chat.GetNotificationsReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	137, // 69: openim.chat.GetNotificationsResp.notifications:type_name -> openim.chat.PostNotification
+	192, // 70: openim.chat.SearchPostsReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	89,  // 71: openim.chat.SearchPostsResp.posts:type_name -> openim.chat.Post
+	147, // 72: openim.chat.GetDiscoverConfigResp.config:type_name -> openim.chat.DiscoverConfig
+	147, // 73: openim.chat.SetDiscoverConfigReq.config:type_name -> openim.chat.DiscoverConfig
+	191, // 74: openim.chat.PostReport.reporter:type_name -> openim.common.UserPublicInfo
+	191, // 75: openim.chat.PostReport.author:type_name -> openim.common.UserPublicInfo
+	190, // 76: openim.chat.SearchPostReportsReq.status:type_name -> openim.protobuf.Int32Value
+	192, // 77: openim.chat.SearchPostReportsReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	156, // 78: openim.chat.SearchPostReportsResp.reports:type_name -> openim.chat.PostReport
+	89,  // 79: openim.chat.GetReportedPostResp.post:type_name -> openim.chat.Post
+	156, // 80: openim.chat.GetReportedPostResp.reports:type_name -> openim.chat.PostReport
+	159, // 81: openim.chat.GetReportedPostResp.logs:type_name -> openim.chat.PostModerationLog
+	192, // 82: openim.chat.SearchPostModerationLogsReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	159, // 83: openim.chat.SearchPostModerationLogsResp.logs:type_name -> openim.chat.PostModerationLog
+	146, // 84: openim.chat.GetTopicFeedResp.topic:type_name -> openim.chat.Topic
+	89,  // 85: openim.chat.GetTopicFeedResp.posts:type_name -> openim.chat.Post
+	172, // 86: openim.chat.RedPacketInfo.claims:type_name -> openim.chat.RedPacketClaim
+	173, // 87: openim.chat.GetRedPacketResp.redPacket:type_name -> openim.chat.RedPacketInfo
+	180, // 88: openim.chat.FindRedPacketCallbacksResp.callbacks:type_name -> openim.chat.RedPacketCallback
+	183, // 89: openim.chat.GetCallbackMetricsResp.metrics:type_name -> openim.chat.CallbackMetric
+	4,   // 90: openim.chat.chat.UpdateUserInfo:input_type -> openim.chat.UpdateUserInfoReq
+	24,  // 91: openim.chat.chat.AddUserAccount:input_type -> openim.chat.AddUserAccountReq
+	9,   // 92: openim.chat.chat.SearchUserPublicInfo:input_type -> openim.chat.SearchUserPublicInfoReq
+	6,   // 93: openim.chat.chat.FindUserPublicInfo:input_type -> openim.chat.FindUserPublicInfoReq
+	57,  // 94: openim.chat.chat.FindUserByAddressOrAccount:input_type -> openim.chat.FindUserByAddressOrAccountReq
+	64,  // 95: openim.chat.chat.SearchUserFullInfo:input_type -> openim.chat.SearchUserFullInfoReq
+	11,  // 96: openim.chat.chat.FindUserFullInfo:input_type -> openim.chat.FindUserFullInfoReq
+	15,  // 97: openim.chat.chat.SendVerifyCode:input_type -> openim.chat.SendVerifyCodeReq
+	17,  // 98: openim.chat.chat.VerifyCode:input_type -> openim.chat.VerifyCodeReq
+	19,  // 99: openim.chat.chat.ChallengeNonce:input_type -> openim.chat.ChallengeNonceReq
+	22,  // 100: openim.chat.chat.RegisterUser:input_type -> openim.chat.RegisterUserReq
+	26,  // 101: openim.chat.chat.Login:input_type -> openim.chat.LoginReq
+	28,  // 102: openim.chat.chat.AddUserKey:input_type -> openim.chat.AddUserKeyReq
+	30,  // 103: openim.chat.chat.ListUserKeys:input_type -> openim.chat.ListUserKeysReq
+	32,  // 104: openim.chat.chat.RevokeUserKey:input_type -> openim.chat.RevokeUserKeyReq
+	36,  // 105: openim.chat.chat.SetRecoveryGuardians:input_type -> openim.chat.SetRecoveryGuardiansReq
+	38,  // 106: openim.chat.chat.GetRecoveryGuardians:input_type -> openim.chat.GetRecoveryGuardiansReq
+	40,  // 107: openim.chat.chat.InitiateRecovery:input_type -> openim.chat.InitiateRecoveryReq
+	42,  // 108: openim.chat.chat.ApproveRecovery:input_type -> openim.chat.ApproveRecoveryReq
+	44,  // 109: openim.chat.chat.GetRecovery:input_type -> openim.chat.GetRecoveryReq
+	46,  // 110: openim.chat.chat.StartAdminRecovery:input_type -> openim.chat.StartAdminRecoveryReq
+	48,  // 111: openim.chat.chat.ExecuteRecovery:input_type -> openim.chat.ExecuteRecoveryReq
+	50,  // 112: openim.chat.chat.CancelRecovery:input_type -> openim.chat.CancelRecoveryReq
+	174, // 113: openim.chat.chat.GetRedPacket:input_type -> openim.chat.GetRedPacketReq
+	176, // 114: openim.chat.chat.GetRedPacketBalance:input_type -> openim.chat.GetRedPacketBalanceReq
+	178, // 115: openim.chat.chat.AdjustRedPacketBalance:input_type -> openim.chat.AdjustRedPacketBalanceReq
+	181, // 116: openim.chat.chat.FindRedPacketCallbacks:input_type -> openim.chat.FindRedPacketCallbacksReq
+	148, // 117: openim.chat.chat.GetDiscoverConfig:input_type -> openim.chat.GetDiscoverConfigReq
+	150, // 118: openim.chat.chat.SetDiscoverConfig:input_type -> openim.chat.SetDiscoverConfigReq
+	152, // 119: openim.chat.chat.SetPostDiscover:input_type -> openim.chat.SetPostDiscoverReq
+	157, // 120: openim.chat.chat.SearchPostReports:input_type -> openim.chat.SearchPostReportsReq
+	160, // 121: openim.chat.chat.GetReportedPost:input_type -> openim.chat.GetReportedPostReq
+	162, // 122: openim.chat.chat.ModeratePost:input_type -> openim.chat.ModeratePostReq
+	164, // 123: openim.chat.chat.SearchPostModerationLogs:input_type -> openim.chat.SearchPostModerationLogsReq
+	52,  // 124: openim.chat.chat.ResetPassword:input_type -> openim.chat.ResetPasswordReq
+	54,  // 125: openim.chat.chat.ChangePassword:input_type -> openim.chat.ChangePasswordReq
+	73,  // 126: openim.chat.chat.CheckUserExist:input_type -> openim.chat.CheckUserExistReq
+	75,  // 127: openim.chat.chat.DelUserAccount:input_type -> openim.chat.DelUserAccountReq
+	56,  // 128: openim.chat.chat.FindUserAccount:input_type -> openim.chat.FindUserAccountReq
+	59,  // 129: openim.chat.chat.FindAccountUser:input_type -> openim.chat.FindAccountUserReq
+	62,  // 130: openim.chat.chat.OpenIMCallback:input_type -> openim.chat.OpenIMCallbackReq
+	184, // 131: openim.chat.chat.GetCallbackMetrics:input_type -> openim.chat.GetCallbackMetricsReq
+	66,  // 132: openim.chat.chat.UserLoginCount:input_type -> openim.chat.UserLoginCountReq
+	69,  // 133: openim.chat.chat.SearchUserInfo:input_type -> openim.chat.SearchUserInfoReq
+	71,  // 134: openim.chat.chat.GetTokenForVideoMeeting:input_type -> openim.chat.GetTokenForVideoMeetingReq
+	79,  // 135: openim.chat.chat.saveGroupToContact:input_type -> openim.chat.saveGroupToContactReq
+	81,  // 136: openim.chat.chat.deleteGroupFromContact:input_type -> openim.chat.deleteGroupFromContactReq
+	77,  // 137: openim.chat.chat.getGroupFromContact:input_type -> openim.chat.getGroupFromContactReq
+	83,  // 138: openim.chat.chat.deleteUserGroupApplicationFromRecipient:input_type -> openim.chat.DeleteGroupApplicationFromRecipientReq
+	85,  // 139: openim.chat.chat.deleteUserGroupApplicationFromApplicant:input_type -> openim.chat.DeleteGroupApplicationFromApplicantReq
+	87,  // 140: openim.chat.chat.deleteUserGroupApplicationFromAll:input_type -> openim.chat.DeleteGroupApplicationFromAlltReq
+	13,  // 141: openim.chat.chat.getAllUserIDs:input_type -> openim.chat.GetAllUserIDsReq
+	90,  // 142: openim.chat.chat.PublishPost:input_type -> openim.chat.PublishPostReq
+	94,  // 143: openim.chat.chat.GetAllTypePost:input_type -> openim.chat.GetAllTypePostReq
+	97,  // 144: openim.chat.chat.GetPostList:input_type -> openim.chat.GetPostListReq
+	99,  // 145: openim.chat.chat.GetPostListByUser:input_type -> openim.chat.GetPostListByUserReq
+	101, // 146: openim.chat.chat.GetCommentPostListByPostID:input_type -> openim.chat.GetCommentPostListByPostIDReq
+	92,  // 147: openim.chat.chat.GetPostByID:input_type -> openim.chat.GetPostByIDReq
+	103, // 148: openim.chat.chat.DeletePost:input_type -> openim.chat.DeletePostReq
+	106, // 149: openim.chat.chat.GetUserCounters:input_type -> openim.chat.GetUserCountersReq
+	108, // 150: openim.chat.chat.RestorePost:input_type -> openim.chat.RestorePostReq
+	110, // 151: openim.chat.chat.GetDeletedPostList:input_type -> openim.chat.GetDeletedPostListReq
+	112, // 152: openim.chat.chat.ChangeAllowCommentPost:input_type -> openim.chat.ChangeAllowCommentPostReq
+	114, // 153: openim.chat.chat.ChangeAllowForwardPost:input_type -> openim.chat.ChangeAllowForwardPostReq
+	116, // 154: openim.chat.chat.EditPost:input_type -> openim.chat.EditPostReq
+	119, // 155: openim.chat.chat.GetPostRevisions:input_type -> openim.chat.GetPostRevisionsReq
+	121, // 156: openim.chat.chat.ChangePostVisibility:input_type -> openim.chat.ChangePostVisibilityReq
+	123, // 157: openim.chat.chat.ChangeLikePost:input_type -> openim.chat.LikePostReq
+	125, // 158: openim.chat.chat.ChangeCollectPost:input_type -> openim.chat.CollectPostReq
+	133, // 159: openim.chat.chat.PinPost:input_type -> openim.chat.PinPostReq
+	135, // 160: openim.chat.chat.PinComment:input_type -> openim.chat.PinCommentReq
+	138, // 161: openim.chat.chat.GetNotifications:input_type -> openim.chat.GetNotificationsReq
+	140, // 162: openim.chat.chat.MarkNotificationsRead:input_type -> openim.chat.MarkNotificationsReadReq
+	142, // 163: openim.chat.chat.GetUnreadNotificationCount:input_type -> openim.chat.GetUnreadNotificationCountReq
+	144, // 164: openim.chat.chat.SearchPosts:input_type -> openim.chat.SearchPostsReq
+	166, // 165: openim.chat.chat.GetTopicFeed:input_type -> openim.chat.GetTopicFeedReq
+	154, // 166: openim.chat.chat.ReportPost:input_type -> openim.chat.ReportPostReq
+	127, // 167: openim.chat.chat.ForwardPost:input_type -> openim.chat.ForwardPostReq
+	131, // 168: openim.chat.chat.CommentPost:input_type -> openim.chat.CommentPostReq
+	129, // 169: openim.chat.chat.ReferencePost:input_type -> openim.chat.ReferencePostReq
+	168, // 170: openim.chat.chat.CheckVersion:input_type -> openim.chat.CheckVersionReq
+	170, // 171: openim.chat.chat.GetFakeUser:input_type -> openim.chat.GetFakeUserReq
+	5,   // 172: openim.chat.chat.UpdateUserInfo:output_type -> openim.chat.UpdateUserInfoResp
+	25,  // 173: openim.chat.chat.AddUserAccount:output_type -> openim.chat.AddUserAccountResp
+	10,  // 174: openim.chat.chat.SearchUserPublicInfo:output_type -> openim.chat.SearchUserPublicInfoResp
+	8,   // 175: openim.chat.chat.FindUserPublicInfo:output_type -> openim.chat.FindUserPublicInfoResp
+	7,   // 176: openim.chat.chat.FindUserByAddressOrAccount:output_type -> openim.chat.FindUserPublicInfoRespOfOne
+	65,  // 177: openim.chat.chat.SearchUserFullInfo:output_type -> openim.chat.SearchUserFullInfoResp
+	12,  // 178: openim.chat.chat.FindUserFullInfo:output_type -> openim.chat.FindUserFullInfoResp
+	16,  // 179: openim.chat.chat.SendVerifyCode:output_type -> openim.chat.SendVerifyCodeResp
+	18,  // 180: openim.chat.chat.VerifyCode:output_type -> openim.chat.VerifyCodeResp
+	20,  // 181: openim.chat.chat.ChallengeNonce:output_type -> openim.chat.ChallengeNonceResp
+	23,  // 182: openim.chat.chat.RegisterUser:output_type -> openim.chat.RegisterUserResp
+	68,  // 183: openim.chat.chat.Login:output_type -> openim.chat.LoginResp
+	29,  // 184: openim.chat.chat.AddUserKey:output_type -> openim.chat.AddUserKeyResp
+	31,  // 185: openim.chat.chat.ListUserKeys:output_type -> openim.chat.ListUserKeysResp
+	33,  // 186: openim.chat.chat.RevokeUserKey:output_type -> openim.chat.RevokeUserKeyResp
+	37,  // 187: openim.chat.chat.SetRecoveryGuardians:output_type -> openim.chat.SetRecoveryGuardiansResp
+	39,  // 188: openim.chat.chat.GetRecoveryGuardians:output_type -> openim.chat.GetRecoveryGuardiansResp
+	41,  // 189: openim.chat.chat.InitiateRecovery:output_type -> openim.chat.InitiateRecoveryResp
+	43,  // 190: openim.chat.chat.ApproveRecovery:output_type -> openim.chat.ApproveRecoveryResp
+	45,  // 191: openim.chat.chat.GetRecovery:output_type -> openim.chat.GetRecoveryResp
+	47,  // 192: openim.chat.chat.StartAdminRecovery:output_type -> openim.chat.StartAdminRecoveryResp
+	49,  // 193: openim.chat.chat.ExecuteRecovery:output_type -> openim.chat.ExecuteRecoveryResp
+	51,  // 194: openim.chat.chat.CancelRecovery:output_type -> openim.chat.CancelRecoveryResp
+	175, // 195: openim.chat.chat.GetRedPacket:output_type -> openim.chat.GetRedPacketResp
+	177, // 196: openim.chat.chat.GetRedPacketBalance:output_type -> openim.chat.GetRedPacketBalanceResp
+	179, // 197: openim.chat.chat.AdjustRedPacketBalance:output_type -> openim.chat.AdjustRedPacketBalanceResp
+	182, // 198: openim.chat.chat.FindRedPacketCallbacks:output_type -> openim.chat.FindRedPacketCallbacksResp
+	149, // 199: openim.chat.chat.GetDiscoverConfig:output_type -> openim.chat.GetDiscoverConfigResp
+	151, // 200: openim.chat.chat.SetDiscoverConfig:output_type -> openim.chat.SetDiscoverConfigResp
+	153, // 201: openim.chat.chat.SetPostDiscover:output_type -> openim.chat.SetPostDiscoverResp
+	158, // 202: openim.chat.chat.SearchPostReports:output_type -> openim.chat.SearchPostReportsResp
+	161, // 203: openim.chat.chat.GetReportedPost:output_type -> openim.chat.GetReportedPostResp
+	163, // 204: openim.chat.chat.ModeratePost:output_type -> openim.chat.ModeratePostResp
+	165, // 205: openim.chat.chat.SearchPostModerationLogs:output_type -> openim.chat.SearchPostModerationLogsResp
+	53,  // 206: openim.chat.chat.ResetPassword:output_type -> openim.chat.ResetPasswordResp
+	55,  // 207: openim.chat.chat.ChangePassword:output_type -> openim.chat.ChangePasswordResp
+	74,  // 208: openim.chat.chat.CheckUserExist:output_type -> openim.chat.CheckUserExistResp
+	76,  // 209: openim.chat.chat.DelUserAccount:output_type -> openim.chat.DelUserAccountResp
+	58,  // 210: openim.chat.chat.FindUserAccount:output_type -> openim.chat.FindUserAccountResp
+	60,  // 211: openim.chat.chat.FindAccountUser:output_type -> openim.chat.FindAccountUserResp
+	63,  // 212: openim.chat.chat.OpenIMCallback:output_type -> openim.chat.OpenIMCallbackResp
+	185, // 213: openim.chat.chat.GetCallbackMetrics:output_type -> openim.chat.GetCallbackMetricsResp
+	67,  // 214: openim.chat.chat.UserLoginCount:output_type -> openim.chat.UserLoginCountResp
+	70,  // 215: openim.chat.chat.SearchUserInfo:output_type -> openim.chat.SearchUserInfoResp
+	72,  // 216: openim.chat.chat.GetTokenForVideoMeeting:output_type -> openim.chat.GetTokenForVideoMeetingResp
+	80,  // 217: openim.chat.chat.saveGroupToContact:output_type -> openim.chat.saveGroupToContactResp
+	82,  // 218: openim.chat.chat.deleteGroupFromContact:output_type -> openim.chat.deleteGroupFromContactResp
+	78,  // 219: openim.chat.chat.getGroupFromContact:output_type -> openim.chat.getGroupFromContactResp
+	84,  // 220: openim.chat.chat.deleteUserGroupApplicationFromRecipient:output_type -> openim.chat.DeleteGroupApplicationFromRecipientResp
+	86,  // 221: openim.chat.chat.deleteUserGroupApplicationFromApplicant:output_type -> openim.chat.DeleteGroupApplicationFromApplicantResp
+	88,  // 222: openim.chat.chat.deleteUserGroupApplicationFromAll:output_type -> openim.chat.DeleteGroupApplicationFromAllResp
+	14,  // 223: openim.chat.chat.getAllUserIDs:output_type -> openim.chat.GetAllUserIDsResp
+	91,  // 224: openim.chat.chat.PublishPost:output_type -> openim.chat.PublishPostResp
+	95,  // 225: openim.chat.chat.GetAllTypePost:output_type -> openim.chat.GetAllTypePostResp
+	98,  // 226: openim.chat.chat.GetPostList:output_type -> openim.chat.GetPostListResp
+	100, // 227: openim.chat.chat.GetPostListByUser:output_type -> openim.chat.GetPostListByUserResp
+	102, // 228: openim.chat.chat.GetCommentPostListByPostID:output_type -> openim.chat.GetCommentPostListByPostIDResp
+	93,  // 229: openim.chat.chat.GetPostByID:output_type -> openim.chat.GetPostByIDResp
+	104, // 230: openim.chat.chat.DeletePost:output_type -> openim.chat.DeletePostResp
+	107, // 231: openim.chat.chat.GetUserCounters:output_type -> openim.chat.GetUserCountersResp
+	109, // 232: openim.chat.chat.RestorePost:output_type -> openim.chat.RestorePostResp
+	111, // 233: openim.chat.chat.GetDeletedPostList:output_type -> openim.chat.GetDeletedPostListResp
+	113, // 234: openim.chat.chat.ChangeAllowCommentPost:output_type -> openim.chat.ChangeAllowCommentPostResp
+	115, // 235: openim.chat.chat.ChangeAllowForwardPost:output_type -> openim.chat.ChangeAllowForwardPostResp
+	117, // 236: openim.chat.chat.EditPost:output_type -> openim.chat.EditPostResp
+	120, // 237: openim.chat.chat.GetPostRevisions:output_type -> openim.chat.GetPostRevisionsResp
+	122, // 238: openim.chat.chat.ChangePostVisibility:output_type -> openim.chat.ChangePostVisibilityResp
+	124, // 239: openim.chat.chat.ChangeLikePost:output_type -> openim.chat.LikePostResp
+	126, // 240: openim.chat.chat.ChangeCollectPost:output_type -> openim.chat.CollectPostResp
+	134, // 241: openim.chat.chat.PinPost:output_type -> openim.chat.PinPostResp
+	136, // 242: openim.chat.chat.PinComment:output_type -> openim.chat.PinCommentResp
+	139, // 243: openim.chat.chat.GetNotifications:output_type -> openim.chat.GetNotificationsResp
+	141, // 244: openim.chat.chat.MarkNotificationsRead:output_type -> openim.chat.MarkNotificationsReadResp
+	143, // 245: openim.chat.chat.GetUnreadNotificationCount:output_type -> openim.chat.GetUnreadNotificationCountResp
+	145, // 246: openim.chat.chat.SearchPosts:output_type -> openim.chat.SearchPostsResp
+	167, // 247: openim.chat.chat.GetTopicFeed:output_type -> openim.chat.GetTopicFeedResp
+	155, // 248: openim.chat.chat.ReportPost:output_type -> openim.chat.ReportPostResp
+	128, // 249: openim.chat.chat.ForwardPost:output_type -> openim.chat.ForwardPostResp
+	132, // 250: openim.chat.chat.CommentPost:output_type -> openim.chat.CommentPostResp
+	130, // 251: openim.chat.chat.ReferencePost:output_type -> openim.chat.ReferencePostResp
+	169, // 252: openim.chat.chat.CheckVersion:output_type -> openim.chat.CheckVersionResp
+	171, // 253: openim.chat.chat.GetFakeUser:output_type -> openim.chat.GetFakeUserResp
+	172, // [172:254] is the sub-list for method output_type
+	90,  // [90:172] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
+}
+
+func init() { file_chat_chat_proto_init() }
+func file_chat_chat_proto_init() {
+	if File_chat_chat_proto != nil {
+		return
+	}
 	if !protoimpl.UnsafeEnabled {
 		file_chat_chat_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*OnlineTime); i {
@@ -7178,8 +13695,1016 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUsersTimeReq); i {
+		file_chat_chat_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsersTimeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsersTimeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*UserIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserPublicInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserPublicInfoRespOfOne); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserPublicInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserPublicInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserPublicInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserFullInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserFullInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllUserIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllUserIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SendVerifyCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*SendVerifyCodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyCodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ChallengeNonceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ChallengeNonceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterUserInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*UserKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserKeyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ListUserKeysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ListUserKeysResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeUserKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeUserKeyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*RecoveryApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*RecoveryInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRecoveryGuardiansReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRecoveryGuardiansResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRecoveryGuardiansReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRecoveryGuardiansResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*InitiateRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*InitiateRecoveryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveRecoveryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRecoveryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*StartAdminRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*StartAdminRecoveryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecuteRecoveryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelRecoveryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserByAddressOrAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*FindAccountUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*FindAccountUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*SignalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenIMCallbackReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenIMCallbackResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserFullInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserFullInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*UserLoginCountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*UserLoginCountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTokenForVideoMeetingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTokenForVideoMeetingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckUserExistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckUserExistResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*DelUserAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*DelUserAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGroupFromContactReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGroupFromContactResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveGroupToContactReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveGroupToContactResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupFromContactReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupFromContactResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromRecipientReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromRecipientResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromApplicantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7190,8 +14715,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUsersTimeResp); i {
+		file_chat_chat_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromApplicantResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7202,8 +14727,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*UserIdentity); i {
+		file_chat_chat_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromAlltReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7214,8 +14739,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateUserInfoReq); i {
+		file_chat_chat_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromAllResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7226,8 +14751,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateUserInfoResp); i {
+		file_chat_chat_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*Post); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7238,8 +14763,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserPublicInfoReq); i {
+		file_chat_chat_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7250,8 +14775,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserPublicInfoRespOfOne); i {
+		file_chat_chat_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7262,8 +14787,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserPublicInfoResp); i {
+		file_chat_chat_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostByIDReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7274,8 +14799,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserPublicInfoReq); i {
+		file_chat_chat_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostByIDResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7286,8 +14811,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserPublicInfoResp); i {
+		file_chat_chat_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllTypePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7298,8 +14823,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserFullInfoReq); i {
+		file_chat_chat_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllTypePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7310,8 +14835,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserFullInfoResp); i {
+		file_chat_chat_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*TypePosts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7322,8 +14847,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllUserIDsReq); i {
+		file_chat_chat_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7334,8 +14859,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllUserIDsResp); i {
+		file_chat_chat_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7346,8 +14871,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SendVerifyCodeReq); i {
+		file_chat_chat_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListByUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7358,8 +14883,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*SendVerifyCodeResp); i {
+		file_chat_chat_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListByUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7370,8 +14895,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*VerifyCodeReq); i {
+		file_chat_chat_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentPostListByPostIDReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7382,8 +14907,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*VerifyCodeResp); i {
+		file_chat_chat_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentPostListByPostIDResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7394,8 +14919,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ChallengeNonceReq); i {
+		file_chat_chat_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7406,8 +14931,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*ChallengeNonceResp); i {
+		file_chat_chat_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7418,8 +14943,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterUserInfo); i {
+		file_chat_chat_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*UserCounter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7430,8 +14955,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterUserReq); i {
+		file_chat_chat_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserCountersReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7442,8 +14967,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterUserResp); i {
+		file_chat_chat_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserCountersResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7454,8 +14979,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*AddUserAccountReq); i {
+		file_chat_chat_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*RestorePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7466,8 +14991,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*AddUserAccountResp); i {
+		file_chat_chat_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*RestorePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7478,8 +15003,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*LoginReq); i {
+		file_chat_chat_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeletedPostListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7490,8 +15015,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordReq); i {
+		file_chat_chat_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeletedPostListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7502,8 +15027,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordResp); i {
+		file_chat_chat_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowCommentPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7514,8 +15039,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangePasswordReq); i {
+		file_chat_chat_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowCommentPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7526,8 +15051,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangePasswordResp); i {
+		file_chat_chat_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowForwardPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7538,8 +15063,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserAccountReq); i {
+		file_chat_chat_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowForwardPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7550,8 +15075,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserByAddressOrAccountReq); i {
+		file_chat_chat_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*EditPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7562,8 +15087,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserAccountResp); i {
+		file_chat_chat_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*EditPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7574,8 +15099,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*FindAccountUserReq); i {
+		file_chat_chat_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*PostRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7586,8 +15111,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*FindAccountUserResp); i {
+		file_chat_chat_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostRevisionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7598,8 +15123,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*SignalRecord); i {
+		file_chat_chat_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostRevisionsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7610,8 +15135,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenIMCallbackReq); i {
+		file_chat_chat_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePostVisibilityReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7622,8 +15147,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenIMCallbackResp); i {
+		file_chat_chat_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePostVisibilityResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7634,8 +15159,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserFullInfoReq); i {
+		file_chat_chat_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*LikePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7646,8 +15171,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserFullInfoResp); i {
+		file_chat_chat_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*LikePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7658,8 +15183,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*UserLoginCountReq); i {
+		file_chat_chat_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*CollectPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7670,8 +15195,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*UserLoginCountResp); i {
+		file_chat_chat_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*CollectPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7682,8 +15207,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*LoginResp); i {
+		file_chat_chat_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*ForwardPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7694,8 +15219,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserInfoReq); i {
+		file_chat_chat_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*ForwardPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7706,8 +15231,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserInfoResp); i {
+		file_chat_chat_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferencePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7718,8 +15243,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTokenForVideoMeetingReq); i {
+		file_chat_chat_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferencePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7730,8 +15255,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTokenForVideoMeetingResp); i {
+		file_chat_chat_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7742,8 +15267,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckUserExistReq); i {
+		file_chat_chat_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7754,8 +15279,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckUserExistResp); i {
+		file_chat_chat_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*PinPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7766,8 +15291,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*DelUserAccountReq); i {
+		file_chat_chat_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*PinPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7778,8 +15303,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*DelUserAccountResp); i {
+		file_chat_chat_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*PinCommentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7790,8 +15315,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGroupFromContactReq); i {
+		file_chat_chat_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*PinCommentResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7802,8 +15327,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGroupFromContactResp); i {
+		file_chat_chat_proto_msgTypes[137].Exporter = func(v any, i int) any {
+			switch v := v.(*PostNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7814,8 +15339,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveGroupToContactReq); i {
+		file_chat_chat_proto_msgTypes[138].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7826,8 +15351,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveGroupToContactResp); i {
+		file_chat_chat_proto_msgTypes[139].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7838,8 +15363,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupFromContactReq); i {
+		file_chat_chat_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkNotificationsReadReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7850,8 +15375,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupFromContactResp); i {
+		file_chat_chat_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkNotificationsReadResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7862,8 +15387,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromRecipientReq); i {
+		file_chat_chat_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUnreadNotificationCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7874,8 +15399,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromRecipientResp); i {
+		file_chat_chat_proto_msgTypes[143].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUnreadNotificationCountResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7886,8 +15411,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromApplicantReq); i {
+		file_chat_chat_proto_msgTypes[144].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchPostsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7898,8 +15423,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromApplicantResp); i {
+		file_chat_chat_proto_msgTypes[145].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchPostsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7910,8 +15435,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromAlltReq); i {
+		file_chat_chat_proto_msgTypes[146].Exporter = func(v any, i int) any {
+			switch v := v.(*Topic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7922,8 +15447,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromAllResp); i {
+		file_chat_chat_proto_msgTypes[147].Exporter = func(v any, i int) any {
+			switch v := v.(*DiscoverConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7934,8 +15459,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*Post); i {
+		file_chat_chat_proto_msgTypes[148].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDiscoverConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7946,8 +15471,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[65].Exporter = func(v any, i int) any {
-			switch v := v.(*PublishPostReq); i {
+		file_chat_chat_proto_msgTypes[149].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDiscoverConfigResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7958,8 +15483,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[66].Exporter = func(v any, i int) any {
-			switch v := v.(*PublishPostResp); i {
+		file_chat_chat_proto_msgTypes[150].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDiscoverConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7970,8 +15495,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[67].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostByIDReq); i {
+		file_chat_chat_proto_msgTypes[151].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDiscoverConfigResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7982,8 +15507,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[68].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostByIDResp); i {
+		file_chat_chat_proto_msgTypes[152].Exporter = func(v any, i int) any {
+			switch v := v.(*SetPostDiscoverReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7994,8 +15519,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[69].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllTypePostReq); i {
+		file_chat_chat_proto_msgTypes[153].Exporter = func(v any, i int) any {
+			switch v := v.(*SetPostDiscoverResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8006,8 +15531,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[70].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllTypePostResp); i {
+		file_chat_chat_proto_msgTypes[154].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8018,8 +15543,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[71].Exporter = func(v any, i int) any {
-			switch v := v.(*TypePosts); i {
+		file_chat_chat_proto_msgTypes[155].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8030,8 +15555,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[72].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListReq); i {
+		file_chat_chat_proto_msgTypes[156].Exporter = func(v any, i int) any {
+			switch v := v.(*PostReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8042,8 +15567,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[73].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListResp); i {
+		file_chat_chat_proto_msgTypes[157].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchPostReportsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8054,8 +15579,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[74].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListByUserReq); i {
+		file_chat_chat_proto_msgTypes[158].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchPostReportsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8066,8 +15591,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[75].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListByUserResp); i {
+		file_chat_chat_proto_msgTypes[159].Exporter = func(v any, i int) any {
+			switch v := v.(*PostModerationLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8078,8 +15603,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[76].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentPostListByPostIDReq); i {
+		file_chat_chat_proto_msgTypes[160].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReportedPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8090,8 +15615,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[77].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentPostListByPostIDResp); i {
+		file_chat_chat_proto_msgTypes[161].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReportedPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8102,8 +15627,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[78].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePostReq); i {
+		file_chat_chat_proto_msgTypes[162].Exporter = func(v any, i int) any {
+			switch v := v.(*ModeratePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8114,8 +15639,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[79].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePostResp); i {
+		file_chat_chat_proto_msgTypes[163].Exporter = func(v any, i int) any {
+			switch v := v.(*ModeratePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8126,8 +15651,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[80].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowCommentPostReq); i {
+		file_chat_chat_proto_msgTypes[164].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchPostModerationLogsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8138,8 +15663,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[81].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowCommentPostResp); i {
+		file_chat_chat_proto_msgTypes[165].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchPostModerationLogsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8150,8 +15675,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[82].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowForwardPostReq); i {
+		file_chat_chat_proto_msgTypes[166].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTopicFeedReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8162,8 +15687,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[83].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowForwardPostResp); i {
+		file_chat_chat_proto_msgTypes[167].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTopicFeedResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8174,8 +15699,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[84].Exporter = func(v any, i int) any {
-			switch v := v.(*LikePostReq); i {
+		file_chat_chat_proto_msgTypes[168].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckVersionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8186,8 +15711,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[85].Exporter = func(v any, i int) any {
-			switch v := v.(*LikePostResp); i {
+		file_chat_chat_proto_msgTypes[169].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckVersionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8198,8 +15723,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[86].Exporter = func(v any, i int) any {
-			switch v := v.(*CollectPostReq); i {
+		file_chat_chat_proto_msgTypes[170].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFakeUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8210,8 +15735,32 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[87].Exporter = func(v any, i int) any {
-			switch v := v.(*CollectPostResp); i {
+		file_chat_chat_proto_msgTypes[171].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFakeUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[172].Exporter = func(v any, i int) any {
+			switch v := v.(*RedPacketClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[173].Exporter = func(v any, i int) any {
+			switch v := v.(*RedPacketInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8222,8 +15771,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[88].Exporter = func(v any, i int) any {
-			switch v := v.(*ForwardPostReq); i {
+		file_chat_chat_proto_msgTypes[174].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRedPacketReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8234,8 +15783,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[89].Exporter = func(v any, i int) any {
-			switch v := v.(*ForwardPostResp); i {
+		file_chat_chat_proto_msgTypes[175].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRedPacketResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8246,8 +15795,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[90].Exporter = func(v any, i int) any {
-			switch v := v.(*ReferencePostReq); i {
+		file_chat_chat_proto_msgTypes[176].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRedPacketBalanceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8258,8 +15807,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[91].Exporter = func(v any, i int) any {
-			switch v := v.(*ReferencePostResp); i {
+		file_chat_chat_proto_msgTypes[177].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRedPacketBalanceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8270,8 +15819,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[92].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentPostReq); i {
+		file_chat_chat_proto_msgTypes[178].Exporter = func(v any, i int) any {
+			switch v := v.(*AdjustRedPacketBalanceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8282,8 +15831,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[93].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentPostResp); i {
+		file_chat_chat_proto_msgTypes[179].Exporter = func(v any, i int) any {
+			switch v := v.(*AdjustRedPacketBalanceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8294,8 +15843,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[94].Exporter = func(v any, i int) any {
-			switch v := v.(*PinPostReq); i {
+		file_chat_chat_proto_msgTypes[180].Exporter = func(v any, i int) any {
+			switch v := v.(*RedPacketCallback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8306,8 +15855,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[95].Exporter = func(v any, i int) any {
-			switch v := v.(*PinPostResp); i {
+		file_chat_chat_proto_msgTypes[181].Exporter = func(v any, i int) any {
+			switch v := v.(*FindRedPacketCallbacksReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8318,8 +15867,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[96].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckVersionReq); i {
+		file_chat_chat_proto_msgTypes[182].Exporter = func(v any, i int) any {
+			switch v := v.(*FindRedPacketCallbacksResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8330,8 +15879,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[97].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckVersionResp); i {
+		file_chat_chat_proto_msgTypes[183].Exporter = func(v any, i int) any {
+			switch v := v.(*CallbackMetric); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8342,8 +15891,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[98].Exporter = func(v any, i int) any {
-			switch v := v.(*GetFakeUserReq); i {
+		file_chat_chat_proto_msgTypes[184].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCallbackMetricsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8354,8 +15903,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[99].Exporter = func(v any, i int) any {
-			switch v := v.(*GetFakeUserResp); i {
+		file_chat_chat_proto_msgTypes[185].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCallbackMetricsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8373,7 +15922,7 @@ func file_chat_chat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chat_chat_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   103,
+			NumMessages:   189,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8414,6 +15963,31 @@ type ChatClient interface {
 	ChallengeNonce(ctx context.Context, in *ChallengeNonceReq, opts ...grpc.CallOption) (*ChallengeNonceResp, error)
 	RegisterUser(ctx context.Context, in *RegisterUserReq, opts ...grpc.CallOption) (*RegisterUserResp, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+	// Wallet keys linked to the account
+	AddUserKey(ctx context.Context, in *AddUserKeyReq, opts ...grpc.CallOption) (*AddUserKeyResp, error)
+	ListUserKeys(ctx context.Context, in *ListUserKeysReq, opts ...grpc.CallOption) (*ListUserKeysResp, error)
+	RevokeUserKey(ctx context.Context, in *RevokeUserKeyReq, opts ...grpc.CallOption) (*RevokeUserKeyResp, error)
+	// Account recovery
+	SetRecoveryGuardians(ctx context.Context, in *SetRecoveryGuardiansReq, opts ...grpc.CallOption) (*SetRecoveryGuardiansResp, error)
+	GetRecoveryGuardians(ctx context.Context, in *GetRecoveryGuardiansReq, opts ...grpc.CallOption) (*GetRecoveryGuardiansResp, error)
+	InitiateRecovery(ctx context.Context, in *InitiateRecoveryReq, opts ...grpc.CallOption) (*InitiateRecoveryResp, error)
+	ApproveRecovery(ctx context.Context, in *ApproveRecoveryReq, opts ...grpc.CallOption) (*ApproveRecoveryResp, error)
+	GetRecovery(ctx context.Context, in *GetRecoveryReq, opts ...grpc.CallOption) (*GetRecoveryResp, error)
+	StartAdminRecovery(ctx context.Context, in *StartAdminRecoveryReq, opts ...grpc.CallOption) (*StartAdminRecoveryResp, error)
+	ExecuteRecovery(ctx context.Context, in *ExecuteRecoveryReq, opts ...grpc.CallOption) (*ExecuteRecoveryResp, error)
+	CancelRecovery(ctx context.Context, in *CancelRecoveryReq, opts ...grpc.CallOption) (*CancelRecoveryResp, error)
+	// Red packet ledger
+	GetRedPacket(ctx context.Context, in *GetRedPacketReq, opts ...grpc.CallOption) (*GetRedPacketResp, error)
+	GetRedPacketBalance(ctx context.Context, in *GetRedPacketBalanceReq, opts ...grpc.CallOption) (*GetRedPacketBalanceResp, error)
+	AdjustRedPacketBalance(ctx context.Context, in *AdjustRedPacketBalanceReq, opts ...grpc.CallOption) (*AdjustRedPacketBalanceResp, error)
+	FindRedPacketCallbacks(ctx context.Context, in *FindRedPacketCallbacksReq, opts ...grpc.CallOption) (*FindRedPacketCallbacksResp, error)
+	GetDiscoverConfig(ctx context.Context, in *GetDiscoverConfigReq, opts ...grpc.CallOption) (*GetDiscoverConfigResp, error)
+	SetDiscoverConfig(ctx context.Context, in *SetDiscoverConfigReq, opts ...grpc.CallOption) (*SetDiscoverConfigResp, error)
+	SetPostDiscover(ctx context.Context, in *SetPostDiscoverReq, opts ...grpc.CallOption) (*SetPostDiscoverResp, error)
+	SearchPostReports(ctx context.Context, in *SearchPostReportsReq, opts ...grpc.CallOption) (*SearchPostReportsResp, error)
+	GetReportedPost(ctx context.Context, in *GetReportedPostReq, opts ...grpc.CallOption) (*GetReportedPostResp, error)
+	ModeratePost(ctx context.Context, in *ModeratePostReq, opts ...grpc.CallOption) (*ModeratePostResp, error)
+	SearchPostModerationLogs(ctx context.Context, in *SearchPostModerationLogsReq, opts ...grpc.CallOption) (*SearchPostModerationLogsResp, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
 	CheckUserExist(ctx context.Context, in *CheckUserExistReq, opts ...grpc.CallOption) (*CheckUserExistResp, error)
@@ -8421,16 +15995,18 @@ type ChatClient interface {
 	FindUserAccount(ctx context.Context, in *FindUserAccountReq, opts ...grpc.CallOption) (*FindUserAccountResp, error)
 	FindAccountUser(ctx context.Context, in *FindAccountUserReq, opts ...grpc.CallOption) (*FindAccountUserResp, error)
 	OpenIMCallback(ctx context.Context, in *OpenIMCallbackReq, opts ...grpc.CallOption) (*OpenIMCallbackResp, error)
+	// Metrics of the OpenIM callback handlers of this instance since it started
+	GetCallbackMetrics(ctx context.Context, in *GetCallbackMetricsReq, opts ...grpc.CallOption) (*GetCallbackMetricsResp, error)
 	// Statistics
 	UserLoginCount(ctx context.Context, in *UserLoginCountReq, opts ...grpc.CallOption) (*UserLoginCountResp, error)
 	SearchUserInfo(ctx context.Context, in *SearchUserInfoReq, opts ...grpc.CallOption) (*SearchUserInfoResp, error)
 	// Audio/video call and video meeting
 	GetTokenForVideoMeeting(ctx context.Context, in *GetTokenForVideoMeetingReq, opts ...grpc.CallOption) (*GetTokenForVideoMeetingResp, error)
-	// 保存群组至联系列表
+	//保存群组至联系列表
 	SaveGroupToContact(ctx context.Context, in *SaveGroupToContactReq, opts ...grpc.CallOption) (*SaveGroupToContactResp, error)
-	// 从联系列表移除群组
+	//从联系列表移除群组
 	DeleteGroupFromContact(ctx context.Context, in *DeleteGroupFromContactReq, opts ...grpc.CallOption) (*DeleteGroupFromContactResp, error)
-	// 从联系列表获取群组
+	//从联系列表获取群组
 	GetGroupFromContact(ctx context.Context, in *GetGroupFromContactReq, opts ...grpc.CallOption) (*GetGroupFromContactResp, error)
 	// 删除用户的加群申请
 	DeleteUserGroupApplicationFromRecipient(ctx context.Context, in *DeleteGroupApplicationFromRecipientReq, opts ...grpc.CallOption) (*DeleteGroupApplicationFromRecipientResp, error)
@@ -8454,16 +16030,42 @@ type ChatClient interface {
 	GetPostByID(ctx context.Context, in *GetPostByIDReq, opts ...grpc.CallOption) (*GetPostByIDResp, error)
 	// 删除帖子
 	DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostResp, error)
+	// 获取用户帖子与关注计数
+	GetUserCounters(ctx context.Context, in *GetUserCountersReq, opts ...grpc.CallOption) (*GetUserCountersResp, error)
+	// 恢复删除的帖子
+	RestorePost(ctx context.Context, in *RestorePostReq, opts ...grpc.CallOption) (*RestorePostResp, error)
+	// 获取回收站帖子列表
+	GetDeletedPostList(ctx context.Context, in *GetDeletedPostListReq, opts ...grpc.CallOption) (*GetDeletedPostListResp, error)
 	// 修改帖子允许评论
 	ChangeAllowCommentPost(ctx context.Context, in *ChangeAllowCommentPostReq, opts ...grpc.CallOption) (*ChangeAllowCommentPostResp, error)
 	// 修改帖子允许转发
 	ChangeAllowForwardPost(ctx context.Context, in *ChangeAllowForwardPostReq, opts ...grpc.CallOption) (*ChangeAllowForwardPostResp, error)
+	// 编辑帖子
+	EditPost(ctx context.Context, in *EditPostReq, opts ...grpc.CallOption) (*EditPostResp, error)
+	// 获取帖子编辑历史
+	GetPostRevisions(ctx context.Context, in *GetPostRevisionsReq, opts ...grpc.CallOption) (*GetPostRevisionsResp, error)
+	// 修改帖子可见范围
+	ChangePostVisibility(ctx context.Context, in *ChangePostVisibilityReq, opts ...grpc.CallOption) (*ChangePostVisibilityResp, error)
 	// 点赞帖子
 	ChangeLikePost(ctx context.Context, in *LikePostReq, opts ...grpc.CallOption) (*LikePostResp, error)
 	// 收藏帖子
 	ChangeCollectPost(ctx context.Context, in *CollectPostReq, opts ...grpc.CallOption) (*CollectPostResp, error)
 	// 置顶帖子
 	PinPost(ctx context.Context, in *PinPostReq, opts ...grpc.CallOption) (*PinPostResp, error)
+	// 置顶帖子的评论
+	PinComment(ctx context.Context, in *PinCommentReq, opts ...grpc.CallOption) (*PinCommentResp, error)
+	// 获取帖子互动通知
+	GetNotifications(ctx context.Context, in *GetNotificationsReq, opts ...grpc.CallOption) (*GetNotificationsResp, error)
+	// 标记通知已读
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error)
+	// 获取未读通知数
+	GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountReq, opts ...grpc.CallOption) (*GetUnreadNotificationCountResp, error)
+	// 搜索帖子
+	SearchPosts(ctx context.Context, in *SearchPostsReq, opts ...grpc.CallOption) (*SearchPostsResp, error)
+	// 获取话题下的帖子
+	GetTopicFeed(ctx context.Context, in *GetTopicFeedReq, opts ...grpc.CallOption) (*GetTopicFeedResp, error)
+	// 举报帖子
+	ReportPost(ctx context.Context, in *ReportPostReq, opts ...grpc.CallOption) (*ReportPostResp, error)
 	// 转发帖子
 	ForwardPost(ctx context.Context, in *ForwardPostReq, opts ...grpc.CallOption) (*ForwardPostResp, error)
 	// 评论帖子
@@ -8592,6 +16194,204 @@ func (c *chatClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *chatClient) AddUserKey(ctx context.Context, in *AddUserKeyReq, opts ...grpc.CallOption) (*AddUserKeyResp, error) {
+	out := new(AddUserKeyResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/AddUserKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ListUserKeys(ctx context.Context, in *ListUserKeysReq, opts ...grpc.CallOption) (*ListUserKeysResp, error) {
+	out := new(ListUserKeysResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ListUserKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RevokeUserKey(ctx context.Context, in *RevokeUserKeyReq, opts ...grpc.CallOption) (*RevokeUserKeyResp, error) {
+	out := new(RevokeUserKeyResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/RevokeUserKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SetRecoveryGuardians(ctx context.Context, in *SetRecoveryGuardiansReq, opts ...grpc.CallOption) (*SetRecoveryGuardiansResp, error) {
+	out := new(SetRecoveryGuardiansResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/SetRecoveryGuardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetRecoveryGuardians(ctx context.Context, in *GetRecoveryGuardiansReq, opts ...grpc.CallOption) (*GetRecoveryGuardiansResp, error) {
+	out := new(GetRecoveryGuardiansResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetRecoveryGuardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) InitiateRecovery(ctx context.Context, in *InitiateRecoveryReq, opts ...grpc.CallOption) (*InitiateRecoveryResp, error) {
+	out := new(InitiateRecoveryResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/InitiateRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ApproveRecovery(ctx context.Context, in *ApproveRecoveryReq, opts ...grpc.CallOption) (*ApproveRecoveryResp, error) {
+	out := new(ApproveRecoveryResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ApproveRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetRecovery(ctx context.Context, in *GetRecoveryReq, opts ...grpc.CallOption) (*GetRecoveryResp, error) {
+	out := new(GetRecoveryResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) StartAdminRecovery(ctx context.Context, in *StartAdminRecoveryReq, opts ...grpc.CallOption) (*StartAdminRecoveryResp, error) {
+	out := new(StartAdminRecoveryResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/StartAdminRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ExecuteRecovery(ctx context.Context, in *ExecuteRecoveryReq, opts ...grpc.CallOption) (*ExecuteRecoveryResp, error) {
+	out := new(ExecuteRecoveryResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ExecuteRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CancelRecovery(ctx context.Context, in *CancelRecoveryReq, opts ...grpc.CallOption) (*CancelRecoveryResp, error) {
+	out := new(CancelRecoveryResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/CancelRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetRedPacket(ctx context.Context, in *GetRedPacketReq, opts ...grpc.CallOption) (*GetRedPacketResp, error) {
+	out := new(GetRedPacketResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetRedPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetRedPacketBalance(ctx context.Context, in *GetRedPacketBalanceReq, opts ...grpc.CallOption) (*GetRedPacketBalanceResp, error) {
+	out := new(GetRedPacketBalanceResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetRedPacketBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) AdjustRedPacketBalance(ctx context.Context, in *AdjustRedPacketBalanceReq, opts ...grpc.CallOption) (*AdjustRedPacketBalanceResp, error) {
+	out := new(AdjustRedPacketBalanceResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/AdjustRedPacketBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindRedPacketCallbacks(ctx context.Context, in *FindRedPacketCallbacksReq, opts ...grpc.CallOption) (*FindRedPacketCallbacksResp, error) {
+	out := new(FindRedPacketCallbacksResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/FindRedPacketCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetDiscoverConfig(ctx context.Context, in *GetDiscoverConfigReq, opts ...grpc.CallOption) (*GetDiscoverConfigResp, error) {
+	out := new(GetDiscoverConfigResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetDiscoverConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SetDiscoverConfig(ctx context.Context, in *SetDiscoverConfigReq, opts ...grpc.CallOption) (*SetDiscoverConfigResp, error) {
+	out := new(SetDiscoverConfigResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/SetDiscoverConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SetPostDiscover(ctx context.Context, in *SetPostDiscoverReq, opts ...grpc.CallOption) (*SetPostDiscoverResp, error) {
+	out := new(SetPostDiscoverResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/SetPostDiscover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchPostReports(ctx context.Context, in *SearchPostReportsReq, opts ...grpc.CallOption) (*SearchPostReportsResp, error) {
+	out := new(SearchPostReportsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/SearchPostReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetReportedPost(ctx context.Context, in *GetReportedPostReq, opts ...grpc.CallOption) (*GetReportedPostResp, error) {
+	out := new(GetReportedPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetReportedPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ModeratePost(ctx context.Context, in *ModeratePostReq, opts ...grpc.CallOption) (*ModeratePostResp, error) {
+	out := new(ModeratePostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ModeratePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchPostModerationLogs(ctx context.Context, in *SearchPostModerationLogsReq, opts ...grpc.CallOption) (*SearchPostModerationLogsResp, error) {
+	out := new(SearchPostModerationLogsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/SearchPostModerationLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
 	out := new(ResetPasswordResp)
 	err := c.cc.Invoke(ctx, "/openim.chat.chat/ResetPassword", in, out, opts...)
@@ -8655,6 +16455,15 @@ func (c *chatClient) OpenIMCallback(ctx context.Context, in *OpenIMCallbackReq,
 	return out, nil
 }
 
+func (c *chatClient) GetCallbackMetrics(ctx context.Context, in *GetCallbackMetricsReq, opts ...grpc.CallOption) (*GetCallbackMetricsResp, error) {
+	out := new(GetCallbackMetricsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetCallbackMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) UserLoginCount(ctx context.Context, in *UserLoginCountReq, opts ...grpc.CallOption) (*UserLoginCountResp, error) {
 	out := new(UserLoginCountResp)
 	err := c.cc.Invoke(ctx, "/openim.chat.chat/UserLoginCount", in, out, opts...)
@@ -8799,54 +16608,171 @@ func (c *chatClient) GetPostByID(ctx context.Context, in *GetPostByIDReq, opts .
 	return out, nil
 }
 
-func (c *chatClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostResp, error) {
-	out := new(DeletePostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/DeletePost", in, out, opts...)
+func (c *chatClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostResp, error) {
+	out := new(DeletePostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/DeletePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetUserCounters(ctx context.Context, in *GetUserCountersReq, opts ...grpc.CallOption) (*GetUserCountersResp, error) {
+	out := new(GetUserCountersResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetUserCounters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RestorePost(ctx context.Context, in *RestorePostReq, opts ...grpc.CallOption) (*RestorePostResp, error) {
+	out := new(RestorePostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/RestorePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetDeletedPostList(ctx context.Context, in *GetDeletedPostListReq, opts ...grpc.CallOption) (*GetDeletedPostListResp, error) {
+	out := new(GetDeletedPostListResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetDeletedPostList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeAllowCommentPost(ctx context.Context, in *ChangeAllowCommentPostReq, opts ...grpc.CallOption) (*ChangeAllowCommentPostResp, error) {
+	out := new(ChangeAllowCommentPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowCommentPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeAllowForwardPost(ctx context.Context, in *ChangeAllowForwardPostReq, opts ...grpc.CallOption) (*ChangeAllowForwardPostResp, error) {
+	out := new(ChangeAllowForwardPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowForwardPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) EditPost(ctx context.Context, in *EditPostReq, opts ...grpc.CallOption) (*EditPostResp, error) {
+	out := new(EditPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/EditPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetPostRevisions(ctx context.Context, in *GetPostRevisionsReq, opts ...grpc.CallOption) (*GetPostRevisionsResp, error) {
+	out := new(GetPostRevisionsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangePostVisibility(ctx context.Context, in *ChangePostVisibilityReq, opts ...grpc.CallOption) (*ChangePostVisibilityResp, error) {
+	out := new(ChangePostVisibilityResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangePostVisibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeLikePost(ctx context.Context, in *LikePostReq, opts ...grpc.CallOption) (*LikePostResp, error) {
+	out := new(LikePostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeLikePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeCollectPost(ctx context.Context, in *CollectPostReq, opts ...grpc.CallOption) (*CollectPostResp, error) {
+	out := new(CollectPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeCollectPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) PinPost(ctx context.Context, in *PinPostReq, opts ...grpc.CallOption) (*PinPostResp, error) {
+	out := new(PinPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/PinPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) PinComment(ctx context.Context, in *PinCommentReq, opts ...grpc.CallOption) (*PinCommentResp, error) {
+	out := new(PinCommentResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/PinComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetNotifications(ctx context.Context, in *GetNotificationsReq, opts ...grpc.CallOption) (*GetNotificationsResp, error) {
+	out := new(GetNotificationsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetNotifications", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeAllowCommentPost(ctx context.Context, in *ChangeAllowCommentPostReq, opts ...grpc.CallOption) (*ChangeAllowCommentPostResp, error) {
-	out := new(ChangeAllowCommentPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowCommentPost", in, out, opts...)
+func (c *chatClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error) {
+	out := new(MarkNotificationsReadResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/MarkNotificationsRead", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeAllowForwardPost(ctx context.Context, in *ChangeAllowForwardPostReq, opts ...grpc.CallOption) (*ChangeAllowForwardPostResp, error) {
-	out := new(ChangeAllowForwardPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowForwardPost", in, out, opts...)
+func (c *chatClient) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountReq, opts ...grpc.CallOption) (*GetUnreadNotificationCountResp, error) {
+	out := new(GetUnreadNotificationCountResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetUnreadNotificationCount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeLikePost(ctx context.Context, in *LikePostReq, opts ...grpc.CallOption) (*LikePostResp, error) {
-	out := new(LikePostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeLikePost", in, out, opts...)
+func (c *chatClient) SearchPosts(ctx context.Context, in *SearchPostsReq, opts ...grpc.CallOption) (*SearchPostsResp, error) {
+	out := new(SearchPostsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/SearchPosts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeCollectPost(ctx context.Context, in *CollectPostReq, opts ...grpc.CallOption) (*CollectPostResp, error) {
-	out := new(CollectPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeCollectPost", in, out, opts...)
+func (c *chatClient) GetTopicFeed(ctx context.Context, in *GetTopicFeedReq, opts ...grpc.CallOption) (*GetTopicFeedResp, error) {
+	out := new(GetTopicFeedResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetTopicFeed", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) PinPost(ctx context.Context, in *PinPostReq, opts ...grpc.CallOption) (*PinPostResp, error) {
-	out := new(PinPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/PinPost", in, out, opts...)
+func (c *chatClient) ReportPost(ctx context.Context, in *ReportPostReq, opts ...grpc.CallOption) (*ReportPostResp, error) {
+	out := new(ReportPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ReportPost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8915,6 +16841,31 @@ type ChatServer interface {
 	ChallengeNonce(context.Context, *ChallengeNonceReq) (*ChallengeNonceResp, error)
 	RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error)
 	Login(context.Context, *LoginReq) (*LoginResp, error)
+	// Wallet keys linked to the account
+	AddUserKey(context.Context, *AddUserKeyReq) (*AddUserKeyResp, error)
+	ListUserKeys(context.Context, *ListUserKeysReq) (*ListUserKeysResp, error)
+	RevokeUserKey(context.Context, *RevokeUserKeyReq) (*RevokeUserKeyResp, error)
+	// Account recovery
+	SetRecoveryGuardians(context.Context, *SetRecoveryGuardiansReq) (*SetRecoveryGuardiansResp, error)
+	GetRecoveryGuardians(context.Context, *GetRecoveryGuardiansReq) (*GetRecoveryGuardiansResp, error)
+	InitiateRecovery(context.Context, *InitiateRecoveryReq) (*InitiateRecoveryResp, error)
+	ApproveRecovery(context.Context, *ApproveRecoveryReq) (*ApproveRecoveryResp, error)
+	GetRecovery(context.Context, *GetRecoveryReq) (*GetRecoveryResp, error)
+	StartAdminRecovery(context.Context, *StartAdminRecoveryReq) (*StartAdminRecoveryResp, error)
+	ExecuteRecovery(context.Context, *ExecuteRecoveryReq) (*ExecuteRecoveryResp, error)
+	CancelRecovery(context.Context, *CancelRecoveryReq) (*CancelRecoveryResp, error)
+	// Red packet ledger
+	GetRedPacket(context.Context, *GetRedPacketReq) (*GetRedPacketResp, error)
+	GetRedPacketBalance(context.Context, *GetRedPacketBalanceReq) (*GetRedPacketBalanceResp, error)
+	AdjustRedPacketBalance(context.Context, *AdjustRedPacketBalanceReq) (*AdjustRedPacketBalanceResp, error)
+	FindRedPacketCallbacks(context.Context, *FindRedPacketCallbacksReq) (*FindRedPacketCallbacksResp, error)
+	GetDiscoverConfig(context.Context, *GetDiscoverConfigReq) (*GetDiscoverConfigResp, error)
+	SetDiscoverConfig(context.Context, *SetDiscoverConfigReq) (*SetDiscoverConfigResp, error)
+	SetPostDiscover(context.Context, *SetPostDiscoverReq) (*SetPostDiscoverResp, error)
+	SearchPostReports(context.Context, *SearchPostReportsReq) (*SearchPostReportsResp, error)
+	GetReportedPost(context.Context, *GetReportedPostReq) (*GetReportedPostResp, error)
+	ModeratePost(context.Context, *ModeratePostReq) (*ModeratePostResp, error)
+	SearchPostModerationLogs(context.Context, *SearchPostModerationLogsReq) (*SearchPostModerationLogsResp, error)
 	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
 	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
 	CheckUserExist(context.Context, *CheckUserExistReq) (*CheckUserExistResp, error)
@@ -8922,16 +16873,18 @@ type ChatServer interface {
 	FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error)
 	FindAccountUser(context.Context, *FindAccountUserReq) (*FindAccountUserResp, error)
 	OpenIMCallback(context.Context, *OpenIMCallbackReq) (*OpenIMCallbackResp, error)
+	// Metrics of the OpenIM callback handlers of this instance since it started
+	GetCallbackMetrics(context.Context, *GetCallbackMetricsReq) (*GetCallbackMetricsResp, error)
 	// Statistics
 	UserLoginCount(context.Context, *UserLoginCountReq) (*UserLoginCountResp, error)
 	SearchUserInfo(context.Context, *SearchUserInfoReq) (*SearchUserInfoResp, error)
 	// Audio/video call and video meeting
 	GetTokenForVideoMeeting(context.Context, *GetTokenForVideoMeetingReq) (*GetTokenForVideoMeetingResp, error)
-	// 保存群组至联系列表
+	//保存群组至联系列表
 	SaveGroupToContact(context.Context, *SaveGroupToContactReq) (*SaveGroupToContactResp, error)
-	// 从联系列表移除群组
+	//从联系列表移除群组
 	DeleteGroupFromContact(context.Context, *DeleteGroupFromContactReq) (*DeleteGroupFromContactResp, error)
-	// 从联系列表获取群组
+	//从联系列表获取群组
 	GetGroupFromContact(context.Context, *GetGroupFromContactReq) (*GetGroupFromContactResp, error)
 	// 删除用户的加群申请
 	DeleteUserGroupApplicationFromRecipient(context.Context, *DeleteGroupApplicationFromRecipientReq) (*DeleteGroupApplicationFromRecipientResp, error)
@@ -8955,16 +16908,42 @@ type ChatServer interface {
 	GetPostByID(context.Context, *GetPostByIDReq) (*GetPostByIDResp, error)
 	// 删除帖子
 	DeletePost(context.Context, *DeletePostReq) (*DeletePostResp, error)
+	// 获取用户帖子与关注计数
+	GetUserCounters(context.Context, *GetUserCountersReq) (*GetUserCountersResp, error)
+	// 恢复删除的帖子
+	RestorePost(context.Context, *RestorePostReq) (*RestorePostResp, error)
+	// 获取回收站帖子列表
+	GetDeletedPostList(context.Context, *GetDeletedPostListReq) (*GetDeletedPostListResp, error)
 	// 修改帖子允许评论
 	ChangeAllowCommentPost(context.Context, *ChangeAllowCommentPostReq) (*ChangeAllowCommentPostResp, error)
 	// 修改帖子允许转发
 	ChangeAllowForwardPost(context.Context, *ChangeAllowForwardPostReq) (*ChangeAllowForwardPostResp, error)
+	// 编辑帖子
+	EditPost(context.Context, *EditPostReq) (*EditPostResp, error)
+	// 获取帖子编辑历史
+	GetPostRevisions(context.Context, *GetPostRevisionsReq) (*GetPostRevisionsResp, error)
+	// 修改帖子可见范围
+	ChangePostVisibility(context.Context, *ChangePostVisibilityReq) (*ChangePostVisibilityResp, error)
 	// 点赞帖子
 	ChangeLikePost(context.Context, *LikePostReq) (*LikePostResp, error)
 	// 收藏帖子
 	ChangeCollectPost(context.Context, *CollectPostReq) (*CollectPostResp, error)
 	// 置顶帖子
 	PinPost(context.Context, *PinPostReq) (*PinPostResp, error)
+	// 置顶帖子的评论
+	PinComment(context.Context, *PinCommentReq) (*PinCommentResp, error)
+	// 获取帖子互动通知
+	GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error)
+	// 标记通知已读
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadReq) (*MarkNotificationsReadResp, error)
+	// 获取未读通知数
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountReq) (*GetUnreadNotificationCountResp, error)
+	// 搜索帖子
+	SearchPosts(context.Context, *SearchPostsReq) (*SearchPostsResp, error)
+	// 获取话题下的帖子
+	GetTopicFeed(context.Context, *GetTopicFeedReq) (*GetTopicFeedResp, error)
+	// 举报帖子
+	ReportPost(context.Context, *ReportPostReq) (*ReportPostResp, error)
 	// 转发帖子
 	ForwardPost(context.Context, *ForwardPostReq) (*ForwardPostResp, error)
 	// 评论帖子
@@ -9017,6 +16996,72 @@ func (*UnimplementedChatServer) RegisterUser(context.Context, *RegisterUserReq)
 func (*UnimplementedChatServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (*UnimplementedChatServer) AddUserKey(context.Context, *AddUserKeyReq) (*AddUserKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserKey not implemented")
+}
+func (*UnimplementedChatServer) ListUserKeys(context.Context, *ListUserKeysReq) (*ListUserKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserKeys not implemented")
+}
+func (*UnimplementedChatServer) RevokeUserKey(context.Context, *RevokeUserKeyReq) (*RevokeUserKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserKey not implemented")
+}
+func (*UnimplementedChatServer) SetRecoveryGuardians(context.Context, *SetRecoveryGuardiansReq) (*SetRecoveryGuardiansResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecoveryGuardians not implemented")
+}
+func (*UnimplementedChatServer) GetRecoveryGuardians(context.Context, *GetRecoveryGuardiansReq) (*GetRecoveryGuardiansResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryGuardians not implemented")
+}
+func (*UnimplementedChatServer) InitiateRecovery(context.Context, *InitiateRecoveryReq) (*InitiateRecoveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateRecovery not implemented")
+}
+func (*UnimplementedChatServer) ApproveRecovery(context.Context, *ApproveRecoveryReq) (*ApproveRecoveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRecovery not implemented")
+}
+func (*UnimplementedChatServer) GetRecovery(context.Context, *GetRecoveryReq) (*GetRecoveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecovery not implemented")
+}
+func (*UnimplementedChatServer) StartAdminRecovery(context.Context, *StartAdminRecoveryReq) (*StartAdminRecoveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAdminRecovery not implemented")
+}
+func (*UnimplementedChatServer) ExecuteRecovery(context.Context, *ExecuteRecoveryReq) (*ExecuteRecoveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRecovery not implemented")
+}
+func (*UnimplementedChatServer) CancelRecovery(context.Context, *CancelRecoveryReq) (*CancelRecoveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecovery not implemented")
+}
+func (*UnimplementedChatServer) GetRedPacket(context.Context, *GetRedPacketReq) (*GetRedPacketResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedPacket not implemented")
+}
+func (*UnimplementedChatServer) GetRedPacketBalance(context.Context, *GetRedPacketBalanceReq) (*GetRedPacketBalanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedPacketBalance not implemented")
+}
+func (*UnimplementedChatServer) AdjustRedPacketBalance(context.Context, *AdjustRedPacketBalanceReq) (*AdjustRedPacketBalanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustRedPacketBalance not implemented")
+}
+func (*UnimplementedChatServer) FindRedPacketCallbacks(context.Context, *FindRedPacketCallbacksReq) (*FindRedPacketCallbacksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRedPacketCallbacks not implemented")
+}
+func (*UnimplementedChatServer) GetDiscoverConfig(context.Context, *GetDiscoverConfigReq) (*GetDiscoverConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscoverConfig not implemented")
+}
+func (*UnimplementedChatServer) SetDiscoverConfig(context.Context, *SetDiscoverConfigReq) (*SetDiscoverConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiscoverConfig not implemented")
+}
+func (*UnimplementedChatServer) SetPostDiscover(context.Context, *SetPostDiscoverReq) (*SetPostDiscoverResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostDiscover not implemented")
+}
+func (*UnimplementedChatServer) SearchPostReports(context.Context, *SearchPostReportsReq) (*SearchPostReportsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPostReports not implemented")
+}
+func (*UnimplementedChatServer) GetReportedPost(context.Context, *GetReportedPostReq) (*GetReportedPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportedPost not implemented")
+}
+func (*UnimplementedChatServer) ModeratePost(context.Context, *ModeratePostReq) (*ModeratePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratePost not implemented")
+}
+func (*UnimplementedChatServer) SearchPostModerationLogs(context.Context, *SearchPostModerationLogsReq) (*SearchPostModerationLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPostModerationLogs not implemented")
+}
 func (*UnimplementedChatServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
 }
@@ -9038,6 +17083,9 @@ func (*UnimplementedChatServer) FindAccountUser(context.Context, *FindAccountUse
 func (*UnimplementedChatServer) OpenIMCallback(context.Context, *OpenIMCallbackReq) (*OpenIMCallbackResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenIMCallback not implemented")
 }
+func (*UnimplementedChatServer) GetCallbackMetrics(context.Context, *GetCallbackMetricsReq) (*GetCallbackMetricsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCallbackMetrics not implemented")
+}
 func (*UnimplementedChatServer) UserLoginCount(context.Context, *UserLoginCountReq) (*UserLoginCountResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserLoginCount not implemented")
 }
@@ -9083,259 +17131,694 @@ func (*UnimplementedChatServer) GetPostListByUser(context.Context, *GetPostListB
 func (*UnimplementedChatServer) GetCommentPostListByPostID(context.Context, *GetCommentPostListByPostIDReq) (*GetCommentPostListByPostIDResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentPostListByPostID not implemented")
 }
-func (*UnimplementedChatServer) GetPostByID(context.Context, *GetPostByIDReq) (*GetPostByIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostByID not implemented")
+func (*UnimplementedChatServer) GetPostByID(context.Context, *GetPostByIDReq) (*GetPostByIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostByID not implemented")
+}
+func (*UnimplementedChatServer) DeletePost(context.Context, *DeletePostReq) (*DeletePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (*UnimplementedChatServer) GetUserCounters(context.Context, *GetUserCountersReq) (*GetUserCountersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCounters not implemented")
+}
+func (*UnimplementedChatServer) RestorePost(context.Context, *RestorePostReq) (*RestorePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (*UnimplementedChatServer) GetDeletedPostList(context.Context, *GetDeletedPostListReq) (*GetDeletedPostListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedPostList not implemented")
+}
+func (*UnimplementedChatServer) ChangeAllowCommentPost(context.Context, *ChangeAllowCommentPostReq) (*ChangeAllowCommentPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAllowCommentPost not implemented")
+}
+func (*UnimplementedChatServer) ChangeAllowForwardPost(context.Context, *ChangeAllowForwardPostReq) (*ChangeAllowForwardPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAllowForwardPost not implemented")
+}
+func (*UnimplementedChatServer) EditPost(context.Context, *EditPostReq) (*EditPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPost not implemented")
+}
+func (*UnimplementedChatServer) GetPostRevisions(context.Context, *GetPostRevisionsReq) (*GetPostRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevisions not implemented")
+}
+func (*UnimplementedChatServer) ChangePostVisibility(context.Context, *ChangePostVisibilityReq) (*ChangePostVisibilityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePostVisibility not implemented")
+}
+func (*UnimplementedChatServer) ChangeLikePost(context.Context, *LikePostReq) (*LikePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeLikePost not implemented")
+}
+func (*UnimplementedChatServer) ChangeCollectPost(context.Context, *CollectPostReq) (*CollectPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCollectPost not implemented")
+}
+func (*UnimplementedChatServer) PinPost(context.Context, *PinPostReq) (*PinPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (*UnimplementedChatServer) PinComment(context.Context, *PinCommentReq) (*PinCommentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinComment not implemented")
+}
+func (*UnimplementedChatServer) GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (*UnimplementedChatServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadReq) (*MarkNotificationsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (*UnimplementedChatServer) GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountReq) (*GetUnreadNotificationCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
+func (*UnimplementedChatServer) SearchPosts(context.Context, *SearchPostsReq) (*SearchPostsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (*UnimplementedChatServer) GetTopicFeed(context.Context, *GetTopicFeedReq) (*GetTopicFeedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicFeed not implemented")
+}
+func (*UnimplementedChatServer) ReportPost(context.Context, *ReportPostReq) (*ReportPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPost not implemented")
+}
+func (*UnimplementedChatServer) ForwardPost(context.Context, *ForwardPostReq) (*ForwardPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardPost not implemented")
+}
+func (*UnimplementedChatServer) CommentPost(context.Context, *CommentPostReq) (*CommentPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentPost not implemented")
+}
+func (*UnimplementedChatServer) ReferencePost(context.Context, *ReferencePostReq) (*ReferencePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferencePost not implemented")
+}
+func (*UnimplementedChatServer) CheckVersion(context.Context, *CheckVersionReq) (*CheckVersionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckVersion not implemented")
+}
+func (*UnimplementedChatServer) GetFakeUser(context.Context, *GetFakeUserReq) (*GetFakeUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFakeUser not implemented")
+}
+
+func RegisterChatServer(s *grpc.Server, srv ChatServer) {
+	s.RegisterService(&_Chat_serviceDesc, srv)
+}
+
+func _Chat_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).UpdateUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/UpdateUserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_AddUserAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).AddUserAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/AddUserAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).AddUserAccount(ctx, req.(*AddUserAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserPublicInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchUserPublicInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SearchUserPublicInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchUserPublicInfo(ctx, req.(*SearchUserPublicInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserPublicInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindUserPublicInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/FindUserPublicInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserPublicInfo(ctx, req.(*FindUserPublicInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindUserByAddressOrAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserByAddressOrAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/FindUserByAddressOrAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, req.(*FindUserByAddressOrAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserFullInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchUserFullInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SearchUserFullInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchUserFullInfo(ctx, req.(*SearchUserFullInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserFullInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindUserFullInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/FindUserFullInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserFullInfo(ctx, req.(*FindUserFullInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SendVerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerifyCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SendVerifyCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SendVerifyCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SendVerifyCode(ctx, req.(*SendVerifyCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).VerifyCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/VerifyCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).VerifyCode(ctx, req.(*VerifyCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ChallengeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeNonceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ChallengeNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ChallengeNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ChallengeNonce(ctx, req.(*ChallengeNonceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) DeletePost(context.Context, *DeletePostReq) (*DeletePostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+
+func _Chat_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/RegisterUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RegisterUser(ctx, req.(*RegisterUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) ChangeAllowCommentPost(context.Context, *ChangeAllowCommentPostReq) (*ChangeAllowCommentPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeAllowCommentPost not implemented")
+
+func _Chat_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) ChangeAllowForwardPost(context.Context, *ChangeAllowForwardPostReq) (*ChangeAllowForwardPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeAllowForwardPost not implemented")
+
+func _Chat_AddUserKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).AddUserKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/AddUserKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).AddUserKey(ctx, req.(*AddUserKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) ChangeLikePost(context.Context, *LikePostReq) (*LikePostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeLikePost not implemented")
+
+func _Chat_ListUserKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ListUserKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ListUserKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ListUserKeys(ctx, req.(*ListUserKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) ChangeCollectPost(context.Context, *CollectPostReq) (*CollectPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeCollectPost not implemented")
+
+func _Chat_RevokeUserKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RevokeUserKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/RevokeUserKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RevokeUserKey(ctx, req.(*RevokeUserKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) PinPost(context.Context, *PinPostReq) (*PinPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+
+func _Chat_SetRecoveryGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecoveryGuardiansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SetRecoveryGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SetRecoveryGuardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SetRecoveryGuardians(ctx, req.(*SetRecoveryGuardiansReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) ForwardPost(context.Context, *ForwardPostReq) (*ForwardPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForwardPost not implemented")
+
+func _Chat_GetRecoveryGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryGuardiansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetRecoveryGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetRecoveryGuardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetRecoveryGuardians(ctx, req.(*GetRecoveryGuardiansReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) CommentPost(context.Context, *CommentPostReq) (*CommentPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommentPost not implemented")
+
+func _Chat_InitiateRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).InitiateRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/InitiateRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).InitiateRecovery(ctx, req.(*InitiateRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) ReferencePost(context.Context, *ReferencePostReq) (*ReferencePostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReferencePost not implemented")
+
+func _Chat_ApproveRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ApproveRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ApproveRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ApproveRecovery(ctx, req.(*ApproveRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) CheckVersion(context.Context, *CheckVersionReq) (*CheckVersionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckVersion not implemented")
+
+func _Chat_GetRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetRecovery(ctx, req.(*GetRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) GetFakeUser(context.Context, *GetFakeUserReq) (*GetFakeUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFakeUser not implemented")
+
+func _Chat_StartAdminRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartAdminRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).StartAdminRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/StartAdminRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).StartAdminRecovery(ctx, req.(*StartAdminRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterChatServer(s *grpc.Server, srv ChatServer) {
-	s.RegisterService(&_Chat_serviceDesc, srv)
+func _Chat_ExecuteRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ExecuteRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ExecuteRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ExecuteRecovery(ctx, req.(*ExecuteRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserInfoReq)
+func _Chat_CancelRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRecoveryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).UpdateUserInfo(ctx, in)
+		return srv.(ChatServer).CancelRecovery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/UpdateUserInfo",
+		FullMethod: "/openim.chat.chat/CancelRecovery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+		return srv.(ChatServer).CancelRecovery(ctx, req.(*CancelRecoveryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_AddUserAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserAccountReq)
+func _Chat_GetRedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedPacketReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).AddUserAccount(ctx, in)
+		return srv.(ChatServer).GetRedPacket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/AddUserAccount",
+		FullMethod: "/openim.chat.chat/GetRedPacket",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).AddUserAccount(ctx, req.(*AddUserAccountReq))
+		return srv.(ChatServer).GetRedPacket(ctx, req.(*GetRedPacketReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SearchUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserPublicInfoReq)
+func _Chat_GetRedPacketBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedPacketBalanceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SearchUserPublicInfo(ctx, in)
+		return srv.(ChatServer).GetRedPacketBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/SearchUserPublicInfo",
+		FullMethod: "/openim.chat.chat/GetRedPacketBalance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SearchUserPublicInfo(ctx, req.(*SearchUserPublicInfoReq))
+		return srv.(ChatServer).GetRedPacketBalance(ctx, req.(*GetRedPacketBalanceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_FindUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserPublicInfoReq)
+func _Chat_AdjustRedPacketBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustRedPacketBalanceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserPublicInfo(ctx, in)
+		return srv.(ChatServer).AdjustRedPacketBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/FindUserPublicInfo",
+		FullMethod: "/openim.chat.chat/AdjustRedPacketBalance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserPublicInfo(ctx, req.(*FindUserPublicInfoReq))
+		return srv.(ChatServer).AdjustRedPacketBalance(ctx, req.(*AdjustRedPacketBalanceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_FindUserByAddressOrAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserByAddressOrAccountReq)
+func _Chat_FindRedPacketCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRedPacketCallbacksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, in)
+		return srv.(ChatServer).FindRedPacketCallbacks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/FindUserByAddressOrAccount",
+		FullMethod: "/openim.chat.chat/FindRedPacketCallbacks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, req.(*FindUserByAddressOrAccountReq))
+		return srv.(ChatServer).FindRedPacketCallbacks(ctx, req.(*FindRedPacketCallbacksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SearchUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserFullInfoReq)
+func _Chat_GetDiscoverConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscoverConfigReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SearchUserFullInfo(ctx, in)
+		return srv.(ChatServer).GetDiscoverConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/SearchUserFullInfo",
+		FullMethod: "/openim.chat.chat/GetDiscoverConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SearchUserFullInfo(ctx, req.(*SearchUserFullInfoReq))
+		return srv.(ChatServer).GetDiscoverConfig(ctx, req.(*GetDiscoverConfigReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_FindUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserFullInfoReq)
+func _Chat_SetDiscoverConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiscoverConfigReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserFullInfo(ctx, in)
+		return srv.(ChatServer).SetDiscoverConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/FindUserFullInfo",
+		FullMethod: "/openim.chat.chat/SetDiscoverConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserFullInfo(ctx, req.(*FindUserFullInfoReq))
+		return srv.(ChatServer).SetDiscoverConfig(ctx, req.(*SetDiscoverConfigReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SendVerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendVerifyCodeReq)
+func _Chat_SetPostDiscover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPostDiscoverReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SendVerifyCode(ctx, in)
+		return srv.(ChatServer).SetPostDiscover(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/SendVerifyCode",
+		FullMethod: "/openim.chat.chat/SetPostDiscover",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SendVerifyCode(ctx, req.(*SendVerifyCodeReq))
+		return srv.(ChatServer).SetPostDiscover(ctx, req.(*SetPostDiscoverReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyCodeReq)
+func _Chat_SearchPostReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostReportsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).VerifyCode(ctx, in)
+		return srv.(ChatServer).SearchPostReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/VerifyCode",
+		FullMethod: "/openim.chat.chat/SearchPostReports",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).VerifyCode(ctx, req.(*VerifyCodeReq))
+		return srv.(ChatServer).SearchPostReports(ctx, req.(*SearchPostReportsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ChallengeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChallengeNonceReq)
+func _Chat_GetReportedPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportedPostReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ChallengeNonce(ctx, in)
+		return srv.(ChatServer).GetReportedPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/ChallengeNonce",
+		FullMethod: "/openim.chat.chat/GetReportedPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ChallengeNonce(ctx, req.(*ChallengeNonceReq))
+		return srv.(ChatServer).GetReportedPost(ctx, req.(*GetReportedPostReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterUserReq)
+func _Chat_ModeratePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeratePostReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).RegisterUser(ctx, in)
+		return srv.(ChatServer).ModeratePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/RegisterUser",
+		FullMethod: "/openim.chat.chat/ModeratePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).RegisterUser(ctx, req.(*RegisterUserReq))
+		return srv.(ChatServer).ModeratePost(ctx, req.(*ModeratePostReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Chat_SearchPostModerationLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostModerationLogsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).Login(ctx, in)
+		return srv.(ChatServer).SearchPostModerationLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/Login",
+		FullMethod: "/openim.chat.chat/SearchPostModerationLogs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).Login(ctx, req.(*LoginReq))
+		return srv.(ChatServer).SearchPostModerationLogs(ctx, req.(*SearchPostModerationLogsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -9466,6 +17949,24 @@ func _Chat_OpenIMCallback_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_GetCallbackMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCallbackMetricsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetCallbackMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetCallbackMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetCallbackMetrics(ctx, req.(*GetCallbackMetricsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chat_UserLoginCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserLoginCountReq)
 	if err := dec(in); err != nil {
@@ -9772,6 +18273,60 @@ func _Chat_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_GetUserCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCountersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetUserCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetUserCounters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetUserCounters(ctx, req.(*GetUserCountersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/RestorePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RestorePost(ctx, req.(*RestorePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetDeletedPostList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedPostListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetDeletedPostList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetDeletedPostList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetDeletedPostList(ctx, req.(*GetDeletedPostListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chat_ChangeAllowCommentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangeAllowCommentPostReq)
 	if err := dec(in); err != nil {
@@ -9808,6 +18363,60 @@ func _Chat_ChangeAllowForwardPost_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_EditPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).EditPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/EditPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).EditPost(ctx, req.(*EditPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetPostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetPostRevisions(ctx, req.(*GetPostRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ChangePostVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePostVisibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ChangePostVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ChangePostVisibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ChangePostVisibility(ctx, req.(*ChangePostVisibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chat_ChangeLikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikePostReq)
 	if err := dec(in); err != nil {
@@ -9862,6 +18471,132 @@ func _Chat_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_PinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).PinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/PinComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).PinComment(ctx, req.(*PinCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetNotifications(ctx, req.(*GetNotificationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/MarkNotificationsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetUnreadNotificationCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SearchPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchPosts(ctx, req.(*SearchPostsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetTopicFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetTopicFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetTopicFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetTopicFeed(ctx, req.(*GetTopicFeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ReportPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ReportPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ReportPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ReportPost(ctx, req.(*ReportPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chat_ForwardPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForwardPostReq)
 	if err := dec(in); err != nil {
@@ -10004,6 +18739,94 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Chat_Login_Handler,
 		},
+		{
+			MethodName: "AddUserKey",
+			Handler:    _Chat_AddUserKey_Handler,
+		},
+		{
+			MethodName: "ListUserKeys",
+			Handler:    _Chat_ListUserKeys_Handler,
+		},
+		{
+			MethodName: "RevokeUserKey",
+			Handler:    _Chat_RevokeUserKey_Handler,
+		},
+		{
+			MethodName: "SetRecoveryGuardians",
+			Handler:    _Chat_SetRecoveryGuardians_Handler,
+		},
+		{
+			MethodName: "GetRecoveryGuardians",
+			Handler:    _Chat_GetRecoveryGuardians_Handler,
+		},
+		{
+			MethodName: "InitiateRecovery",
+			Handler:    _Chat_InitiateRecovery_Handler,
+		},
+		{
+			MethodName: "ApproveRecovery",
+			Handler:    _Chat_ApproveRecovery_Handler,
+		},
+		{
+			MethodName: "GetRecovery",
+			Handler:    _Chat_GetRecovery_Handler,
+		},
+		{
+			MethodName: "StartAdminRecovery",
+			Handler:    _Chat_StartAdminRecovery_Handler,
+		},
+		{
+			MethodName: "ExecuteRecovery",
+			Handler:    _Chat_ExecuteRecovery_Handler,
+		},
+		{
+			MethodName: "CancelRecovery",
+			Handler:    _Chat_CancelRecovery_Handler,
+		},
+		{
+			MethodName: "GetRedPacket",
+			Handler:    _Chat_GetRedPacket_Handler,
+		},
+		{
+			MethodName: "GetRedPacketBalance",
+			Handler:    _Chat_GetRedPacketBalance_Handler,
+		},
+		{
+			MethodName: "AdjustRedPacketBalance",
+			Handler:    _Chat_AdjustRedPacketBalance_Handler,
+		},
+		{
+			MethodName: "FindRedPacketCallbacks",
+			Handler:    _Chat_FindRedPacketCallbacks_Handler,
+		},
+		{
+			MethodName: "GetDiscoverConfig",
+			Handler:    _Chat_GetDiscoverConfig_Handler,
+		},
+		{
+			MethodName: "SetDiscoverConfig",
+			Handler:    _Chat_SetDiscoverConfig_Handler,
+		},
+		{
+			MethodName: "SetPostDiscover",
+			Handler:    _Chat_SetPostDiscover_Handler,
+		},
+		{
+			MethodName: "SearchPostReports",
+			Handler:    _Chat_SearchPostReports_Handler,
+		},
+		{
+			MethodName: "GetReportedPost",
+			Handler:    _Chat_GetReportedPost_Handler,
+		},
+		{
+			MethodName: "ModeratePost",
+			Handler:    _Chat_ModeratePost_Handler,
+		},
+		{
+			MethodName: "SearchPostModerationLogs",
+			Handler:    _Chat_SearchPostModerationLogs_Handler,
+		},
 		{
 			MethodName: "ResetPassword",
 			Handler:    _Chat_ResetPassword_Handler,
@@ -10032,6 +18855,10 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenIMCallback",
 			Handler:    _Chat_OpenIMCallback_Handler,
 		},
+		{
+			MethodName: "GetCallbackMetrics",
+			Handler:    _Chat_GetCallbackMetrics_Handler,
+		},
 		{
 			MethodName: "UserLoginCount",
 			Handler:    _Chat_UserLoginCount_Handler,
@@ -10100,6 +18927,18 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _Chat_DeletePost_Handler,
 		},
+		{
+			MethodName: "GetUserCounters",
+			Handler:    _Chat_GetUserCounters_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _Chat_RestorePost_Handler,
+		},
+		{
+			MethodName: "GetDeletedPostList",
+			Handler:    _Chat_GetDeletedPostList_Handler,
+		},
 		{
 			MethodName: "ChangeAllowCommentPost",
 			Handler:    _Chat_ChangeAllowCommentPost_Handler,
@@ -10108,6 +18947,18 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeAllowForwardPost",
 			Handler:    _Chat_ChangeAllowForwardPost_Handler,
 		},
+		{
+			MethodName: "EditPost",
+			Handler:    _Chat_EditPost_Handler,
+		},
+		{
+			MethodName: "GetPostRevisions",
+			Handler:    _Chat_GetPostRevisions_Handler,
+		},
+		{
+			MethodName: "ChangePostVisibility",
+			Handler:    _Chat_ChangePostVisibility_Handler,
+		},
 		{
 			MethodName: "ChangeLikePost",
 			Handler:    _Chat_ChangeLikePost_Handler,
@@ -10120,6 +18971,34 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PinPost",
 			Handler:    _Chat_PinPost_Handler,
 		},
+		{
+			MethodName: "PinComment",
+			Handler:    _Chat_PinComment_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _Chat_GetNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _Chat_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _Chat_GetUnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Chat_SearchPosts_Handler,
+		},
+		{
+			MethodName: "GetTopicFeed",
+			Handler:    _Chat_GetTopicFeed_Handler,
+		},
+		{
+			MethodName: "ReportPost",
+			Handler:    _Chat_ReportPost_Handler,
+		},
 		{
 			MethodName: "ForwardPost",
 			Handler:    _Chat_ForwardPost_Handler,
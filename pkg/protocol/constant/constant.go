@@ -400,5 +400,5 @@ const (
 	ReceiveLuckRedPacket      = 1004 //领取群聊拼手气红包
 	ReceiveExclusiveRedPacket = 1005 //领取群聊用户专属红包
 
-	RefundRedPacket = 1005 //退款
+	RefundRedPacket = 1006 //退款
 )
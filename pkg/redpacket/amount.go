@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redpacket
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+// AmountScale is the number of minor units in one major unit, the ledger keeps amounts as integer minor units.
+const AmountScale = 100
+
+// ParseAmount parses a positive decimal amount such as "12.5" into minor units, at most two fraction digits are allowed.
+func ParseAmount(s string) (int64, error) {
+	integer, fraction, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if integer == "" || len(fraction) > 2 {
+		return 0, eerrs.ErrRedPacketAmount.WrapMsg("invalid amount " + s)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+	for _, r := range integer + fraction {
+		if r < '0' || r > '9' {
+			return 0, eerrs.ErrRedPacketAmount.WrapMsg("invalid amount " + s)
+		}
+	}
+	major, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return 0, eerrs.ErrRedPacketAmount.WrapMsg("invalid amount " + s)
+	}
+	minor, _ := strconv.ParseInt(fraction, 10, 64)
+	amount := major*AmountScale + minor
+	if amount <= 0 || amount/AmountScale != major {
+		return 0, eerrs.ErrRedPacketAmount.WrapMsg("invalid amount " + s)
+	}
+	return amount, nil
+}
+
+// FormatAmount formats minor units as a decimal amount with two fraction digits.
+func FormatAmount(amount int64) string {
+	var sign string
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	fraction := strconv.FormatInt(amount%AmountScale, 10)
+	if len(fraction) < 2 {
+		fraction = "0" + fraction
+	}
+	return sign + strconv.FormatInt(amount/AmountScale, 10) + "." + fraction
+}
+
+// Split divides total into count random shares of at least one minor unit each.
+// Every share is drawn from (0, 2*remain/n] so that the expected share stays the same for every claimer.
+func Split(total int64, count int) ([]int64, error) {
+	if count <= 0 || total < int64(count) {
+		return nil, eerrs.ErrRedPacketAmount.WrapMsg("amount is too small for the count")
+	}
+	shares := make([]int64, 0, count)
+	remain := total
+	for n := int64(count); n > 1; n-- {
+		upper := remain / n * 2
+		if upper > remain-(n-1) {
+			upper = remain - (n - 1)
+		}
+		share := int64(1)
+		if upper > 1 {
+			share = rand.Int63n(upper) + 1
+		}
+		shares = append(shares, share)
+		remain -= share
+	}
+	return append(shares, remain), nil
+}
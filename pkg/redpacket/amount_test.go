@@ -0,0 +1,140 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redpacket
+
+import (
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{name: "integer", in: "12", want: 1200},
+		{name: "one fraction digit", in: "12.5", want: 1250},
+		{name: "two fraction digits", in: "0.01", want: 1},
+		{name: "trailing dot", in: "3.", want: 300},
+		{name: "surrounding spaces", in: " 2.50 ", want: 250},
+		{name: "leading zeros", in: "007.07", want: 707},
+		{name: "zero", in: "0", wantErr: true},
+		{name: "zero with fraction", in: "0.00", wantErr: true},
+		{name: "empty", in: "", wantErr: true},
+		{name: "missing integer", in: ".5", wantErr: true},
+		{name: "three fraction digits", in: "1.234", wantErr: true},
+		{name: "negative", in: "-1", wantErr: true},
+		{name: "plus sign", in: "+1", wantErr: true},
+		{name: "letters", in: "1a", wantErr: true},
+		{name: "letters in fraction", in: "1.a", wantErr: true},
+		{name: "two dots", in: "1.2.3", wantErr: true},
+		{name: "exponent", in: "1e3", wantErr: true},
+		{name: "overflow", in: "92233720368547758.08", wantErr: true},
+		{name: "integer overflow", in: "99999999999999999999", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAmount(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAmount(%q) = %d, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseAmount(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want string
+	}{
+		{in: 0, want: "0.00"},
+		{in: 1, want: "0.01"},
+		{in: 1250, want: "12.50"},
+		{in: -305, want: "-3.05"},
+	}
+	for _, tt := range tests {
+		if got := FormatAmount(tt.in); got != tt.want {
+			t.Errorf("FormatAmount(%d) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int64
+		count int
+	}{
+		{name: "one share", total: 100, count: 1},
+		{name: "one minor unit each", total: 10, count: 10},
+		{name: "one spare unit", total: 11, count: 10},
+		{name: "remainder", total: 1001, count: 7},
+		{name: "large", total: 100000000, count: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// shares are random, repeat to cover different draws
+			for i := 0; i < 1000; i++ {
+				shares, err := Split(tt.total, tt.count)
+				if err != nil {
+					t.Fatalf("Split(%d, %d) error: %v", tt.total, tt.count, err)
+				}
+				if len(shares) != tt.count {
+					t.Fatalf("Split(%d, %d) returned %d shares", tt.total, tt.count, len(shares))
+				}
+				var sum int64
+				for _, share := range shares {
+					if share < 1 {
+						t.Fatalf("Split(%d, %d) returned share %d below one minor unit", tt.total, tt.count, share)
+					}
+					sum += share
+				}
+				if sum != tt.total {
+					t.Fatalf("Split(%d, %d) shares sum to %d", tt.total, tt.count, sum)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int64
+		count int
+	}{
+		{name: "zero count", total: 100, count: 0},
+		{name: "negative count", total: 100, count: -1},
+		{name: "total below count", total: 9, count: 10},
+		{name: "zero total", total: 0, count: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Split(tt.total, tt.count); err == nil {
+				t.Fatalf("Split(%d, %d) want error", tt.total, tt.count)
+			}
+		})
+	}
+}
@@ -1,5 +1,14 @@
 package apistruct
 
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/openimsdk/chat/pkg/eerrs"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+	"github.com/openimsdk/chat/pkg/redpacket"
+)
+
 // RedPacketType 定义红包类型
 type RedPacketType string
 
@@ -9,6 +18,26 @@ const (
 	Exclusive RedPacketType = "exclusive" // 群聊用户专属红包
 )
 
+// CustomMsgVersion 当前红包自定义消息版本, 未携带版本号的消息按版本 1 处理
+const CustomMsgVersion = 1
+
+// MaxRemarkLen 红包备注最大长度
+const MaxRemarkLen = 100
+
+// CustomElem 定义 OpenIM 自定义消息内容, Data 为 JSON 编码的 CustomData
+type CustomElem struct {
+	Data        string `json:"data"`
+	Description string `json:"description"`
+	Extension   string `json:"extension"`
+}
+
+// CustomData 定义自定义消息的公共结构
+type CustomData struct {
+	CustomType int32           `json:"customType"`
+	Version    int32           `json:"version"`
+	Data       json.RawMessage `json:"data"`
+}
+
 // RedPacket 定义红包结构体
 type RedPacket struct {
 	ReceiveUserID string        `json:"receiveUserId"` // 领取人ID
@@ -20,3 +49,133 @@ type RedPacket struct {
 	TotalCount    int           `json:"totalCount"`    // 红包个数
 	Emoji         string        `json:"emoji"`         // emoji
 }
+
+// ReceiveRedPacket 定义领取红包结构体
+type ReceiveRedPacket struct {
+	RedPacketID string `json:"redPacketId"` // 红包ID
+}
+
+// RefundRedPacket 定义红包退款通知结构体, 只能由服务端发送
+type RefundRedPacket struct {
+	RedPacketID string `json:"redPacketId"` // 红包ID
+	Amount      string `json:"amount"`      // 退款金额
+	RefundTime  int64  `json:"refundTime"`  // 退款时间
+}
+
+// IsRedPacket 判断自定义消息类型是否为红包消息
+func IsRedPacket(customType int32) bool {
+	return customType >= constantpb.SendPrivateRedPacket && customType <= constantpb.RefundRedPacket
+}
+
+// IsSendRedPacket 判断自定义消息类型是否为发送红包
+func IsSendRedPacket(customType int32) bool {
+	return customType >= constantpb.SendPrivateRedPacket && customType <= constantpb.SendExclusiveRedPacket
+}
+
+// IsReceiveRedPacket 判断自定义消息类型是否为领取红包
+func IsReceiveRedPacket(customType int32) bool {
+	return customType >= constantpb.ReceivePrivateRedPacket && customType <= constantpb.ReceiveExclusiveRedPacket
+}
+
+// SendRedPacketType 返回发送红包消息对应的红包类型
+func SendRedPacketType(customType int32) RedPacketType {
+	switch customType {
+	case constantpb.SendPrivateRedPacket:
+		return Private
+	case constantpb.SendLuckRedPacket:
+		return Luck
+	case constantpb.SendExclusiveRedPacket:
+		return Exclusive
+	default:
+		return ""
+	}
+}
+
+// ParseCustomData 解析自定义消息内容, 不是合法的自定义消息时返回错误
+func ParseCustomData(content string) (*CustomData, error) {
+	var elem CustomElem
+	if err := json.Unmarshal([]byte(content), &elem); err != nil {
+		return nil, eerrs.ErrRedPacketPayload.WrapMsg("custom content is not json: " + err.Error())
+	}
+	var data CustomData
+	if err := json.Unmarshal([]byte(elem.Data), &data); err != nil {
+		return nil, eerrs.ErrRedPacketPayload.WrapMsg("custom data is not json: " + err.Error())
+	}
+	return &data, nil
+}
+
+func (d *CustomData) decode(v any) error {
+	if d.Version > CustomMsgVersion {
+		return eerrs.ErrRedPacketPayload.WrapMsg("unsupported version " + strconv.Itoa(int(d.Version)))
+	}
+	if len(d.Data) == 0 {
+		return eerrs.ErrRedPacketPayload.WrapMsg("data is empty")
+	}
+	if err := json.Unmarshal(d.Data, v); err != nil {
+		return eerrs.ErrRedPacketPayload.WrapMsg(err.Error())
+	}
+	return nil
+}
+
+// RedPacket 解析发送红包消息, 并校验金额精度与个数范围
+func (d *CustomData) RedPacket(maxCount int) (*RedPacket, error) {
+	packetType := SendRedPacketType(d.CustomType)
+	if packetType == "" {
+		return nil, eerrs.ErrRedPacketPayload.WrapMsg("not a send red packet message")
+	}
+	var packet RedPacket
+	if err := d.decode(&packet); err != nil {
+		return nil, err
+	}
+	if packet.Type != "" && packet.Type != packetType {
+		return nil, eerrs.ErrRedPacketPayload.WrapMsg("type does not match customType")
+	}
+	packet.Type = packetType
+	if err := packet.Check(maxCount); err != nil {
+		return nil, err
+	}
+	return &packet, nil
+}
+
+// Check 校验红包金额精度、个数范围与领取人
+func (x *RedPacket) Check(maxCount int) error {
+	amount, err := redpacket.ParseAmount(x.Amount)
+	if err != nil {
+		return err
+	}
+	if len([]rune(x.Remark)) > MaxRemarkLen {
+		return eerrs.ErrRedPacketPayload.WrapMsg("remark is too long")
+	}
+	switch x.Type {
+	case Luck:
+		if x.TotalCount <= 0 || x.TotalCount > maxCount {
+			return eerrs.ErrRedPacketCount.WrapMsg("totalCount must be between 1 and " + strconv.Itoa(maxCount))
+		}
+		if amount < int64(x.TotalCount) {
+			return eerrs.ErrRedPacketAmount.WrapMsg("amount is too small for the count")
+		}
+	case Private, Exclusive:
+		if x.TotalCount > 1 {
+			return eerrs.ErrRedPacketCount.WrapMsg("totalCount must be 1")
+		}
+		if x.Type == Exclusive && x.ReceiveUserID == "" {
+			return eerrs.ErrRedPacketPayload.WrapMsg("receiveUserId is empty")
+		}
+	}
+	return nil
+}
+
+// ReceiveRedPacket 解析领取红包消息
+func (d *CustomData) ReceiveRedPacket() (*ReceiveRedPacket, error) {
+	if !IsReceiveRedPacket(d.CustomType) {
+		return nil, eerrs.ErrRedPacketPayload.WrapMsg("not a receive red packet message")
+	}
+	var receive ReceiveRedPacket
+	if err := d.decode(&receive); err != nil {
+		return nil, err
+	}
+	if receive.RedPacketID == "" {
+		return nil, eerrs.ErrRedPacketPayload.WrapMsg("redPacketId is empty")
+	}
+	return &receive, nil
+}
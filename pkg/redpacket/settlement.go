@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redpacket
+
+import (
+	"context"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/redpacket/servererrs"
+)
+
+// Settlement moves the money of a red packet in an external payment backend.
+// The native ledger records every packet either way, a settlement runs after the ledger change commits and is retried
+// until the backend accepts it, so the backend must apply each settlementID only once.
+type Settlement interface {
+	Send(ctx context.Context, userID string, settlementID string, params map[string]any) error
+	// Receive carries the clientMsgID of the receive message so that the backend can reconcile it with the ledger.
+	Receive(ctx context.Context, userID string, settlementID string, redPacketID string, clientMsgID string) error
+	Refund(ctx context.Context, userID string, settlementID string, redPacketID string, amount string) error
+}
+
+// SettlementID is the idempotency key of a settlement, key is the clientMsgID of a send or receive message
+// and the redPacketID of a refund.
+func SettlementID(operation string, key string) string {
+	return operation + ":" + key
+}
+
+// NewHTTPSettlement settles red packets through the HTTP red packet service, it returns nil when no apiURL is configured.
+func NewHTTPSettlement(conf *config.RpcRedPacket) Settlement {
+	if conf.ApiURL == "" {
+		return nil
+	}
+	return &httpSettlement{client: NewRedPacketClient(conf.ApiURL), config: conf}
+}
+
+type httpSettlement struct {
+	client *Client
+	config *config.RpcRedPacket
+}
+
+func (o *httpSettlement) Send(ctx context.Context, userID string, settlementID string, params map[string]any) error {
+	req := make(map[string]any, len(params)+1)
+	for k, v := range params {
+		req[k] = v
+	}
+	req["settlementId"] = settlementID
+	return o.post(ctx, userID, "/redPacket/send", req)
+}
+
+func (o *httpSettlement) Receive(ctx context.Context, userID string, settlementID string, redPacketID string, clientMsgID string) error {
+	return o.post(ctx, userID, "/redPacket/receive", map[string]any{"settlementId": settlementID, "redPacketId": redPacketID, "clientMsgID": clientMsgID})
+}
+
+func (o *httpSettlement) Refund(ctx context.Context, userID string, settlementID string, redPacketID string, amount string) error {
+	return o.post(ctx, userID, "/redPacket/refund", map[string]any{"settlementId": settlementID, "redPacketId": redPacketID, "amount": amount})
+}
+
+func (o *httpSettlement) post(ctx context.Context, userID string, url string, req any) error {
+	var resp Response
+	if err := o.client.SyncPost(ctx, userID, url, req, &resp, o.config); err != nil {
+		return err
+	}
+	if resp.Code != SuccessCode {
+		return servererrs.ErrInternalServer.WrapMsg(resp.Msg, "url", url, "code", resp.Code)
+	}
+	return nil
+}
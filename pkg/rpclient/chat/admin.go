@@ -16,6 +16,7 @@ package chat
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
@@ -67,6 +68,15 @@ func (o *AdminClient) CheckLogin(ctx context.Context, userID string, ip string)
 	return err
 }
 
+// AddLoginLockout returns false when a permanent rule already blocks logins from the ip and no lockout was recorded.
+func (o *AdminClient) AddLoginLockout(ctx context.Context, userID string, ip string, reason string, expireTime time.Time) (bool, error) {
+	resp, err := o.client.AddLoginLockout(ctx, &admin.AddLoginLockoutReq{UserID: userID, Ip: ip, Reason: reason, ExpireTime: expireTime.UnixMilli()})
+	if err != nil {
+		return false, err
+	}
+	return resp.Recorded, nil
+}
+
 func (o *AdminClient) UseInvitationCode(ctx context.Context, userID string, invitationCode string) error {
 	_, err := o.client.UseInvitationCode(ctx, &admin.UseInvitationCodeReq{UserID: userID, Code: invitationCode})
 	return err
@@ -83,8 +93,8 @@ func (o *AdminClient) CheckNilOrAdmin(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32) (*admin.CreateTokenResp, error) {
-	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType})
+func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32, platformID int32, deviceID string, ip string) (*admin.CreateTokenResp, error) {
+	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType, PlatformID: platformID, DeviceID: deviceID, Ip: ip})
 }
 
 func (o *AdminClient) GetDefaultFriendUserID(ctx context.Context) ([]string, error) {
@@ -107,3 +117,8 @@ func (o *AdminClient) InvalidateToken(ctx context.Context, userID string) error
 	_, err := o.client.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: userID})
 	return err
 }
+
+func (o *AdminClient) BlockUser(ctx context.Context, userID string, reason string) error {
+	_, err := o.client.BlockUser(ctx, &admin.BlockUserReq{UserID: userID, Reason: reason})
+	return err
+}
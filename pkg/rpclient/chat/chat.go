@@ -113,3 +113,21 @@ func (o *ChatClient) DelUserAccount(ctx context.Context, req *chat.DelUserAccoun
 	resp, err = o.client.DelUserAccount(ctx, req)
 	return resp, err
 }
+
+func (o *ChatClient) StartAdminRecovery(ctx context.Context, req *chat.StartAdminRecoveryReq) (*chat.RecoveryInfo, error) {
+	resp, err := o.client.StartAdminRecovery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Recovery, nil
+}
+
+func (o *ChatClient) ExecuteRecovery(ctx context.Context, recoveryID string) error {
+	_, err := o.client.ExecuteRecovery(ctx, &chat.ExecuteRecoveryReq{RecoveryID: recoveryID})
+	return err
+}
+
+func (o *ChatClient) CancelRecovery(ctx context.Context, recoveryID string) error {
+	_, err := o.client.CancelRecovery(ctx, &chat.CancelRecoveryReq{RecoveryID: recoveryID})
+	return err
+}